@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/diegofsousa/explicAI/configuration"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/db"
+	"github.com/google/uuid"
+)
+
+const usage = `usage:
+  apikey create -workspace <workspaceId> -name <name> [-role viewer|editor|admin]
+  apikey list -workspace <workspaceId>
+  apikey revoke -workspace <workspaceId> -id <externalId>`
+
+func main() {
+	if len(os.Args) < 2 {
+		exit(usage)
+	}
+
+	c := configuration.Init()
+	apiKeys := service.NewAPIKey(db.NewAPIKey(c.GetString("database.url")))
+	workspaces := service.NewWorkspace(db.NewWorkspace(c.GetString("database.url")))
+
+	switch os.Args[1] {
+	case "create":
+		create(workspaces, apiKeys, os.Args[2:])
+	case "list":
+		list(workspaces, apiKeys, os.Args[2:])
+	case "revoke":
+		revoke(workspaces, apiKeys, os.Args[2:])
+	default:
+		exit(usage)
+	}
+}
+
+func create(workspaces service.WorkspaceUseCase, apiKeys service.APIKeyUseCase, args []string) {
+	flags := flag.NewFlagSet("create", flag.ExitOnError)
+	workspace := flags.String("workspace", "", "external id of the workspace")
+	name := flags.String("name", "", "name to identify the api key")
+	role := flags.String("role", "editor", "role of the key: viewer, editor or admin")
+	flags.Parse(args)
+
+	ctx := workspaceContext(workspaces, *workspace)
+
+	key, err := apiKeys.CreateAPIKey(ctx, service.APIKeyCreateInput{Name: *name, Role: *role})
+	if err != nil {
+		exit("failed to create api key: " + err.Error())
+	}
+
+	fmt.Printf("id:  %s\nkey: %s\n\nstore the key now, it can not be shown again\n", key.ExternalID, key.Key)
+}
+
+func list(workspaces service.WorkspaceUseCase, apiKeys service.APIKeyUseCase, args []string) {
+	flags := flag.NewFlagSet("list", flag.ExitOnError)
+	workspace := flags.String("workspace", "", "external id of the workspace")
+	flags.Parse(args)
+
+	ctx := workspaceContext(workspaces, *workspace)
+
+	keys, err := apiKeys.ListAPIKeys(ctx)
+	if err != nil {
+		exit("failed to list api keys: " + err.Error())
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tNAME\tPREFIX\tROLE\tCREATED\tREVOKED")
+	for _, key := range keys.Data {
+		revoked := "-"
+		if key.RevokedAt != nil {
+			revoked = key.RevokedAt.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			key.ExternalID, key.Name, key.Prefix, key.Role, key.CreatedAt.Format(time.RFC3339), revoked)
+	}
+	writer.Flush()
+}
+
+func revoke(workspaces service.WorkspaceUseCase, apiKeys service.APIKeyUseCase, args []string) {
+	flags := flag.NewFlagSet("revoke", flag.ExitOnError)
+	workspace := flags.String("workspace", "", "external id of the workspace")
+	id := flags.String("id", "", "external id of the api key")
+	flags.Parse(args)
+
+	ctx := workspaceContext(workspaces, *workspace)
+
+	externalID, err := uuid.Parse(*id)
+	if err != nil {
+		exit("invalid api key id: " + *id)
+	}
+
+	if err = apiKeys.RevokeAPIKey(ctx, externalID); err != nil {
+		exit("failed to revoke api key: " + err.Error())
+	}
+
+	fmt.Println("api key has been revoked")
+}
+
+// workspaceContext checks that the workspace exists and returns a context
+// acting as its admin, since the cli runs with direct database access.
+func workspaceContext(workspaces service.WorkspaceUseCase, id string) context.Context {
+	workspaceID, err := uuid.Parse(id)
+	if err != nil {
+		exit("invalid workspace id: " + id)
+	}
+
+	ctx := context.Background()
+	if _, err = workspaces.GetWorkspace(ctx, workspaceID); err != nil {
+		exit("failed to get workspace: " + err.Error())
+	}
+
+	return auth.WithPrincipal(ctx, auth.Principal{WorkspaceID: workspaceID, Name: "cli", Role: auth.RoleAdmin})
+}
+
+func exit(message string) {
+	fmt.Fprintln(os.Stderr, message)
+	os.Exit(1)
+}
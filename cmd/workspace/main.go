@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/diegofsousa/explicAI/configuration"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/db"
+)
+
+const usage = `usage:
+  workspace create -name <name>
+  workspace list`
+
+func main() {
+	if len(os.Args) < 2 {
+		exit(usage)
+	}
+
+	c := configuration.Init()
+	workspaces := service.NewWorkspace(db.NewWorkspace(c.GetString("database.url")))
+
+	ctx := context.Background()
+
+	switch os.Args[1] {
+	case "create":
+		create(ctx, workspaces, os.Args[2:])
+	case "list":
+		list(ctx, workspaces)
+	default:
+		exit(usage)
+	}
+}
+
+func create(ctx context.Context, workspaces service.WorkspaceUseCase, args []string) {
+	flags := flag.NewFlagSet("create", flag.ExitOnError)
+	name := flags.String("name", "", "name to identify the workspace")
+	flags.Parse(args)
+
+	workspace, err := workspaces.CreateWorkspace(ctx, service.WorkspaceCreateInput{Name: *name})
+	if err != nil {
+		exit("failed to create workspace: " + err.Error())
+	}
+
+	fmt.Printf("id: %s\n", workspace.ExternalID)
+}
+
+func list(ctx context.Context, workspaces service.WorkspaceUseCase) {
+	output, err := workspaces.ListWorkspaces(ctx)
+	if err != nil {
+		exit("failed to list workspaces: " + err.Error())
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tNAME\tCREATED")
+	for _, workspace := range output.Data {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n",
+			workspace.ExternalID, workspace.Name, workspace.CreatedAt.Format(time.RFC3339))
+	}
+	writer.Flush()
+}
+
+func exit(message string) {
+	fmt.Fprintln(os.Stderr, message)
+	os.Exit(1)
+}
@@ -2,12 +2,24 @@ package configuration
 
 import (
 	"context"
+	"encoding/json"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
 	"github.com/diegofsousa/explicAI/internal/application/service"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/api"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/db"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/errors"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/eventbus"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/filestore"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/ratelimit"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/render"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -15,10 +27,34 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	apiKeyHeader        = "X-API-Key"
+	apiKeyQueryParam    = "api_key"
+	bearerPrefix        = "Bearer "
+	mimeTextEventStream = "text/event-stream"
+	audioRoute          = "/summaries/:externalId/audio"
+
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+var anonymousPrincipal = auth.Principal{Name: "anonymous", Role: auth.RoleAdmin}
+
+// aiRoutes trigger transcription and summarization on the AI providers, so
+// they share a single rate limit per client.
+var aiRoutes = map[string]bool{
+	http.MethodPost + " /upload":           true,
+	http.MethodPost + " /uploads/from-url": true,
+	http.MethodPost + " /uploads/tus":      true,
+}
+
 type Application struct {
 	server  *echo.Echo
 	config  *viper.Viper
 	clients *Clients
+	apiKeys service.APIKeyUseCase
+	tokens  service.TokenUseCase
 }
 
 func NewApplication(config *viper.Viper, clients *Clients) *Application {
@@ -26,13 +62,26 @@ func NewApplication(config *viper.Viper, clients *Clients) *Application {
 	server.HideBanner = true
 	server.HidePort = true
 
+	apiKeys := service.NewAPIKey(db.NewAPIKey(config.GetString("database.url")))
+
+	var tokens service.TokenUseCase
+	if clients.TokenVerifier != nil {
+		tokens = service.NewToken(clients.TokenVerifier, service.TokenClaims{
+			Workspace: config.GetString("auth.oidc.claims.workspace"),
+			Role:      config.GetString("auth.oidc.claims.role"),
+			Name:      config.GetString("auth.oidc.claims.name"),
+		})
+	}
+
 	logger := log.StartLog()
-	initMiddlewares(server, logger)
+	initMiddlewares(server, logger, config, apiKeys, tokens)
 
 	return &Application{
 		server:  server,
 		config:  config,
 		clients: clients,
+		apiKeys: apiKeys,
+		tokens:  tokens,
 	}
 }
 
@@ -48,23 +97,139 @@ func (a *Application) Start() {
 }
 
 func (a *Application) registerControllers() {
+	summaryRepository := db.NewSummary(a.config.GetString("database.url"))
+	webhookRepository := db.NewWebhook(a.config.GetString("database.url"))
+
+	dispatcher := service.NewWebhookDispatcher(
+		webhookRepository,
+		a.clients.WebhookSender,
+		service.WebhookRetryPolicy{
+			MaxAttempts:    a.config.GetInt("webhook.retry.maxAttempts"),
+			InitialBackoff: time.Duration(a.config.GetInt64("webhook.retry.initialBackoff")) * time.Millisecond,
+			MaxBackoff:     time.Duration(a.config.GetInt64("webhook.retry.maxBackoff")) * time.Millisecond,
+		},
+	)
+
+	bus := eventbus.NewBus(a.config.GetInt("events.bufferSize"), dispatcher)
+
+	usageRepository := db.NewUsage(a.config.GetString("database.url"))
+	usage := service.NewUsage(usageRepository, usagePrices(a.config))
+
 	summary := service.NewSummary(
 		a.clients.AudioTranscript,
 		a.clients.Summarize,
-		db.NewSummary(a.config.GetString("database.url")),
+		summaryRepository,
+		bus,
+		usage,
+		a.clients.BlobStore,
+		a.clients.Downloader,
+		time.Duration(a.config.GetFloat64("upload.maxDurationMinutes")*float64(time.Minute)),
+		service.ResponseValidation{
+			MaxRepairs: a.config.GetInt("summarize.validation.maxRepairs"),
+			Language:   a.config.GetString("summarize.validation.language"),
+		},
 	)
 
-	api.NewExplicaServer(summary).Register(a.server)
+	export := service.NewExport(
+		summaryRepository,
+		render.NewRenderer(a.config.GetString("export.templates.dir")),
+	)
+
+	var summaries service.SummaryUseCase = summary
+	if a.config.GetBool("budget.enabled") {
+		summaries = service.NewSummaryBudget(
+			summary,
+			summaryRepository,
+			usageRepository,
+			bus,
+			service.BudgetLimit{
+				AudioMinutes: a.config.GetFloat64("budget.monthly.audioMinutes"),
+				Tokens:       a.config.GetInt64("budget.monthly.tokens"),
+			},
+			budgetOverrides(a.config),
+		)
+	}
+
+	authorizedSummaries := service.NewSummaryAuthorization(summaries)
+	uploadMaxSize := a.config.GetInt64("upload.maxSize")
+
+	api.NewExplicaServer(authorizedSummaries, uploadMaxSize).Register(a.server)
+	api.NewTusServer(
+		service.NewUploadAuthorization(
+			service.NewUpload(filestore.NewUploadStore(a.config.GetString("upload.dir")), authorizedSummaries, uploadMaxSize),
+		),
+		uploadMaxSize,
+	).Register(a.server)
+	api.NewExportServer(service.NewExportAuthorization(export)).Register(a.server)
+	api.NewAudioServer(service.NewAudioAuthorization(service.NewAudio(summaryRepository, a.clients.BlobStore))).Register(a.server)
+	api.NewWebhookServer(service.NewWebhookAuthorization(service.NewWebhook(webhookRepository))).Register(a.server)
+	api.NewAPIKeyServer(service.NewAPIKeyAuthorization(a.apiKeys)).Register(a.server)
+	api.NewUsageServer(service.NewUsageAuthorization(usage)).Register(a.server)
+	api.NewEventServer(
+		service.NewEventAuthorization(service.NewEvents(summaryRepository, bus)),
+		time.Duration(a.config.GetInt64("events.heartbeat"))*time.Millisecond,
+	).Register(a.server)
 }
 
-func initMiddlewares(server *echo.Echo, logger *zap.Logger) {
+// usagePrices reads the price table from a JSON string so it can be replaced
+// through the USAGE_PRICES environment variable.
+func usagePrices(config *viper.Viper) map[string]service.ModelPrice {
+	prices := map[string]service.ModelPrice{}
+
+	if err := json.Unmarshal([]byte(config.GetString("usage.prices")), &prices); err != nil {
+		log.LogError(context.Background(), "invalid usage prices, costs will not be computed", err)
+		return map[string]service.ModelPrice{}
+	}
+
+	return prices
+}
+
+// budgetOverrides reads the monthly budgets of specific callers, keyed by api
+// key id or token user id, from the BUDGET_OVERRIDES JSON string.
+func budgetOverrides(config *viper.Viper) map[uuid.UUID]service.BudgetLimit {
+	overrides := map[uuid.UUID]service.BudgetLimit{}
+
+	if err := json.Unmarshal([]byte(config.GetString("budget.overrides")), &overrides); err != nil {
+		log.LogError(context.Background(), "invalid budget overrides, using the default budget", err)
+		return map[uuid.UUID]service.BudgetLimit{}
+	}
+
+	return overrides
+}
+
+func initMiddlewares(
+	server *echo.Echo,
+	logger *zap.Logger,
+	config *viper.Viper,
+	apiKeys service.APIKeyUseCase,
+	tokens service.TokenUseCase,
+) {
 	server.Use(middleware.Recover())
 	server.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
+		AllowOrigins: splitList(config.GetString("server.cors.allowOrigins")),
+		AllowMethods: []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+		AllowHeaders: []string{
+			echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, apiKeyHeader,
+			"Tus-Resumable", "Upload-Length", "Upload-Offset", "Upload-Metadata",
+		},
+		ExposeHeaders: []string{
+			echo.HeaderContentDisposition, echo.HeaderRetryAfter,
+			headerRateLimitLimit, headerRateLimitRemaining, headerRateLimitReset,
+			echo.HeaderContentLength, "Content-Range", "Accept-Ranges",
+			echo.HeaderLocation, "Tus-Resumable", "Tus-Version", "Tus-Extension", "Tus-Max-Size",
+			"Upload-Length", "Upload-Offset", "Upload-Metadata", "X-Summary-Id",
+		},
 	}))
 	server.Use(loggerMiddleware(logger))
+	server.Use(authMiddleware(apiKeys, tokens, config.GetBool("auth.enabled")))
+
+	if config.GetBool("ratelimit.enabled") {
+		limiter := ratelimit.NewLimiter(
+			config.GetInt("ratelimit.ai.capacity"),
+			config.GetFloat64("ratelimit.ai.refillPerMinute"),
+		)
+		server.Use(rateLimitMiddleware(limiter, aiRoutes))
+	}
 }
 
 func loggerMiddleware(logger *zap.Logger) echo.MiddlewareFunc {
@@ -85,3 +250,114 @@ func loggerMiddleware(logger *zap.Logger) echo.MiddlewareFunc {
 		}
 	}
 }
+
+// authMiddleware authenticates every request by its api key or, when an
+// identity provider is configured, by its JWT bearer token, and stores the
+// resulting principal in the request context. With authentication disabled
+// all requests share an anonymous admin principal.
+func authMiddleware(apiKeys service.APIKeyUseCase, tokens service.TokenUseCase, enabled bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			if !enabled {
+				c.SetRequest(c.Request().WithContext(auth.WithPrincipal(ctx, anonymousPrincipal)))
+				return next(c)
+			}
+
+			authenticate := apiKeys.Authenticate
+			credential := credentialFromRequest(c)
+			if tokens != nil && isJWT(credential) {
+				authenticate = tokens.Authenticate
+			}
+
+			principal, err := authenticate(ctx, credential)
+			if err != nil {
+				return errors.Handle(c, err)
+			}
+
+			c.SetRequest(c.Request().WithContext(auth.WithPrincipal(ctx, *principal)))
+
+			return next(c)
+		}
+	}
+}
+
+// credentialFromRequest reads the api key or token from the X-API-Key header
+// or a bearer Authorization header. EventSource cannot set headers, so event
+// streams may also send it in the api_key query parameter.
+func credentialFromRequest(c echo.Context) string {
+	request := c.Request()
+
+	if key := request.Header.Get(apiKeyHeader); key != "" {
+		return key
+	}
+
+	if authorization := request.Header.Get(echo.HeaderAuthorization); strings.HasPrefix(authorization, bearerPrefix) {
+		return strings.TrimPrefix(authorization, bearerPrefix)
+	}
+
+	// EventSource and audio elements can not set headers, so event streams and
+	// audio playback also accept the key as a query parameter
+	if strings.Contains(request.Header.Get(echo.HeaderAccept), mimeTextEventStream) || c.Path() == audioRoute {
+		return c.QueryParam(apiKeyQueryParam)
+	}
+
+	return ""
+}
+
+// rateLimitMiddleware spends a token of the client bucket on every request to
+// the limited routes, reporting the bucket state in X-RateLimit-* headers.
+func rateLimitMiddleware(limiter *ratelimit.Limiter, routes map[string]bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !routes[c.Request().Method+" "+c.Path()] {
+				return next(c)
+			}
+
+			result := limiter.Allow(rateLimitKey(c))
+
+			header := c.Response().Header()
+			header.Set(headerRateLimitLimit, strconv.Itoa(result.Limit))
+			header.Set(headerRateLimitRemaining, strconv.Itoa(result.Remaining))
+			header.Set(headerRateLimitReset, strconv.Itoa(seconds(result.Reset)))
+
+			if !result.Allowed {
+				header.Set(echo.HeaderRetryAfter, strconv.Itoa(seconds(result.RetryAfter)))
+				return errors.Handle(c, application.RateLimitExceeded)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// rateLimitKey identifies the client by its authenticated principal, falling
+// back to the remote address for anonymous requests.
+func rateLimitKey(c echo.Context) string {
+	if principal, ok := auth.PrincipalFromContext(c.Request().Context()); ok && principal.KeyID != uuid.Nil {
+		return "key:" + principal.KeyID.String()
+	}
+
+	return "ip:" + c.RealIP()
+}
+
+func seconds(duration time.Duration) int {
+	return int(math.Ceil(duration.Seconds()))
+}
+
+// isJWT tells compact JWTs, made of three dot separated segments, apart from
+// api keys.
+func isJWT(credential string) bool {
+	return strings.Count(credential, ".") == 2
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -1,23 +1,105 @@
 package configuration
 
 import (
+	"context"
+	"encoding/json"
+	"strings"
+
 	"github.com/diegofsousa/explicAI/internal/gateway/audiotranscript"
+	"github.com/diegofsousa/explicAI/internal/gateway/blobstore"
+	"github.com/diegofsousa/explicAI/internal/gateway/download"
+	"github.com/diegofsousa/explicAI/internal/gateway/identity"
 	"github.com/diegofsousa/explicAI/internal/gateway/summarize"
+	"github.com/diegofsousa/explicAI/internal/gateway/webhook"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients/anthropic"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/clients/chatgpt"
+	downloadclient "github.com/diegofsousa/explicAI/internal/infrastructure/clients/download"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients/failover"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients/localwhisper"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients/oidc"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients/ollama"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients/s3"
+	webhookclient "github.com/diegofsousa/explicAI/internal/infrastructure/clients/webhook"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/clients/whisper"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/filestore"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
 	"github.com/spf13/viper"
+	"go.uber.org/zap"
 )
 
 type Clients struct {
 	AudioTranscript audiotranscript.AudioTranscript
 	Summarize       summarize.Summarize
+	WebhookSender   webhook.Sender
+	TokenVerifier   identity.TokenVerifier
+	BlobStore       blobstore.BlobStore
+	Downloader      download.Downloader
 }
 
+// GetClients builds the clients from the configuration. The transcription
+// and summarization drivers may be lists, tried in order and failing over
+// when a provider is down. Clients that are configured through environment
+// variables read their full keys, as viper does not apply them to a sub tree.
 func GetClients(config *viper.Viper) *Clients {
-	return &Clients{
-		AudioTranscript: buildWhisperClient(config.Sub("whisper")),
-		Summarize:       buildChatgptClient(config.Sub("chatgpt")),
+	clients := &Clients{
+		AudioTranscript: buildAudioTranscript(config),
+		Summarize:       buildSummarize(config),
+		WebhookSender:   buildWebhookClient(config.Sub("webhook")),
+		BlobStore:       buildBlobStore(config),
+		Downloader:      buildDownloadClient(config),
+	}
+
+	if config.GetBool("auth.oidc.enabled") {
+		clients.TokenVerifier = buildOIDCClient(config)
+	}
+
+	return clients
+}
+
+func buildAudioTranscript(config *viper.Viper) audiotranscript.AudioTranscript {
+	drivers := splitList(config.GetString("whisper.driver"))
+	if len(drivers) < 2 {
+		return buildTranscriptDriver(config, strings.Join(drivers, ""))
+	}
+
+	weights := routeWeights(config, "whisper.weights")
+	providers := make([]failover.Provider[audiotranscript.AudioTranscript], 0, len(drivers))
+	for _, driver := range drivers {
+		providers = append(providers, failover.Provider[audiotranscript.AudioTranscript]{
+			Name:   driver,
+			Weight: weights[driver],
+			Client: buildTranscriptDriver(config, driver),
+		})
+	}
+
+	return failover.NewAudioTranscript(providers)
+}
+
+func buildTranscriptDriver(config *viper.Viper, driver string) audiotranscript.AudioTranscript {
+	switch driver {
+	case "local":
+		return localwhisper.NewClient(
+			config.GetString("whisper.local.name"),
+			config.GetString("whisper.local.host"),
+			config.GetString("whisper.local.server"),
+			config.GetString("whisper.local.apiKey"),
+			config.GetString("whisper.local.model"),
+			config.GetString("whisper.local.language"),
+			config.GetInt64("whisper.local.timeout"),
+		)
+	case "azure":
+		return whisper.NewAzureClient(
+			config.GetString("whisper.azure.name"),
+			config.GetString("whisper.azure.host"),
+			config.GetString("whisper.azure.apiKey"),
+			config.GetString("whisper.azure.deployment"),
+			config.GetString("whisper.azure.apiVersion"),
+			config.GetString("whisper.azure.model"),
+			config.GetInt64("whisper.azure.timeout"),
+		)
 	}
+
+	return buildWhisperClient(config.Sub("whisper"))
 }
 
 func buildWhisperClient(config *viper.Viper) audiotranscript.AudioTranscript {
@@ -30,6 +112,77 @@ func buildWhisperClient(config *viper.Viper) audiotranscript.AudioTranscript {
 	)
 }
 
+func buildSummarize(config *viper.Viper) summarize.Summarize {
+	drivers := splitList(config.GetString("summarize.driver"))
+	if len(drivers) < 2 {
+		return buildSummarizeDriver(config, strings.Join(drivers, ""))
+	}
+
+	weights := routeWeights(config, "summarize.weights")
+	providers := make([]failover.Provider[summarize.Summarize], 0, len(drivers))
+	for _, driver := range drivers {
+		providers = append(providers, failover.Provider[summarize.Summarize]{
+			Name:   driver,
+			Weight: weights[driver],
+			Client: buildSummarizeDriver(config, driver),
+		})
+	}
+
+	return failover.NewSummarize(providers)
+}
+
+func buildSummarizeDriver(config *viper.Viper, driver string) summarize.Summarize {
+	switch driver {
+	case "ollama":
+		return ollama.NewClient(
+			config.GetString("summarize.ollama.name"),
+			config.GetString("summarize.ollama.host"),
+			config.GetString("summarize.ollama.model"),
+			config.GetInt("summarize.ollama.contextSize"),
+			config.GetInt64("summarize.ollama.timeout"),
+		)
+	case "anthropic":
+		return buildAnthropicClient(config.Sub("anthropic"))
+	case "azure":
+		return chatgpt.NewAzureClient(
+			config.GetString("summarize.azure.name"),
+			config.GetString("summarize.azure.host"),
+			config.GetString("summarize.azure.apiKey"),
+			config.GetString("summarize.azure.deployment"),
+			config.GetString("summarize.azure.apiVersion"),
+			config.GetString("summarize.azure.model"),
+			config.GetInt64("summarize.azure.timeout"),
+		)
+	}
+
+	return buildChatgptClient(config.Sub("chatgpt"))
+}
+
+// routeWeights reads the share of the calls each driver serves first, from a
+// JSON string such as {"chatgpt":90,"anthropic":10}.
+func routeWeights(config *viper.Viper, key string) map[string]int {
+	weights := map[string]int{}
+
+	if err := json.Unmarshal([]byte(config.GetString(key)), &weights); err != nil {
+		log.LogError(context.Background(), "invalid route weights, using the configured order", err, zap.String("key", key))
+		return map[string]int{}
+	}
+
+	return weights
+}
+
+func buildAnthropicClient(config *viper.Viper) summarize.Summarize {
+	return anthropic.NewClient(
+		config.GetString("name"),
+		config.GetString("host"),
+		anthropicApiKey,
+		config.GetString("model"),
+		config.GetInt("maxTokens"),
+		config.GetInt("fullTextMaxTokens"),
+		config.GetInt64("timeout"),
+	)
+}
+
 func buildChatgptClient(config *viper.Viper) summarize.Summarize {
 	return chatgpt.NewClient(
 		config.GetString("name"),
@@ -39,3 +192,45 @@ func buildChatgptClient(config *viper.Viper) summarize.Summarize {
 		config.GetInt64("timeout"),
 	)
 }
+
+func buildWebhookClient(config *viper.Viper) webhook.Sender {
+	return webhookclient.NewClient(
+		config.GetString("name"),
+		config.GetInt64("timeout"),
+	)
+}
+
+func buildDownloadClient(config *viper.Viper) download.Downloader {
+	return downloadclient.NewClient(
+		config.GetString("download.name"),
+		config.GetInt64("download.maxSize"),
+		config.GetInt64("download.timeout"),
+	)
+}
+
+func buildOIDCClient(config *viper.Viper) identity.TokenVerifier {
+	return oidc.NewClient(
+		config.GetString("auth.oidc.name"),
+		config.GetString("auth.oidc.issuer"),
+		config.GetString("auth.oidc.audience"),
+		config.GetString("auth.oidc.jwksUrl"),
+		config.GetInt64("auth.oidc.refreshInterval"),
+		config.GetInt64("auth.oidc.timeout"),
+	)
+}
+
+func buildBlobStore(config *viper.Viper) blobstore.BlobStore {
+	if config.GetString("blob.driver") == "s3" {
+		return s3.NewClient(
+			config.GetString("blob.s3.name"),
+			config.GetString("blob.s3.endpoint"),
+			config.GetString("blob.s3.region"),
+			config.GetString("blob.s3.bucket"),
+			config.GetString("blob.s3.accessKey"),
+			config.GetString("blob.s3.secretKey"),
+			config.GetInt64("blob.s3.timeout"),
+		)
+	}
+
+	return filestore.NewStore(config.GetString("blob.local.dir"))
+}
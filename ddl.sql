@@ -1,3 +1,12 @@
+CREATE TABLE workspaces (
+    id SERIAL PRIMARY KEY,
+    external_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    name VARCHAR(255) NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_workspaces_external_id ON workspaces(external_id);
+
 CREATE TABLE summaries (
     id SERIAL PRIMARY KEY,
     external_id UUID NOT NULL,
@@ -9,7 +18,84 @@ CREATE TABLE summaries (
     brief_resume TEXT,
     medium_resume TEXT,
     progress int,
-    fulltext TEXT
+    fulltext TEXT,
+    workspace_id UUID NOT NULL,
+    audio_key VARCHAR(255),
+    audio_content_type VARCHAR(100),
+    audio_duration_seconds DOUBLE PRECISION,
+    audio_codec VARCHAR(50),
+    audio_bitrate INT,
+    audio_sample_rate INT,
+    audio_channels INT,
+    audio_sha256 VARCHAR(64)
 );
 
 CREATE INDEX idx_external_id ON summaries(external_id);
+CREATE INDEX idx_summaries_workspace_id ON summaries(workspace_id);
+CREATE INDEX idx_summaries_audio_sha256 ON summaries(workspace_id, audio_sha256);
+
+CREATE TABLE webhooks (
+    id SERIAL PRIMARY KEY,
+    external_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    url TEXT NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    statuses TEXT[] NOT NULL DEFAULT '{}',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    workspace_id UUID NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_webhooks_external_id ON webhooks(external_id);
+CREATE INDEX idx_webhooks_workspace_id ON webhooks(workspace_id);
+
+CREATE TABLE webhook_deliveries (
+    id SERIAL PRIMARY KEY,
+    external_id UUID NOT NULL,
+    webhook_id INT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    attempt INT NOT NULL,
+    payload TEXT NOT NULL,
+    status_code INT,
+    error TEXT,
+    delivered BOOLEAN NOT NULL
+);
+
+CREATE INDEX idx_webhook_deliveries_webhook_id ON webhook_deliveries(webhook_id);
+
+CREATE TABLE api_keys (
+    id SERIAL PRIMARY KEY,
+    external_id UUID NOT NULL,
+    workspace_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL,
+    role VARCHAR(16) NOT NULL DEFAULT 'editor',
+    revoked_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_api_keys_external_id ON api_keys(external_id);
+CREATE UNIQUE INDEX idx_api_keys_key_hash ON api_keys(key_hash);
+CREATE INDEX idx_api_keys_workspace_id ON api_keys(workspace_id);
+
+CREATE TABLE usage_records (
+    id SERIAL PRIMARY KEY,
+    external_id UUID NOT NULL,
+    summary_id UUID NOT NULL,
+    workspace_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    operation VARCHAR(32) NOT NULL,
+    model VARCHAR(100) NOT NULL,
+    provider VARCHAR(100) NOT NULL DEFAULT '',
+    prompt_tokens INT NOT NULL DEFAULT 0,
+    completion_tokens INT NOT NULL DEFAULT 0,
+    audio_seconds NUMERIC(12, 3) NOT NULL DEFAULT 0,
+    cost NUMERIC(14, 6) NOT NULL DEFAULT 0,
+    validation_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_usage_records_workspace_id_created_at ON usage_records(workspace_id, created_at);
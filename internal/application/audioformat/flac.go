@@ -0,0 +1,36 @@
+package audioformat
+
+import (
+	"io"
+)
+
+// probeFLAC reads the STREAMINFO block, which the specification requires to
+// be the first metadata block.
+func probeFLAC(r io.ReadSeeker) (Metadata, error) {
+	block := make([]byte, 4+34)
+	if err := readAt(r, 4, block); err != nil {
+		return Metadata{}, err
+	}
+
+	if block[0]&0x7F != 0 || uint24(block[1:4]) < 34 {
+		return Metadata{}, errMalformed
+	}
+
+	info := block[4:]
+	sampleRate := int(info[10])<<12 | int(info[11])<<4 | int(info[12])>>4
+	channels := int((info[12]>>1)&0x07) + 1
+	samples := int64(info[13]&0x0F)<<32 | int64(be.Uint32(info[14:]))
+
+	metadata := Metadata{
+		Codec:      "flac",
+		SampleRate: sampleRate,
+		Channels:   channels,
+	}
+
+	// zero samples means the encoder did not know the length
+	if sampleRate > 0 && samples > 0 {
+		metadata.Duration = seconds(float64(samples) / float64(sampleRate))
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,103 @@
+package audioformat
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/pkg/errors"
+)
+
+// Format is an audio container accepted by the transcription provider.
+type Format struct {
+	Name        string
+	ContentType string
+	Extensions  []string
+}
+
+var (
+	MP3  = Format{Name: "mp3", ContentType: "audio/mpeg", Extensions: []string{".mp3", ".mpeg", ".mpga"}}
+	WAV  = Format{Name: "wav", ContentType: "audio/wav", Extensions: []string{".wav"}}
+	MP4  = Format{Name: "mp4", ContentType: "audio/mp4", Extensions: []string{".m4a", ".mp4"}}
+	WebM = Format{Name: "webm", ContentType: "audio/webm", Extensions: []string{".webm"}}
+	OGG  = Format{Name: "ogg", ContentType: "audio/ogg", Extensions: []string{".ogg", ".oga"}}
+	FLAC = Format{Name: "flac", ContentType: "audio/flac", Extensions: []string{".flac"}}
+
+	formats = []Format{MP3, WAV, MP4, WebM, OGG, FLAC}
+)
+
+// Detect recognizes the container from the magic bytes at the start of the
+// content.
+func Detect(header []byte) (Format, bool) {
+	switch {
+	case bytes.HasPrefix(header, []byte("ID3")), isMPEGFrame(header):
+		return MP3, true
+	case bytes.HasPrefix(header, []byte("RIFF")) && len(header) >= 12 && string(header[8:12]) == "WAVE":
+		return WAV, true
+	case len(header) >= 8 && string(header[4:8]) == "ftyp":
+		return MP4, true
+	case bytes.HasPrefix(header, []byte{0x1A, 0x45, 0xDF, 0xA3}):
+		return WebM, true
+	case bytes.HasPrefix(header, []byte("OggS")):
+		return OGG, true
+	case bytes.HasPrefix(header, []byte("fLaC")):
+		return FLAC, true
+	}
+
+	return Format{}, false
+}
+
+// Validate detects the format of the content and, when a filename is given,
+// checks that its extension matches the detected container.
+func Validate(filename string, header []byte) (Format, error) {
+	format, ok := Detect(header)
+	if !ok {
+		return Format{}, application.UnsupportedAudioFormat
+	}
+
+	if filename != "" && !format.hasExtension(filepath.Ext(filename)) {
+		return Format{}, errors.Wrapf(application.AudioFormatMismatch, "%s has %s content", filepath.Base(filename), format.Name)
+	}
+
+	return format, nil
+}
+
+// Filename keeps the given name when its extension matches the format, as
+// providers pick the decoder by extension, and builds one otherwise.
+func (f Format) Filename(name string) string {
+	if name != "" && f.hasExtension(filepath.Ext(name)) {
+		return filepath.Base(name)
+	}
+
+	return "audio" + f.Extensions[0]
+}
+
+// IsSupportedExtension reports whether a file extension belongs to one of
+// the accepted formats.
+func IsSupportedExtension(extension string) bool {
+	for _, format := range formats {
+		if format.hasExtension(extension) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f Format) hasExtension(extension string) bool {
+	extension = strings.ToLower(extension)
+	for _, candidate := range f.Extensions {
+		if candidate == extension {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isMPEGFrame matches the sync word of an MPEG audio frame without an ID3
+// tag, excluding layer 00 used by AAC ADTS streams.
+func isMPEGFrame(header []byte) bool {
+	return len(header) >= 2 && header[0] == 0xFF && header[1]&0xE0 == 0xE0 && header[1]&0x06 != 0
+}
@@ -0,0 +1,81 @@
+package audioformat
+
+import (
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/stretchr/testify/suite"
+)
+
+type FormatTestSuite struct {
+	suite.Suite
+}
+
+func TestFormat(t *testing.T) {
+	suite.Run(t, new(FormatTestSuite))
+}
+
+func (s *FormatTestSuite) TestDetect() {
+	cases := map[string]struct {
+		header []byte
+		format Format
+	}{
+		"mp3 with id3 tag": {[]byte("ID3\x04\x00\x00\x00\x00\x00\x00"), MP3},
+		"mp3 frame":        {[]byte{0xFF, 0xFB, 0x90, 0x64}, MP3},
+		"wav":              {[]byte("RIFF\x24\x08\x00\x00WAVEfmt "), WAV},
+		"m4a":              {[]byte("\x00\x00\x00\x20ftypM4A \x00\x00\x00\x00"), MP4},
+		"mp4":              {[]byte("\x00\x00\x00\x18ftypisom\x00\x00\x02\x00"), MP4},
+		"webm":             {[]byte{0x1A, 0x45, 0xDF, 0xA3, 0x9F, 0x42, 0x86, 0x81}, WebM},
+		"ogg":              {[]byte("OggS\x00\x02\x00\x00"), OGG},
+		"flac":             {[]byte("fLaC\x00\x00\x00\x22"), FLAC},
+	}
+
+	for name, c := range cases {
+		s.Run(name, func() {
+			format, ok := Detect(c.header)
+			s.Require().True(ok)
+			s.Equal(c.format.Name, format.Name)
+		})
+	}
+
+	s.Run("unknown content", func() {
+		for _, header := range [][]byte{nil, []byte("<html>"), []byte("RIFF\x24\x08\x00\x00AVI "), {0xFF, 0xF1, 0x50, 0x80}} {
+			_, ok := Detect(header)
+			s.False(ok)
+		}
+	})
+}
+
+func (s *FormatTestSuite) TestValidate() {
+	wav := []byte("RIFF\x24\x08\x00\x00WAVEfmt ")
+
+	s.Run("matching extension", func() {
+		format, err := Validate("Reunião.WAV", wav)
+		s.Require().NoError(err)
+		s.Equal("audio/wav", format.ContentType)
+		s.Equal("Reunião.WAV", format.Filename("Reunião.WAV"))
+	})
+
+	s.Run("without filename", func() {
+		format, err := Validate("", wav)
+		s.Require().NoError(err)
+		s.Equal("audio.wav", format.Filename(""))
+	})
+
+	s.Run("mismatched extension", func() {
+		_, err := Validate("meeting.mp3", wav)
+		s.Require().ErrorIs(err, application.AudioFormatMismatch)
+		s.Equal("meeting.mp3 has wav content: audio content does not match the file extension", err.Error())
+	})
+
+	s.Run("unsupported content", func() {
+		_, err := Validate("meeting.mp3", []byte("plain text"))
+		s.Require().ErrorIs(err, application.UnsupportedAudioFormat)
+	})
+
+	s.Run("supported extensions", func() {
+		s.True(IsSupportedExtension(".M4A"))
+		s.True(IsSupportedExtension(".flac"))
+		s.False(IsSupportedExtension(".txt"))
+	})
+}
@@ -0,0 +1,86 @@
+package audioformat
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Metadata describes the audio stream read from the container headers.
+// Fields the container does not declare are left zeroed.
+type Metadata struct {
+	Duration   time.Duration
+	Codec      string
+	Bitrate    int
+	SampleRate int
+	Channels   int
+}
+
+var errMalformed = errors.New("malformed audio container")
+
+// Probe reads the container headers of an audio already detected as format,
+// seeking only to the parts that describe the stream instead of decoding it.
+func Probe(r io.ReadSeeker, size int64, format Format) (Metadata, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return Metadata{}, err
+	}
+
+	var (
+		metadata Metadata
+		err      error
+	)
+
+	switch format.Name {
+	case MP3.Name:
+		metadata, err = probeMPEG(r, size)
+	case WAV.Name:
+		metadata, err = probeWAV(r, size)
+	case FLAC.Name:
+		metadata, err = probeFLAC(r)
+	case OGG.Name:
+		metadata, err = probeOGG(r, size)
+	case MP4.Name:
+		metadata, err = probeMP4(r, size)
+	case WebM.Name:
+		metadata, err = probeWebM(r, size)
+	default:
+		return Metadata{}, errors.Errorf("metadata of %s is not supported", format.Name)
+	}
+
+	if err != nil {
+		return Metadata{}, errors.Wrapf(err, "probe %s", format.Name)
+	}
+
+	// containers without a declared bitrate report the average one
+	if metadata.Bitrate == 0 && metadata.Duration > 0 {
+		metadata.Bitrate = int(float64(size*8) / metadata.Duration.Seconds())
+	}
+
+	return metadata, nil
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
+
+func readAt(r io.ReadSeeker, offset int64, buf []byte) error {
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	_, err := io.ReadFull(r, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errMalformed
+	}
+
+	return err
+}
+
+func uint24(b []byte) uint32 {
+	return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+}
+
+var le = binary.LittleEndian
+var be = binary.BigEndian
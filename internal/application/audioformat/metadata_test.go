@@ -0,0 +1,267 @@
+package audioformat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MetadataTestSuite struct {
+	suite.Suite
+}
+
+func TestMetadata(t *testing.T) {
+	suite.Run(t, new(MetadataTestSuite))
+}
+
+func (s *MetadataTestSuite) TestProbe() {
+	cases := map[string]struct {
+		content  []byte
+		format   Format
+		metadata Metadata
+	}{
+		"constant bitrate mp3": {
+			content:  mp3CBR(),
+			format:   MP3,
+			metadata: Metadata{Duration: time.Second, Codec: "mp3", Bitrate: 128000, SampleRate: 44100, Channels: 2},
+		},
+		"variable bitrate mp3": {
+			content:  mp3Xing(),
+			format:   MP3,
+			metadata: Metadata{Duration: 2612244897, Codec: "mp3", Bitrate: 1277, SampleRate: 44100, Channels: 1},
+		},
+		"wav": {
+			content:  wav(16000),
+			format:   WAV,
+			metadata: Metadata{Duration: 500 * time.Millisecond, Codec: "pcm", Bitrate: 256000, SampleRate: 16000, Channels: 1},
+		},
+		"streamed wav without data size": {
+			content:  wav(math.MaxUint32),
+			format:   WAV,
+			metadata: Metadata{Duration: 500 * time.Millisecond, Codec: "pcm", Bitrate: 256000, SampleRate: 16000, Channels: 1},
+		},
+		"flac": {
+			content:  flac(),
+			format:   FLAC,
+			metadata: Metadata{Duration: 10 * time.Second, Codec: "flac", Bitrate: 33, SampleRate: 44100, Channels: 2},
+		},
+		"ogg opus": {
+			content:  oggOpus(),
+			format:   OGG,
+			metadata: Metadata{Duration: 3 * time.Second, Codec: "opus", Bitrate: 240, SampleRate: 48000, Channels: 2},
+		},
+		"m4a with moov after the media data": {
+			content:  m4a(),
+			format:   MP4,
+			metadata: Metadata{Duration: 4 * time.Second, Codec: "aac", Bitrate: 608, SampleRate: 44100, Channels: 2},
+		},
+		"webm": {
+			content:  webm(),
+			format:   WebM,
+			metadata: Metadata{Duration: 2500 * time.Millisecond, Codec: "opus", Bitrate: 259, SampleRate: 48000, Channels: 1},
+		},
+	}
+
+	for name, c := range cases {
+		s.Run(name, func() {
+			metadata, err := Probe(bytes.NewReader(c.content), int64(len(c.content)), c.format)
+			s.Require().NoError(err)
+			s.Equal(c.metadata, metadata)
+		})
+	}
+
+	s.Run("truncated content", func() {
+		content := wav(16000)[:20]
+		_, err := Probe(bytes.NewReader(content), int64(len(content)), WAV)
+		s.Require().Error(err)
+	})
+
+	s.Run("content without frames", func() {
+		content := []byte("ID3\x04\x00\x00\x00\x00\x00\x00plain text")
+		_, err := Probe(bytes.NewReader(content), int64(len(content)), MP3)
+		s.Require().Error(err)
+	})
+
+	// containers nested millions of times would overflow the stack if the
+	// parsers followed them
+	s.Run("deeply nested mp4 boxes", func() {
+		content := nestedMP4(1 << 22)
+		_, err := Probe(bytes.NewReader(content), int64(len(content)), MP4)
+		s.Require().ErrorIs(err, errMalformed)
+	})
+
+	s.Run("deeply nested webm elements", func() {
+		content := nestedWebM(1 << 22)
+		_, err := Probe(bytes.NewReader(content), int64(len(content)), WebM)
+		s.Require().ErrorIs(err, errMalformed)
+	})
+}
+
+func writeLE(buf *bytes.Buffer, values ...any) {
+	for _, value := range values {
+		binary.Write(buf, binary.LittleEndian, value)
+	}
+}
+
+func mp3CBR() []byte {
+	content := []byte("ID3\x04\x00\x00\x00\x00\x00\x00")
+	content = append(content, 0xFF, 0xFB, 0x90, 0x64)
+	return append(content, make([]byte, 16000-4)...)
+}
+
+func mp3Xing() []byte {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0xC4})
+	copy(frame[21:], "Xing\x00\x00\x00\x01\x00\x00\x00\x64")
+	return frame
+}
+
+func wav(dataSize uint32) []byte {
+	content := new(bytes.Buffer)
+	content.WriteString("RIFF\x00\x00\x00\x00WAVEfmt ")
+	writeLE(content, uint32(16), uint16(1), uint16(1), uint32(16000), uint32(32000), uint16(2), uint16(16))
+	content.WriteString("data")
+	binary.Write(content, binary.LittleEndian, dataSize)
+	content.Write(make([]byte, min(dataSize, 16000)))
+	return content.Bytes()
+}
+
+func flac() []byte {
+	info := make([]byte, 34)
+	info[10], info[11] = 0x0A, 0xC4
+	info[12] = 0x4<<4 | 1<<1
+	info[13] = 0xF << 4
+	binary.BigEndian.PutUint32(info[14:], 441000)
+
+	content := append([]byte("fLaC\x80\x00\x00\x22"), info...)
+	return content
+}
+
+func oggPage(granule uint64, packet []byte) []byte {
+	page := new(bytes.Buffer)
+	page.WriteString("OggS\x00\x02")
+	writeLE(page, granule, uint32(1), uint32(0), uint32(0))
+	page.WriteByte(1)
+	page.WriteByte(byte(len(packet)))
+	page.Write(packet)
+	return page.Bytes()
+}
+
+func oggOpus() []byte {
+	head := new(bytes.Buffer)
+	head.WriteString("OpusHead\x01\x02")
+	writeLE(head, uint16(312), uint32(16000), uint16(0), uint8(0))
+
+	content := oggPage(0, head.Bytes())
+	content = append(content, make([]byte, 10)...)
+	return append(content, oggPage(48000*3+312, []byte("audio"))...)
+}
+
+func box(kind string, content ...[]byte) []byte {
+	data := bytes.Join(content, nil)
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint32(header, uint32(len(data)+8))
+	copy(header[4:], kind)
+	return append(header, data...)
+}
+
+func mediaHeader(timescale, duration uint32) []byte {
+	header := make([]byte, 20)
+	binary.BigEndian.PutUint32(header[12:], timescale)
+	binary.BigEndian.PutUint32(header[16:], duration)
+	return header
+}
+
+func m4a() []byte {
+	handler := make([]byte, 24)
+	copy(handler[8:], "soun")
+
+	entry := make([]byte, 36)
+	copy(entry[4:], "mp4a")
+	binary.BigEndian.PutUint16(entry[24:], 2)
+	binary.BigEndian.PutUint32(entry[32:], 44100<<16)
+	binary.BigEndian.PutUint32(entry, uint32(len(entry)))
+
+	sampleDescription := append([]byte{0, 0, 0, 0, 0, 0, 0, 1}, entry...)
+
+	return bytes.Join([][]byte{
+		box("ftyp", []byte("M4A \x00\x00\x00\x00")),
+		box("mdat", make([]byte, 100)),
+		box("moov",
+			box("mvhd", mediaHeader(1000, 5000)),
+			box("trak",
+				box("mdia",
+					box("mdhd", mediaHeader(44100, 44100*4)),
+					box("hdlr", handler),
+					box("minf", box("stbl", box("stsd", sampleDescription))),
+				),
+			),
+		),
+	}, nil)
+}
+
+func ebml(id []byte, content ...[]byte) []byte {
+	data := bytes.Join(content, nil)
+	return append(append(id, 0x80|byte(len(data))), data...)
+}
+
+func float64Bytes(value float64) []byte {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, math.Float64bits(value))
+	return data
+}
+
+func webm() []byte {
+	segment := bytes.Join([][]byte{
+		ebml([]byte{0x15, 0x49, 0xA9, 0x66},
+			ebml([]byte{0x2A, 0xD7, 0xB1}, []byte{0x0F, 0x42, 0x40}),
+			ebml([]byte{0x44, 0x89}, float64Bytes(2500)),
+		),
+		ebml([]byte{0x16, 0x54, 0xAE, 0x6B},
+			ebml([]byte{0xAE},
+				ebml([]byte{0x83}, []byte{0x02}),
+				ebml([]byte{0x86}, []byte("A_OPUS")),
+				ebml([]byte{0xE1},
+					ebml([]byte{0xB5}, float64Bytes(48000)),
+					ebml([]byte{0x9F}, []byte{0x01}),
+				),
+			),
+		),
+		ebml([]byte{0x1F, 0x43, 0xB6, 0x75}, make([]byte, 10)),
+	}, nil)
+
+	// the segment of a live recording has an unknown size
+	content := ebml([]byte{0x1A, 0x45, 0xDF, 0xA3})
+	content = append(content, 0x18, 0x53, 0x80, 0x67, 0xFF)
+	return append(content, segment...)
+}
+
+// nestedMP4 places a track whose mdia box holds another mdia box, levels deep.
+func nestedMP4(levels int) []byte {
+	prefix := box("ftyp", []byte("M4A \x00\x00\x00\x00"))
+	nested := make([]byte, 16+8*levels)
+	for i := 0; i < levels+2; i++ {
+		kind := "mdia"
+		switch i {
+		case 0:
+			kind = "moov"
+		case 1:
+			kind = "trak"
+		}
+		binary.BigEndian.PutUint32(nested[8*i:], uint32(len(nested)-8*i))
+		copy(nested[8*i+4:], kind)
+	}
+	return append(prefix, nested...)
+}
+
+// nestedWebM places a track whose Audio element holds another Audio element,
+// levels deep. Elements of unknown size cost only two bytes each.
+func nestedWebM(levels int) []byte {
+	content := ebml([]byte{0x1A, 0x45, 0xDF, 0xA3})
+	content = append(content, 0x18, 0x53, 0x80, 0x67, 0xFF, 0x16, 0x54, 0xAE, 0x6B, 0xFF, 0xAE, 0xFF)
+	return append(content, bytes.Repeat([]byte{0xE1, 0xFF}, levels)...)
+}
@@ -0,0 +1,192 @@
+package audioformat
+
+import (
+	"io"
+	"strings"
+)
+
+var mp4Codecs = map[string]string{
+	"mp4a": "aac",
+	"alac": "alac",
+	"Opus": "opus",
+	"fLaC": "flac",
+	"ac-3": "ac3",
+	"ec-3": "eac3",
+	"samr": "amr_nb",
+	"sawb": "amr_wb",
+}
+
+// mp4TrackPath maps each container box of a track to its parent. Only this
+// path is descended, so boxes nested in themselves are not followed.
+var mp4TrackPath = map[string]string{
+	"mdia": "trak",
+	"minf": "mdia",
+	"stbl": "minf",
+}
+
+// mp4Track keeps what the boxes of an audio track declare.
+type mp4Track struct {
+	audio    bool
+	duration float64
+	metadata Metadata
+}
+
+// probeMP4 walks the box tree down to the first audio track. The moov box
+// may come after the media data, which is skipped without being read.
+func probeMP4(r io.ReadSeeker, size int64) (Metadata, error) {
+	var (
+		movieDuration float64
+		track         *mp4Track
+	)
+
+	err := mp4Boxes(r, 0, size, func(kind string, start, end int64) error {
+		if kind != "moov" {
+			return nil
+		}
+
+		return mp4Boxes(r, start, end, func(kind string, start, end int64) error {
+			switch {
+			case kind == "mvhd":
+				duration, err := mp4Duration(r, start)
+				movieDuration = duration
+				return err
+			case kind == "trak" && track == nil:
+				candidate, err := probeMP4Track(r, start, end)
+				if err == nil && candidate.audio {
+					track = candidate
+				}
+				return err
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	if track == nil {
+		return Metadata{}, errMalformed
+	}
+
+	metadata := track.metadata
+	duration := track.duration
+	if duration == 0 {
+		duration = movieDuration
+	}
+	metadata.Duration = seconds(duration)
+
+	return metadata, nil
+}
+
+func probeMP4Track(r io.ReadSeeker, start, end int64) (*mp4Track, error) {
+	track := new(mp4Track)
+
+	var visit func(parent string) func(kind string, start, end int64) error
+	visit = func(parent string) func(kind string, start, end int64) error {
+		return func(kind string, start, end int64) error {
+			if mp4TrackPath[kind] == parent {
+				return mp4Boxes(r, start, end, visit(kind))
+			}
+
+			return probeMP4TrackBox(r, track, kind, start, end)
+		}
+	}
+
+	return track, mp4Boxes(r, start, end, visit("trak"))
+}
+
+func probeMP4TrackBox(r io.ReadSeeker, track *mp4Track, kind string, start, end int64) error {
+	switch kind {
+	case "hdlr":
+		handler := make([]byte, 12)
+		if err := readAt(r, start, handler); err != nil {
+			return err
+		}
+		track.audio = string(handler[8:12]) == "soun"
+	case "mdhd":
+		duration, err := mp4Duration(r, start)
+		track.duration = duration
+		return err
+	case "stsd":
+		entry := make([]byte, 44)
+		if end-start < int64(len(entry)) {
+			return nil
+		}
+		if err := readAt(r, start, entry); err != nil {
+			return err
+		}
+
+		format := string(entry[12:16])
+		track.metadata.Codec = mp4Codecs[format]
+		if track.metadata.Codec == "" {
+			track.metadata.Codec = strings.ToLower(strings.TrimSpace(format))
+		}
+		track.metadata.Channels = int(be.Uint16(entry[32:]))
+		// the sample rate is a 16.16 fixed point number
+		track.metadata.SampleRate = int(be.Uint32(entry[40:]) >> 16)
+	}
+	return nil
+}
+
+// mp4Duration reads the timescale and duration shared by the mvhd and mdhd
+// boxes, which grow to 64 bits in version 1.
+func mp4Duration(r io.ReadSeeker, start int64) (float64, error) {
+	header := make([]byte, 32)
+	if err := readAt(r, start, header[:20]); err != nil {
+		return 0, err
+	}
+
+	if header[0] != 1 {
+		timescale, duration := be.Uint32(header[12:]), be.Uint32(header[16:])
+		if timescale == 0 {
+			return 0, nil
+		}
+		return float64(duration) / float64(timescale), nil
+	}
+
+	if err := readAt(r, start, header); err != nil {
+		return 0, err
+	}
+
+	timescale, duration := be.Uint32(header[20:]), be.Uint64(header[24:])
+	if timescale == 0 {
+		return 0, nil
+	}
+	return float64(duration) / float64(timescale), nil
+}
+
+// mp4Boxes calls fn with the type and the content range of each box between
+// start and end.
+func mp4Boxes(r io.ReadSeeker, start, end int64, fn func(kind string, start, end int64) error) error {
+	header := make([]byte, 16)
+
+	for offset := start; offset+8 <= end; {
+		if err := readAt(r, offset, header[:8]); err != nil {
+			return err
+		}
+
+		size, kind, headerSize := int64(be.Uint32(header)), string(header[4:8]), int64(8)
+
+		switch size {
+		case 0:
+			size = end - offset
+		case 1:
+			if err := readAt(r, offset+8, header[8:]); err != nil {
+				return err
+			}
+			size, headerSize = int64(be.Uint64(header[8:])), 16
+		}
+
+		if size < headerSize || offset+size > end {
+			return errMalformed
+		}
+
+		if err := fn(kind, offset+headerSize, offset+size); err != nil {
+			return err
+		}
+
+		offset += size
+	}
+
+	return nil
+}
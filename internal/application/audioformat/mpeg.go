@@ -0,0 +1,182 @@
+package audioformat
+
+import (
+	"bytes"
+	"io"
+	"time"
+)
+
+// mpegScanWindow bounds the search for the first frame after the ID3 tag,
+// as encoders may leave padding between them.
+const mpegScanWindow = 64 * 1024
+
+var (
+	mpegBitrates = map[[2]int][15]int{
+		{1, 1}: {0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+		{1, 2}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+		{1, 3}: {0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+		{2, 1}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+		{2, 2}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		{2, 3}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+	}
+	mpegSampleRates = map[int][3]int{
+		1: {44100, 48000, 32000},
+		2: {22050, 24000, 16000},
+		3: {11025, 12000, 8000},
+	}
+)
+
+type mpegFrame struct {
+	version    int // 1, 2 or 3 for MPEG 2.5
+	layer      int
+	bitrate    int
+	sampleRate int
+	channels   int
+}
+
+func (f mpegFrame) samples() int {
+	switch {
+	case f.layer == 1:
+		return 384
+	case f.layer == 3 && f.version != 1:
+		return 576
+	default:
+		return 1152
+	}
+}
+
+// sideInfoSize is where a Xing or Info header starts after the frame header.
+func (f mpegFrame) sideInfoSize() int {
+	switch {
+	case f.version == 1 && f.channels == 1:
+		return 17
+	case f.version == 1:
+		return 32
+	case f.channels == 1:
+		return 9
+	default:
+		return 17
+	}
+}
+
+// probeMPEG reads the first frame after the ID3 tag. The duration comes from
+// the frame count of a Xing or VBRI header and, for constant bitrate files
+// without one, from the size of the stream.
+func probeMPEG(r io.ReadSeeker, size int64) (Metadata, error) {
+	start, err := id3Size(r)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	if size-start < 4 {
+		return Metadata{}, errMalformed
+	}
+
+	window := make([]byte, min(int64(mpegScanWindow), size-start))
+	if err := readAt(r, start, window); err != nil {
+		return Metadata{}, err
+	}
+
+	offset, frame, ok := findMPEGFrame(window)
+	if !ok {
+		return Metadata{}, errMalformed
+	}
+
+	metadata := Metadata{
+		Codec:      "mp" + string(rune('0'+frame.layer)),
+		SampleRate: frame.sampleRate,
+		Channels:   frame.channels,
+	}
+
+	if frames, ok := vbrFrames(window[offset:], frame); ok {
+		metadata.Duration = seconds(float64(frames) * float64(frame.samples()) / float64(frame.sampleRate))
+		return metadata, nil
+	}
+
+	metadata.Bitrate = frame.bitrate
+	metadata.Duration = time.Duration(float64(size-start-int64(offset)) * 8 / float64(frame.bitrate) * float64(time.Second))
+	return metadata, nil
+}
+
+func id3Size(r io.ReadSeeker) (int64, error) {
+	header := make([]byte, 10)
+	if err := readAt(r, 0, header); err != nil || !bytes.HasPrefix(header, []byte("ID3")) {
+		return 0, nil
+	}
+
+	// the tag size is a syncsafe integer, 7 bits per byte
+	size := int64(header[6]&0x7F)<<21 | int64(header[7]&0x7F)<<14 | int64(header[8]&0x7F)<<7 | int64(header[9]&0x7F)
+	size += 10
+	if header[5]&0x10 != 0 {
+		size += 10
+	}
+
+	return size, nil
+}
+
+func findMPEGFrame(window []byte) (int, mpegFrame, bool) {
+	for offset := 0; offset+4 <= len(window); offset++ {
+		if frame, ok := parseMPEGFrame(window[offset : offset+4]); ok {
+			return offset, frame, true
+		}
+	}
+
+	return 0, mpegFrame{}, false
+}
+
+func parseMPEGFrame(header []byte) (mpegFrame, bool) {
+	if !isMPEGFrame(header) {
+		return mpegFrame{}, false
+	}
+
+	var frame mpegFrame
+
+	switch (header[1] >> 3) & 0x03 {
+	case 0:
+		frame.version = 3
+	case 2:
+		frame.version = 2
+	case 3:
+		frame.version = 1
+	default:
+		return mpegFrame{}, false
+	}
+
+	frame.layer = 4 - int((header[1]>>1)&0x03)
+
+	bitrateIndex := int(header[2] >> 4)
+	sampleRateIndex := int((header[2] >> 2) & 0x03)
+	if bitrateIndex == 0 || bitrateIndex == 15 || sampleRateIndex == 3 {
+		return mpegFrame{}, false
+	}
+
+	frame.bitrate = mpegBitrates[[2]int{min(frame.version, 2), frame.layer}][bitrateIndex] * 1000
+	frame.sampleRate = mpegSampleRates[frame.version][sampleRateIndex]
+
+	frame.channels = 2
+	if header[3]>>6 == 0x03 {
+		frame.channels = 1
+	}
+
+	return frame, true
+}
+
+// vbrFrames reads the frame count written by encoders at the first frame of
+// variable bitrate files.
+func vbrFrames(data []byte, frame mpegFrame) (uint32, bool) {
+	xing := 4 + frame.sideInfoSize()
+	if len(data) >= xing+12 {
+		tag := string(data[xing : xing+4])
+		flags := be.Uint32(data[xing+4:])
+		if (tag == "Xing" || tag == "Info") && flags&0x01 != 0 {
+			return be.Uint32(data[xing+8:]), true
+		}
+	}
+
+	const vbri = 4 + 32
+	if len(data) >= vbri+18 && string(data[vbri:vbri+4]) == "VBRI" {
+		return be.Uint32(data[vbri+14:]), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,106 @@
+package audioformat
+
+import (
+	"bytes"
+	"io"
+)
+
+const (
+	oggPageHeaderSize = 27
+	// oggMaxPageSize is a page header with 255 segments of 255 bytes, so the
+	// last page always fits in a tail of this size.
+	oggMaxPageSize  = oggPageHeaderSize + 255 + 255*255
+	opusGranuleRate = 48000
+)
+
+var oggCapture = []byte("OggS")
+
+// probeOGG reads the codec from the identification packet in the first page
+// and the duration from the granule position of the last page, which counts
+// the samples of the logical stream.
+func probeOGG(r io.ReadSeeker, size int64) (Metadata, error) {
+	first := make([]byte, min(size, oggPageHeaderSize+255+64))
+	if err := readAt(r, 0, first); err != nil {
+		return Metadata{}, err
+	}
+
+	if !bytes.HasPrefix(first, oggCapture) || len(first) < oggPageHeaderSize {
+		return Metadata{}, errMalformed
+	}
+
+	serial := le.Uint32(first[14:])
+	segments := int(first[26])
+	if len(first) < oggPageHeaderSize+segments {
+		return Metadata{}, errMalformed
+	}
+	packet := first[oggPageHeaderSize+segments:]
+
+	var (
+		metadata    Metadata
+		granuleRate int
+		preSkip     int64
+	)
+
+	switch {
+	case bytes.HasPrefix(packet, []byte("\x01vorbis")) && len(packet) >= 24:
+		metadata.Codec = "vorbis"
+		metadata.Channels = int(packet[11])
+		metadata.SampleRate = int(le.Uint32(packet[12:]))
+		metadata.Bitrate = int(int32(le.Uint32(packet[20:])))
+		granuleRate = metadata.SampleRate
+	case bytes.HasPrefix(packet, []byte("OpusHead")) && len(packet) >= 16:
+		// opus always decodes at 48 kHz, the header only keeps the input rate
+		metadata.Codec = "opus"
+		metadata.Channels = int(packet[9])
+		metadata.SampleRate = opusGranuleRate
+		preSkip = int64(le.Uint16(packet[10:]))
+		granuleRate = opusGranuleRate
+	case bytes.HasPrefix(packet, []byte("\x7FFLAC")) && len(packet) >= 17+18:
+		info := packet[17:]
+		metadata.Codec = "flac"
+		metadata.SampleRate = int(info[10])<<12 | int(info[11])<<4 | int(info[12])>>4
+		metadata.Channels = int((info[12]>>1)&0x07) + 1
+		granuleRate = metadata.SampleRate
+	default:
+		return Metadata{}, errMalformed
+	}
+
+	if metadata.Bitrate < 0 {
+		metadata.Bitrate = 0
+	}
+
+	if granuleRate > 0 {
+		if granule, ok := lastGranule(r, size, serial); ok && granule > preSkip {
+			metadata.Duration = seconds(float64(granule-preSkip) / float64(granuleRate))
+		}
+	}
+
+	return metadata, nil
+}
+
+func lastGranule(r io.ReadSeeker, size int64, serial uint32) (int64, bool) {
+	length := min(size, oggMaxPageSize)
+	tail := make([]byte, length)
+	if err := readAt(r, size-length, tail); err != nil {
+		return 0, false
+	}
+
+	for end := len(tail); end > 0; {
+		offset := bytes.LastIndex(tail[:end], oggCapture)
+		if offset < 0 {
+			break
+		}
+		end = offset
+
+		if offset+oggPageHeaderSize > len(tail) || le.Uint32(tail[offset+14:]) != serial {
+			continue
+		}
+
+		// -1 marks pages where no packet ends
+		if granule := int64(le.Uint64(tail[offset+6:])); granule >= 0 {
+			return granule, true
+		}
+	}
+
+	return 0, false
+}
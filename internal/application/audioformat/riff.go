@@ -0,0 +1,77 @@
+package audioformat
+
+import (
+	"fmt"
+	"io"
+)
+
+const waveFormatExtensible = 0xFFFE
+
+var waveCodecs = map[uint16]string{
+	0x0001: "pcm",
+	0x0002: "adpcm",
+	0x0003: "pcm_float",
+	0x0006: "alaw",
+	0x0007: "mulaw",
+	0x0011: "adpcm",
+	0x0055: "mp3",
+}
+
+// probeWAV walks the RIFF chunks until both the fmt chunk, describing the
+// samples, and the data chunk, whose size gives the duration, are found.
+func probeWAV(r io.ReadSeeker, size int64) (Metadata, error) {
+	var (
+		metadata Metadata
+		byteRate uint32
+		dataSize int64 = -1
+		hasFmt   bool
+		header   = make([]byte, 8)
+	)
+
+	for offset := int64(12); !hasFmt || dataSize < 0; {
+		if err := readAt(r, offset, header); err != nil {
+			return Metadata{}, err
+		}
+
+		id, chunkSize := string(header[:4]), int64(le.Uint32(header[4:]))
+
+		switch id {
+		case "fmt ":
+			if chunkSize < 16 {
+				return Metadata{}, errMalformed
+			}
+
+			chunk := make([]byte, min(chunkSize, 40))
+			if err := readAt(r, offset+8, chunk); err != nil {
+				return Metadata{}, err
+			}
+
+			tag := le.Uint16(chunk)
+			if tag == waveFormatExtensible && len(chunk) >= 26 {
+				tag = le.Uint16(chunk[24:])
+			}
+
+			metadata.Codec = waveCodecs[tag]
+			if metadata.Codec == "" {
+				metadata.Codec = fmt.Sprintf("wav_0x%04x", tag)
+			}
+			metadata.Channels = int(le.Uint16(chunk[2:]))
+			metadata.SampleRate = int(le.Uint32(chunk[4:]))
+			byteRate = le.Uint32(chunk[8:])
+			hasFmt = true
+		case "data":
+			// recorders that stream the file may not fill in the final size
+			dataSize = min(chunkSize, size-offset-8)
+		}
+
+		// chunks are word aligned
+		offset += 8 + chunkSize + chunkSize%2
+	}
+
+	if byteRate > 0 {
+		metadata.Bitrate = int(byteRate) * 8
+		metadata.Duration = seconds(float64(dataSize) / float64(byteRate))
+	}
+
+	return metadata, nil
+}
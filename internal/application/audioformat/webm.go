@@ -0,0 +1,266 @@
+package audioformat
+
+import (
+	"io"
+	"math"
+	"math/bits"
+	"strings"
+)
+
+const (
+	ebmlHeaderID         = 0x1A45DFA3
+	ebmlSegmentID        = 0x18538067
+	ebmlInfoID           = 0x1549A966
+	ebmlTimecodeScaleID  = 0x2AD7B1
+	ebmlDurationID       = 0x4489
+	ebmlTracksID         = 0x1654AE6B
+	ebmlTrackEntryID     = 0xAE
+	ebmlTrackTypeID      = 0x83
+	ebmlCodecID          = 0x86
+	ebmlAudioID          = 0xE1
+	ebmlSamplingFreqID   = 0xB5
+	ebmlChannelsID       = 0x9F
+	ebmlClusterID        = 0x1F43B675
+	ebmlAudioTrack       = 2
+	ebmlDefaultTimescale = 1_000_000
+)
+
+var webmCodecs = map[string]string{
+	"A_OPUS":    "opus",
+	"A_VORBIS":  "vorbis",
+	"A_AAC":     "aac",
+	"A_FLAC":    "flac",
+	"A_MPEG/L3": "mp3",
+}
+
+type ebmlElement struct {
+	id    uint32
+	start int64
+	end   int64
+}
+
+// probeWebM reads the Info and Tracks elements of the segment, which come
+// before the first cluster. Recordings made by browsers usually do not
+// declare the duration, which is then left unknown.
+func probeWebM(r io.ReadSeeker, size int64) (Metadata, error) {
+	header, err := readEBMLElement(r, 0, size)
+	if err != nil || header.id != ebmlHeaderID {
+		return Metadata{}, errMalformed
+	}
+
+	segment, err := readEBMLElement(r, header.end, size)
+	if err != nil || segment.id != ebmlSegmentID {
+		return Metadata{}, errMalformed
+	}
+
+	var (
+		metadata  Metadata
+		timescale uint64 = ebmlDefaultTimescale
+		duration  float64
+		hasTrack  bool
+	)
+
+	err = ebmlChildren(r, segment, func(element ebmlElement) (bool, error) {
+		switch element.id {
+		case ebmlInfoID:
+			return true, ebmlChildren(r, element, func(child ebmlElement) (bool, error) {
+				var err error
+				switch child.id {
+				case ebmlTimecodeScaleID:
+					timescale, err = readEBMLUint(r, child)
+				case ebmlDurationID:
+					duration, err = readEBMLFloat(r, child)
+				}
+				return true, err
+			})
+		case ebmlTracksID:
+			return true, ebmlChildren(r, element, func(entry ebmlElement) (bool, error) {
+				if entry.id != ebmlTrackEntryID || hasTrack {
+					return true, nil
+				}
+
+				track, audio, err := probeWebMTrack(r, entry)
+				if audio {
+					metadata, hasTrack = track, true
+				}
+				return true, err
+			})
+		case ebmlClusterID:
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	if !hasTrack {
+		return Metadata{}, errMalformed
+	}
+
+	// the duration is a float counted in timecode scale units of nanoseconds
+	metadata.Duration = seconds(duration * float64(timescale) / 1e9)
+
+	return metadata, nil
+}
+
+func probeWebMTrack(r io.ReadSeeker, entry ebmlElement) (Metadata, bool, error) {
+	var (
+		metadata  Metadata
+		trackType uint64
+	)
+
+	// the Audio element is only read right under the track entry, so Audio
+	// elements nested in themselves are not followed
+	audio := func(child ebmlElement) (bool, error) {
+		var err error
+		switch child.id {
+		case ebmlSamplingFreqID:
+			var rate float64
+			rate, err = readEBMLFloat(r, child)
+			metadata.SampleRate = int(rate)
+		case ebmlChannelsID:
+			var channels uint64
+			channels, err = readEBMLUint(r, child)
+			metadata.Channels = int(channels)
+		}
+		return true, err
+	}
+
+	visit := func(child ebmlElement) (bool, error) {
+		var err error
+		switch child.id {
+		case ebmlTrackTypeID:
+			trackType, err = readEBMLUint(r, child)
+		case ebmlCodecID:
+			var codec string
+			codec, err = readEBMLString(r, child)
+			metadata.Codec = webmCodecs[codec]
+			if metadata.Codec == "" {
+				metadata.Codec = strings.ToLower(strings.TrimPrefix(codec, "A_"))
+			}
+		case ebmlAudioID:
+			err = ebmlChildren(r, child, audio)
+		}
+		return true, err
+	}
+
+	if err := ebmlChildren(r, entry, visit); err != nil {
+		return Metadata{}, false, err
+	}
+
+	return metadata, trackType == ebmlAudioTrack, nil
+}
+
+// ebmlChildren calls fn for each child of parent until fn asks to stop.
+func ebmlChildren(r io.ReadSeeker, parent ebmlElement, fn func(ebmlElement) (bool, error)) error {
+	for offset := parent.start; offset < parent.end; {
+		element, err := readEBMLElement(r, offset, parent.end)
+		if err != nil {
+			return err
+		}
+
+		next, err := fn(element)
+		if err != nil || !next {
+			return err
+		}
+
+		offset = element.end
+	}
+
+	return nil
+}
+
+// readEBMLElement reads the variable length id and size of the element at
+// offset. Elements of unknown size, used by live recordings, extend to the
+// end of their parent.
+func readEBMLElement(r io.ReadSeeker, offset, end int64) (ebmlElement, error) {
+	header := make([]byte, min(12, end-offset))
+	if len(header) < 2 {
+		return ebmlElement{}, errMalformed
+	}
+
+	if err := readAt(r, offset, header); err != nil {
+		return ebmlElement{}, err
+	}
+
+	idLength := bits.LeadingZeros8(header[0]) + 1
+	if idLength > 4 || idLength >= len(header) {
+		return ebmlElement{}, errMalformed
+	}
+
+	var id uint32
+	for _, b := range header[:idLength] {
+		id = id<<8 | uint32(b)
+	}
+
+	sizeLength := bits.LeadingZeros8(header[idLength]) + 1
+	if sizeLength > 8 || idLength+sizeLength > len(header) {
+		return ebmlElement{}, errMalformed
+	}
+
+	size := uint64(header[idLength]) & (0xFF >> sizeLength)
+	unknown := size == 0xFF>>sizeLength
+	for _, b := range header[idLength+1 : idLength+sizeLength] {
+		size = size<<8 | uint64(b)
+		unknown = unknown && b == 0xFF
+	}
+
+	element := ebmlElement{id: id, start: offset + int64(idLength+sizeLength)}
+	element.end = end
+	if !unknown {
+		if size > uint64(end-element.start) {
+			return ebmlElement{}, errMalformed
+		}
+		element.end = element.start + int64(size)
+	}
+
+	return element, nil
+}
+
+func readEBMLData(r io.ReadSeeker, element ebmlElement, limit int64) ([]byte, error) {
+	length := element.end - element.start
+	if length > limit {
+		return nil, errMalformed
+	}
+
+	data := make([]byte, length)
+	return data, readAt(r, element.start, data)
+}
+
+func readEBMLUint(r io.ReadSeeker, element ebmlElement) (uint64, error) {
+	data, err := readEBMLData(r, element, 8)
+	if err != nil {
+		return 0, err
+	}
+
+	var value uint64
+	for _, b := range data {
+		value = value<<8 | uint64(b)
+	}
+
+	return value, nil
+}
+
+func readEBMLFloat(r io.ReadSeeker, element ebmlElement) (float64, error) {
+	data, err := readEBMLData(r, element, 8)
+	if err != nil {
+		return 0, err
+	}
+
+	switch len(data) {
+	case 0:
+		return 0, nil
+	case 4:
+		return float64(math.Float32frombits(be.Uint32(data))), nil
+	case 8:
+		return math.Float64frombits(be.Uint64(data)), nil
+	}
+
+	return 0, errMalformed
+}
+
+func readEBMLString(r io.ReadSeeker, element ebmlElement) (string, error) {
+	data, err := readEBMLData(r, element, 256)
+	return strings.TrimRight(string(data), "\x00"), err
+}
@@ -0,0 +1,28 @@
+package auth
+
+type Role string
+
+const (
+	RoleViewer Role = "viewer"
+	RoleEditor Role = "editor"
+	RoleAdmin  Role = "admin"
+)
+
+var roleLevels = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+func ParseRole(value string) (Role, bool) {
+	role := Role(value)
+	_, ok := roleLevels[role]
+	return role, ok
+}
+
+// Allows reports whether the role grants at least the permissions of the
+// required one. Unknown roles are never allowed.
+func (r Role) Allows(required Role) bool {
+	level, ok := roleLevels[r]
+	return ok && level >= roleLevels[required]
+}
@@ -3,13 +3,37 @@ package application
 import "errors"
 
 var (
-	MissingFile           = errors.New("missing file to upload")
-	InvalidFile           = errors.New("invalid file")
-	FailedReadFile        = errors.New("fail read to upload")
-	SummaryNotFound       = errors.New("summary not found")
-	ExternalIDIsInvalid   = errors.New("extenalId is invalid")
-	InternalDatabaseError = errors.New("internal database error")
-	TranscriptFailed      = errors.New("fail to transcript audio")
-	ResumeTextFailed      = errors.New("fail to resume audio")
-	UnexpectedErrorList   = errors.New("error on list summaries")
+	MissingFile            = errors.New("missing file to upload")
+	InvalidFile            = errors.New("invalid file")
+	FailedReadFile         = errors.New("fail read to upload")
+	SummaryNotFound        = errors.New("summary not found")
+	ExternalIDIsInvalid    = errors.New("extenalId is invalid")
+	InternalDatabaseError  = errors.New("internal database error")
+	TranscriptFailed       = errors.New("fail to transcript audio")
+	ResumeTextFailed       = errors.New("fail to resume audio")
+	UnexpectedErrorList    = errors.New("error on list summaries")
+	InvalidExportFormat    = errors.New("invalid export format")
+	ExportFailed           = errors.New("fail to export summary")
+	InvalidSummaryFilter   = errors.New("invalid summary filter")
+	InvalidWebhook         = errors.New("invalid webhook")
+	WebhookNotFound        = errors.New("webhook not found")
+	Unauthorized           = errors.New("missing or invalid api key")
+	Forbidden              = errors.New("operation not allowed for this api key")
+	InvalidAPIKey          = errors.New("invalid api key")
+	APIKeyNotFound         = errors.New("api key not found")
+	InvalidWorkspace       = errors.New("invalid workspace")
+	WorkspaceNotFound      = errors.New("workspace not found")
+	RateLimitExceeded      = errors.New("rate limit exceeded")
+	InvalidUsageFilter     = errors.New("invalid usage filter")
+	BudgetExceeded         = errors.New("monthly budget exceeded")
+	AudioNotFound          = errors.New("audio not found")
+	StoreAudioFailed       = errors.New("fail to store audio")
+	InvalidDownloadURL     = errors.New("invalid download url")
+	InvalidUpload          = errors.New("invalid upload")
+	UploadNotFound         = errors.New("upload not found")
+	UploadOffsetMismatch   = errors.New("upload offset does not match")
+	UploadTooLarge         = errors.New("upload exceeds the maximum size")
+	UnsupportedAudioFormat = errors.New("unsupported audio format, expected mp3, wav, m4a, mp4, webm, ogg or flac")
+	AudioFormatMismatch    = errors.New("audio content does not match the file extension")
+	AudioTooLong           = errors.New("audio exceeds the maximum duration")
 )
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+)
+
+const (
+	apiKeyPrefix       = "eai_"
+	apiKeyPrefixLength = len(apiKeyPrefix) + 8
+	apiKeyNameLength   = 255
+)
+
+type APIKeyUseCase interface {
+	CreateAPIKey(ctx context.Context, input APIKeyCreateInput) (*APIKeyOutput, error)
+	ListAPIKeys(ctx context.Context) (*APIKeyListOutput, error)
+	RevokeAPIKey(ctx context.Context, externalID uuid.UUID) error
+	Authenticate(ctx context.Context, key string) (*auth.Principal, error)
+}
+
+type APIKey struct {
+	repository repository.APIKeyRepository
+}
+
+func NewAPIKey(repository repository.APIKeyRepository) *APIKey {
+	return &APIKey{
+		repository: repository,
+	}
+}
+
+func (a *APIKey) CreateAPIKey(ctx context.Context, input APIKeyCreateInput) (*APIKeyOutput, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || len(name) > apiKeyNameLength {
+		return nil, application.InvalidAPIKey
+	}
+
+	role := auth.RoleEditor
+	if input.Role != "" {
+		var ok bool
+		if role, ok = auth.ParseRole(input.Role); !ok {
+			return nil, application.InvalidAPIKey
+		}
+	}
+
+	key, err := generateAPIKey()
+	if err != nil {
+		log.LogError(ctx, "failed to generate api key", err)
+		return nil, err
+	}
+
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := a.repository.CreateAPIKey(ctx, workspaceID, repository.APIKeyCreateInput{
+		Name:    name,
+		Prefix:  key[:apiKeyPrefixLength],
+		KeyHash: hashAPIKey(key),
+		Role:    string(role),
+	})
+	if err != nil {
+		log.LogError(ctx, "failed to create api key in db", err)
+		return nil, application.InternalDatabaseError
+	}
+
+	output := toAPIKeyOutput(*created)
+	output.Key = key
+
+	return &output, nil
+}
+
+func (a *APIKey) ListAPIKeys(ctx context.Context) (*APIKeyListOutput, error) {
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := a.repository.GetAPIKeys(ctx, workspaceID)
+	if err != nil {
+		log.LogError(ctx, "error on list api keys", err)
+		return nil, err
+	}
+
+	output := APIKeyListOutput{Data: []APIKeyOutput{}}
+	for _, key := range keys {
+		output.Data = append(output.Data, toAPIKeyOutput(key))
+	}
+
+	return &output, nil
+}
+
+func (a *APIKey) RevokeAPIKey(ctx context.Context, externalID uuid.UUID) error {
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = a.repository.RevokeAPIKeyByExternalID(ctx, workspaceID, externalID)
+	if err == application.APIKeyNotFound {
+		return err
+	}
+
+	if err != nil {
+		log.LogError(ctx, "error on revoke api key", err)
+		return err
+	}
+
+	return nil
+}
+
+func (a *APIKey) Authenticate(ctx context.Context, key string) (*auth.Principal, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, application.Unauthorized
+	}
+
+	found, err := a.repository.GetActiveAPIKeyByHash(ctx, hashAPIKey(key))
+	if err == application.APIKeyNotFound {
+		return nil, application.Unauthorized
+	}
+
+	if err != nil {
+		log.LogError(ctx, "error on get api key", err)
+		return nil, err
+	}
+
+	return &auth.Principal{
+		KeyID:       found.ExternalID,
+		WorkspaceID: found.WorkspaceID,
+		Name:        found.Name,
+		Role:        auth.Role(found.Role),
+	}, nil
+}
+
+// workspaceFromContext returns the workspace that owns the data handled by
+// the request, taken from the authenticated principal.
+func workspaceFromContext(ctx context.Context) (uuid.UUID, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return uuid.Nil, application.Unauthorized
+	}
+
+	return principal.WorkspaceID, nil
+}
+
+func generateAPIKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	return apiKeyPrefix + hex.EncodeToString(key), nil
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func toAPIKeyOutput(key repository.APIKeyOutput) APIKeyOutput {
+	output := APIKeyOutput{
+		ExternalID: key.ExternalID,
+		CreatedAt:  key.CreatedAt,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Role:       key.Role,
+	}
+
+	if key.RevokedAt.Valid {
+		output.RevokedAt = &key.RevokedAt.Time
+	}
+
+	return output
+}
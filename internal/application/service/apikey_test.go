@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	apiKeyExternalIDStr  = "6c1f9a3b-2d4e-4f5a-8b7c-9e0d1a2b3c4d"
+	apiKeyExternalIDUUID = uuid.MustParse(apiKeyExternalIDStr)
+	adminPrincipal       = auth.Principal{KeyID: uuid.New(), WorkspaceID: workspaceIDUUID, Name: "admin", Role: auth.RoleAdmin}
+)
+
+type (
+	APIKeyTestSuite struct {
+		suite.Suite
+
+		ctx        context.Context
+		repository *gatewaymocks.APIKeyRepository
+	}
+)
+
+func TestAPIKeyTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyTestSuite))
+}
+
+func (s *APIKeyTestSuite) SetupTest() {
+	s.ctx = auth.WithPrincipal(context.Background(), adminPrincipal)
+}
+
+func (s *APIKeyTestSuite) TestCreateAPIKey() {
+	s.Run("successful create api key storing only its hash", func() {
+		var stored repository.APIKeyCreateInput
+
+		s.repository = new(gatewaymocks.APIKeyRepository)
+		s.repository.EXPECT().
+			CreateAPIKey(mock.Anything, workspaceIDUUID, mock.Anything).
+			RunAndReturn(func(ctx context.Context, workspaceID uuid.UUID, input repository.APIKeyCreateInput) (*repository.APIKeyOutput, error) {
+				stored = input
+				return &repository.APIKeyOutput{
+					ExternalID: apiKeyExternalIDUUID,
+					CreatedAt:  createdAt,
+					Name:       input.Name,
+					Prefix:     input.Prefix,
+					Role:       input.Role,
+				}, nil
+			})
+
+		service := NewAPIKey(s.repository)
+		output, err := service.CreateAPIKey(s.ctx, APIKeyCreateInput{Name: " ci pipeline "})
+		s.Require().NoError(err)
+
+		s.True(strings.HasPrefix(output.Key, apiKeyPrefix))
+		s.Len(output.Key, len(apiKeyPrefix)+64)
+		s.Equal("ci pipeline", stored.Name)
+		s.Equal(output.Key[:apiKeyPrefixLength], stored.Prefix)
+		s.Equal(output.Key[:apiKeyPrefixLength], output.Prefix)
+		s.Equal("editor", stored.Role)
+
+		sum := sha256.Sum256([]byte(output.Key))
+		s.Equal(hex.EncodeToString(sum[:]), stored.KeyHash)
+		s.NotContains(stored.KeyHash, output.Key)
+	})
+
+	s.Run("invalid api key name", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+
+		service := NewAPIKey(s.repository)
+		_, err := service.CreateAPIKey(s.ctx, APIKeyCreateInput{Name: "  "})
+		s.Require().ErrorIs(err, application.InvalidAPIKey)
+	})
+
+	s.Run("invalid api key role", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+
+		service := NewAPIKey(s.repository)
+		_, err := service.CreateAPIKey(s.ctx, APIKeyCreateInput{Name: "key", Role: "owner"})
+		s.Require().ErrorIs(err, application.InvalidAPIKey)
+	})
+
+	s.Run("create api key without admin key", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+
+		service := NewAPIKeyAuthorization(NewAPIKey(s.repository))
+		_, err := service.CreateAPIKey(auth.WithPrincipal(context.Background(), principal), APIKeyCreateInput{Name: "key"})
+		s.Require().ErrorIs(err, application.Forbidden)
+
+		_, err = service.CreateAPIKey(context.Background(), APIKeyCreateInput{Name: "key"})
+		s.Require().ErrorIs(err, application.Unauthorized)
+	})
+
+	s.Run("fail db create api key", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+		s.repository.EXPECT().
+			CreateAPIKey(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(nil, errors.New("some error"))
+
+		service := NewAPIKey(s.repository)
+		_, err := service.CreateAPIKey(s.ctx, APIKeyCreateInput{Name: "key"})
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+	})
+}
+
+func (s *APIKeyTestSuite) TestListAPIKeys() {
+	s.Run("successful list api keys", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+		s.repository.EXPECT().
+			GetAPIKeys(mock.Anything, workspaceIDUUID).
+			Return([]repository.APIKeyOutput{
+				{ExternalID: apiKeyExternalIDUUID, Name: "key", Prefix: "eai_0123abcd"},
+				{ExternalID: uuid.New(), Name: "old", RevokedAt: sql.NullTime{Time: createdAt, Valid: true}},
+			}, nil)
+
+		service := NewAPIKey(s.repository)
+		output, err := service.ListAPIKeys(s.ctx)
+		s.Require().NoError(err)
+		s.Len(output.Data, 2)
+		s.Nil(output.Data[0].RevokedAt)
+		s.Empty(output.Data[0].Key)
+		s.Equal(createdAt, *output.Data[1].RevokedAt)
+	})
+
+	s.Run("list api keys without admin key", func() {
+		service := NewAPIKeyAuthorization(NewAPIKey(new(gatewaymocks.APIKeyRepository)))
+		_, err := service.ListAPIKeys(auth.WithPrincipal(context.Background(), principal))
+		s.Require().ErrorIs(err, application.Forbidden)
+	})
+}
+
+func (s *APIKeyTestSuite) TestRevokeAPIKey() {
+	s.Run("successful revoke api key", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+		s.repository.EXPECT().
+			RevokeAPIKeyByExternalID(mock.Anything, workspaceIDUUID, apiKeyExternalIDUUID).
+			Return(nil)
+
+		service := NewAPIKey(s.repository)
+		s.Require().NoError(service.RevokeAPIKey(s.ctx, apiKeyExternalIDUUID))
+	})
+
+	s.Run("revoke unknown api key", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+		s.repository.EXPECT().
+			RevokeAPIKeyByExternalID(mock.Anything, workspaceIDUUID, apiKeyExternalIDUUID).
+			Return(application.APIKeyNotFound)
+
+		service := NewAPIKey(s.repository)
+		err := service.RevokeAPIKey(s.ctx, apiKeyExternalIDUUID)
+		s.Require().ErrorIs(err, application.APIKeyNotFound)
+	})
+}
+
+func (s *APIKeyTestSuite) TestAuthenticate() {
+	key := apiKeyPrefix + strings.Repeat("ab", 32)
+
+	s.Run("successful authenticate api key", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+		s.repository.EXPECT().
+			GetActiveAPIKeyByHash(mock.Anything, hashAPIKey(key)).
+			Return(&repository.APIKeyOutput{
+				ExternalID:  apiKeyExternalIDUUID,
+				WorkspaceID: workspaceIDUUID,
+				Name:        "key",
+				Role:        "admin",
+			}, nil)
+
+		service := NewAPIKey(s.repository)
+		output, err := service.Authenticate(context.Background(), key)
+		s.Require().NoError(err)
+		s.Equal(auth.Principal{
+			KeyID:       apiKeyExternalIDUUID,
+			WorkspaceID: workspaceIDUUID,
+			Name:        "key",
+			Role:        auth.RoleAdmin,
+		}, *output)
+	})
+
+	s.Run("missing or malformed api key", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+
+		service := NewAPIKey(s.repository)
+		_, err := service.Authenticate(context.Background(), "")
+		s.Require().ErrorIs(err, application.Unauthorized)
+
+		_, err = service.Authenticate(context.Background(), "Bearer something")
+		s.Require().ErrorIs(err, application.Unauthorized)
+	})
+
+	s.Run("unknown or revoked api key", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+		s.repository.EXPECT().
+			GetActiveAPIKeyByHash(mock.Anything, hashAPIKey(key)).
+			Return(nil, application.APIKeyNotFound)
+
+		service := NewAPIKey(s.repository)
+		_, err := service.Authenticate(context.Background(), key)
+		s.Require().ErrorIs(err, application.Unauthorized)
+	})
+
+	s.Run("fail db authenticate api key", func() {
+		s.repository = new(gatewaymocks.APIKeyRepository)
+		s.repository.EXPECT().
+			GetActiveAPIKeyByHash(mock.Anything, hashAPIKey(key)).
+			Return(nil, errors.New("some error"))
+
+		service := NewAPIKey(s.repository)
+		_, err := service.Authenticate(context.Background(), key)
+		s.Require().Error(err)
+		s.NotErrorIs(err, application.Unauthorized)
+	})
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/blobstore"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+)
+
+type AudioUseCase interface {
+	GetSummaryAudio(ctx context.Context, externalID uuid.UUID) (*AudioOutput, error)
+}
+
+type Audio struct {
+	repository repository.Repository
+	blobs      blobstore.BlobStore
+}
+
+func NewAudio(repository repository.Repository, blobs blobstore.BlobStore) *Audio {
+	return &Audio{
+		repository: repository,
+		blobs:      blobs,
+	}
+}
+
+// GetSummaryAudio returns the original audio of the summary. The content is
+// only fetched from the blob store when read, from the position it was
+// seeked to, so range requests do not download the whole file.
+func (a *Audio) GetSummaryAudio(ctx context.Context, externalID uuid.UUID) (*AudioOutput, error) {
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := a.repository.GetSummaryByExternalID(ctx, workspaceID, externalID)
+	if err != nil {
+		if err != application.SummaryNotFound {
+			log.LogError(ctx, "error on get summary", err)
+		}
+		return nil, err
+	}
+
+	if summary.AudioKey.String == "" {
+		return nil, application.AudioNotFound
+	}
+
+	size, err := a.blobs.Size(ctx, summary.AudioKey.String)
+	if err != nil {
+		if err != application.AudioNotFound {
+			log.LogError(ctx, "error on get audio", err)
+		}
+		return nil, err
+	}
+
+	return &AudioOutput{
+		Content:     &blobReader{ctx: ctx, blobs: a.blobs, key: summary.AudioKey.String, size: size},
+		ContentType: summary.AudioContentType.String,
+		Size:        size,
+		ModTime:     summary.CreatedAt,
+	}, nil
+}
+
+type blobReader struct {
+	ctx    context.Context
+	blobs  blobstore.BlobStore
+	key    string
+	size   int64
+	offset int64
+	body   io.ReadCloser
+}
+
+func (r *blobReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	if r.body == nil {
+		body, err := r.blobs.Get(r.ctx, r.key, r.offset)
+		if err != nil {
+			return 0, err
+		}
+		r.body = body
+	}
+
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+	return n, err
+}
+
+func (r *blobReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	}
+
+	if offset < 0 {
+		return 0, errors.New("seek before the start of the audio")
+	}
+
+	if offset != r.offset {
+		r.Close()
+		r.offset = offset
+	}
+
+	return offset, nil
+}
+
+func (r *blobReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+
+	err := r.body.Close()
+	r.body = nil
+	return err
+}
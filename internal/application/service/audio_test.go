@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *SummaryTestSuite) TestGetSummaryAudio() {
+	summaryWithAudio := &repository.SummaryOutput{
+		ExternalID:       summaryExternalIDUUID,
+		CreatedAt:        createdAt,
+		AudioKey:         sql.NullString{String: audioKey, Valid: true},
+		AudioContentType: sql.NullString{String: "audio/mpeg", Valid: true},
+	}
+
+	s.Run("successful read audio from offset", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(summaryWithAudio, nil)
+
+		blobs := new(gatewaymocks.BlobStore)
+		blobs.EXPECT().Size(mock.Anything, audioKey).Return(int64(10), nil)
+		blobs.EXPECT().
+			Get(mock.Anything, audioKey, int64(6)).
+			Return(io.NopCloser(strings.NewReader("6789")), nil).
+			Once()
+
+		output, err := NewAudio(s.repository, blobs).GetSummaryAudio(s.ctx, summaryExternalIDUUID)
+		s.Require().NoError(err)
+		s.Equal("audio/mpeg", output.ContentType)
+		s.Equal(int64(10), output.Size)
+		s.Equal(createdAt, output.ModTime)
+
+		end, err := output.Content.Seek(0, io.SeekEnd)
+		s.Require().NoError(err)
+		s.Equal(int64(10), end)
+
+		_, err = output.Content.Seek(6, io.SeekStart)
+		s.Require().NoError(err)
+
+		content, err := io.ReadAll(output.Content)
+		s.Require().NoError(err)
+		s.Equal("6789", string(content))
+		s.NoError(output.Content.Close())
+	})
+
+	s.Run("summary without audio", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(&repository.SummaryOutput{ExternalID: summaryExternalIDUUID}, nil)
+
+		_, err := NewAudio(s.repository, new(gatewaymocks.BlobStore)).GetSummaryAudio(s.ctx, summaryExternalIDUUID)
+		s.Require().ErrorIs(err, application.AudioNotFound)
+	})
+
+	s.Run("audio missing from the store", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(summaryWithAudio, nil)
+
+		blobs := new(gatewaymocks.BlobStore)
+		blobs.EXPECT().Size(mock.Anything, audioKey).Return(int64(0), application.AudioNotFound)
+
+		_, err := NewAudio(s.repository, blobs).GetSummaryAudio(s.ctx, summaryExternalIDUUID)
+		s.Require().ErrorIs(err, application.AudioNotFound)
+	})
+
+	s.Run("summary not found", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(nil, application.SummaryNotFound)
+
+		_, err := NewAudio(s.repository, new(gatewaymocks.BlobStore)).GetSummaryAudio(s.ctx, summaryExternalIDUUID)
+		s.Require().ErrorIs(err, application.SummaryNotFound)
+	})
+
+	s.Run("unauthenticated caller", func() {
+		_, err := NewAudio(new(gatewaymocks.Repository), new(gatewaymocks.BlobStore)).GetSummaryAudio(context.Background(), summaryExternalIDUUID)
+		s.Require().ErrorIs(err, application.Unauthorized)
+	})
+}
@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	"github.com/google/uuid"
+)
+
+// requireRole checks that the authenticated principal holds at least the
+// given role.
+func requireRole(ctx context.Context, role auth.Role) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return application.Unauthorized
+	}
+
+	if !principal.Role.Allows(role) {
+		return application.Forbidden
+	}
+
+	return nil
+}
+
+// SummaryAuthorization wraps a SummaryUseCase checking the role of the
+// caller before each operation: viewers can only read summaries, while
+// editors and admins can also create and delete them.
+type SummaryAuthorization struct {
+	next SummaryUseCase
+}
+
+func NewSummaryAuthorization(next SummaryUseCase) *SummaryAuthorization {
+	return &SummaryAuthorization{
+		next: next,
+	}
+}
+
+func (a *SummaryAuthorization) CreateSummaryAndTriggerAIProccess(ctx context.Context, audio SummaryAudioInput) (*SummarySimpleOutput, error) {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	return a.next.CreateSummaryAndTriggerAIProccess(ctx, audio)
+}
+
+func (a *SummaryAuthorization) CreateSummaryFromURL(ctx context.Context, input SummaryFromURLInput) (*SummarySimpleOutput, error) {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	return a.next.CreateSummaryFromURL(ctx, input)
+}
+
+func (a *SummaryAuthorization) ListSummaries(ctx context.Context, filter SummaryFilterInput) (*SummaryListOutput, error) {
+	if err := requireRole(ctx, auth.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return a.next.ListSummaries(ctx, filter)
+}
+
+func (a *SummaryAuthorization) GetSummaryByExternalID(ctx context.Context, externalID uuid.UUID) (*SummaryDetailedOutput, error) {
+	if err := requireRole(ctx, auth.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return a.next.GetSummaryByExternalID(ctx, externalID)
+}
+
+func (a *SummaryAuthorization) DeleteSummaryByExternalID(ctx context.Context, externalID uuid.UUID) error {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return err
+	}
+
+	return a.next.DeleteSummaryByExternalID(ctx, externalID)
+}
+
+// UploadAuthorization wraps an UploadUseCase, as resumable uploads create
+// summaries and require the editor role.
+type UploadAuthorization struct {
+	next UploadUseCase
+}
+
+func NewUploadAuthorization(next UploadUseCase) *UploadAuthorization {
+	return &UploadAuthorization{
+		next: next,
+	}
+}
+
+func (a *UploadAuthorization) CreateUpload(ctx context.Context, input UploadCreateInput) (*UploadOutput, error) {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	return a.next.CreateUpload(ctx, input)
+}
+
+func (a *UploadAuthorization) GetUpload(ctx context.Context, id uuid.UUID) (*UploadOutput, error) {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	return a.next.GetUpload(ctx, id)
+}
+
+func (a *UploadAuthorization) WriteUploadChunk(ctx context.Context, input UploadChunkInput) (*UploadOutput, error) {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	return a.next.WriteUploadChunk(ctx, input)
+}
+
+func (a *UploadAuthorization) DeleteUpload(ctx context.Context, id uuid.UUID) error {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return err
+	}
+
+	return a.next.DeleteUpload(ctx, id)
+}
+
+// AudioAuthorization wraps an AudioUseCase, reading the audio of a summary
+// with the same role as reading the summary.
+type AudioAuthorization struct {
+	next AudioUseCase
+}
+
+func NewAudioAuthorization(next AudioUseCase) *AudioAuthorization {
+	return &AudioAuthorization{
+		next: next,
+	}
+}
+
+func (a *AudioAuthorization) GetSummaryAudio(ctx context.Context, externalID uuid.UUID) (*AudioOutput, error) {
+	if err := requireRole(ctx, auth.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return a.next.GetSummaryAudio(ctx, externalID)
+}
+
+// ExportAuthorization wraps an ExportUseCase, exporting summaries with the
+// same role as reading them.
+type ExportAuthorization struct {
+	next ExportUseCase
+}
+
+func NewExportAuthorization(next ExportUseCase) *ExportAuthorization {
+	return &ExportAuthorization{
+		next: next,
+	}
+}
+
+func (a *ExportAuthorization) ExportSummary(ctx context.Context, externalID uuid.UUID, format string) (*SummaryExportOutput, error) {
+	if err := requireRole(ctx, auth.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return a.next.ExportSummary(ctx, externalID, format)
+}
+
+func (a *ExportAuthorization) ExportSummariesCSV(ctx context.Context, filter SummaryFilterInput, w io.Writer) error {
+	if err := requireRole(ctx, auth.RoleViewer); err != nil {
+		return err
+	}
+
+	return a.next.ExportSummariesCSV(ctx, filter, w)
+}
+
+// EventAuthorization wraps an EventUseCase, following the status of
+// summaries with the same role as reading them.
+type EventAuthorization struct {
+	next EventUseCase
+}
+
+func NewEventAuthorization(next EventUseCase) *EventAuthorization {
+	return &EventAuthorization{
+		next: next,
+	}
+}
+
+func (a *EventAuthorization) SubscribeEvents(ctx context.Context) (*EventSubscriptionOutput, error) {
+	if err := requireRole(ctx, auth.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return a.next.SubscribeEvents(ctx)
+}
+
+func (a *EventAuthorization) SubscribeSummaryEvents(ctx context.Context, externalID uuid.UUID) (*EventSubscriptionOutput, error) {
+	if err := requireRole(ctx, auth.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return a.next.SubscribeSummaryEvents(ctx, externalID)
+}
+
+// WebhookAuthorization wraps a WebhookUseCase. Viewers can read the
+// webhooks, while managing them and reading their deliveries requires the
+// editor role, as they receive the events of every summary in the workspace.
+type WebhookAuthorization struct {
+	next WebhookUseCase
+}
+
+func NewWebhookAuthorization(next WebhookUseCase) *WebhookAuthorization {
+	return &WebhookAuthorization{
+		next: next,
+	}
+}
+
+func (a *WebhookAuthorization) CreateWebhook(ctx context.Context, input WebhookCreateInput) (*WebhookOutput, error) {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	return a.next.CreateWebhook(ctx, input)
+}
+
+func (a *WebhookAuthorization) UpdateWebhook(ctx context.Context, externalID uuid.UUID, input WebhookUpdateInput) (*WebhookOutput, error) {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	return a.next.UpdateWebhook(ctx, externalID, input)
+}
+
+func (a *WebhookAuthorization) ListWebhooks(ctx context.Context) (*WebhookListOutput, error) {
+	if err := requireRole(ctx, auth.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return a.next.ListWebhooks(ctx)
+}
+
+func (a *WebhookAuthorization) GetWebhookByExternalID(ctx context.Context, externalID uuid.UUID) (*WebhookOutput, error) {
+	if err := requireRole(ctx, auth.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return a.next.GetWebhookByExternalID(ctx, externalID)
+}
+
+func (a *WebhookAuthorization) DeleteWebhookByExternalID(ctx context.Context, externalID uuid.UUID) error {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return err
+	}
+
+	return a.next.DeleteWebhookByExternalID(ctx, externalID)
+}
+
+func (a *WebhookAuthorization) ListWebhookDeliveries(ctx context.Context, externalID uuid.UUID) (*WebhookDeliveryListOutput, error) {
+	if err := requireRole(ctx, auth.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	return a.next.ListWebhookDeliveries(ctx, externalID)
+}
+
+// APIKeyAuthorization wraps an APIKeyUseCase, leaving the management of the
+// keys to admins. Authenticate runs before there is a principal, so it is
+// not checked.
+type APIKeyAuthorization struct {
+	next APIKeyUseCase
+}
+
+func NewAPIKeyAuthorization(next APIKeyUseCase) *APIKeyAuthorization {
+	return &APIKeyAuthorization{
+		next: next,
+	}
+}
+
+func (a *APIKeyAuthorization) CreateAPIKey(ctx context.Context, input APIKeyCreateInput) (*APIKeyOutput, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	return a.next.CreateAPIKey(ctx, input)
+}
+
+func (a *APIKeyAuthorization) ListAPIKeys(ctx context.Context) (*APIKeyListOutput, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	return a.next.ListAPIKeys(ctx)
+}
+
+func (a *APIKeyAuthorization) RevokeAPIKey(ctx context.Context, externalID uuid.UUID) error {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return err
+	}
+
+	return a.next.RevokeAPIKey(ctx, externalID)
+}
+
+func (a *APIKeyAuthorization) Authenticate(ctx context.Context, key string) (*auth.Principal, error) {
+	return a.next.Authenticate(ctx, key)
+}
+
+// UsageAuthorization wraps a UsageUseCase, as the usage report carries the
+// costs of the whole workspace and is left to admins.
+type UsageAuthorization struct {
+	next UsageUseCase
+}
+
+func NewUsageAuthorization(next UsageUseCase) *UsageAuthorization {
+	return &UsageAuthorization{
+		next: next,
+	}
+}
+
+func (a *UsageAuthorization) GetUsage(ctx context.Context, filter UsageFilterInput) (*UsageReportOutput, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	return a.next.GetUsage(ctx, filter)
+}
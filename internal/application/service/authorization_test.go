@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *SummaryTestSuite) TestSummaryAuthorization() {
+	viewer := principal
+	viewer.Role = auth.RoleViewer
+	viewerCtx := auth.WithPrincipal(context.Background(), viewer)
+
+	s.Run("viewer can read summaries", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			GetSummaries(mock.Anything, workspaceIDUUID, repository.SummaryFilter{}).
+			Return([]repository.SummaryOutput{}, nil)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(&repository.SummaryOutput{ExternalID: summaryExternalIDUUID, CreatedAt: createdAt}, nil)
+
+		service := NewSummaryAuthorization(NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{}))
+		_, err := service.ListSummaries(viewerCtx, SummaryFilterInput{})
+		s.Require().NoError(err)
+
+		_, err = service.GetSummaryByExternalID(viewerCtx, summaryExternalIDUUID)
+		s.Require().NoError(err)
+	})
+
+	s.Run("viewer can not create or delete summaries", func() {
+		s.repository = newSummaryRepository()
+
+		service := NewSummaryAuthorization(NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{}))
+		_, err := service.CreateSummaryAndTriggerAIProccess(viewerCtx, SummaryAudioInput{})
+		s.Require().ErrorIs(err, application.Forbidden)
+
+		err = service.DeleteSummaryByExternalID(viewerCtx, summaryExternalIDUUID)
+		s.Require().ErrorIs(err, application.Forbidden)
+
+		s.repository.AssertNotCalled(s.T(), "CreateSummary", mock.Anything, mock.Anything, mock.Anything)
+		s.repository.AssertNotCalled(s.T(), "DeleteSummaryByExternalID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("editor can delete summaries", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(&repository.SummaryOutput{ExternalID: summaryExternalIDUUID}, nil)
+		s.repository.EXPECT().
+			DeleteSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(nil)
+
+		service := NewSummaryAuthorization(NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{}))
+		err := service.DeleteSummaryByExternalID(s.ctx, summaryExternalIDUUID)
+		s.Require().NoError(err)
+	})
+
+	s.Run("unauthenticated and unknown roles are rejected", func() {
+		service := NewSummaryAuthorization(NewSummary(s.audioTranscript, s.summarize, new(gatewaymocks.Repository), s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{}))
+		_, err := service.ListSummaries(context.Background(), SummaryFilterInput{})
+		s.Require().ErrorIs(err, application.Unauthorized)
+
+		unknown := principal
+		unknown.Role = "intern"
+		_, err = service.ListSummaries(auth.WithPrincipal(context.Background(), unknown), SummaryFilterInput{})
+		s.Require().ErrorIs(err, application.Forbidden)
+	})
+}
+
+func (s *SummaryTestSuite) TestReadAuthorization() {
+	viewer := principal
+	viewer.Role = auth.RoleViewer
+	viewerCtx := auth.WithPrincipal(context.Background(), viewer)
+
+	s.Run("viewer can export summaries", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			StreamSummaries(mock.Anything, workspaceIDUUID, repository.SummaryFilter{}, mock.Anything).
+			Return(nil)
+
+		service := NewExportAuthorization(NewExport(s.repository, new(gatewaymocks.Renderer)))
+		err := service.ExportSummariesCSV(viewerCtx, SummaryFilterInput{}, io.Discard)
+		s.Require().NoError(err)
+	})
+
+	s.Run("unauthenticated caller can not read summaries", func() {
+		s.repository = new(gatewaymocks.Repository)
+
+		export := NewExportAuthorization(NewExport(s.repository, new(gatewaymocks.Renderer)))
+		_, err := export.ExportSummary(context.Background(), summaryExternalIDUUID, "md")
+		s.Require().ErrorIs(err, application.Unauthorized)
+
+		err = export.ExportSummariesCSV(context.Background(), SummaryFilterInput{}, io.Discard)
+		s.Require().ErrorIs(err, application.Unauthorized)
+
+		audio := NewAudioAuthorization(NewAudio(s.repository, new(gatewaymocks.BlobStore)))
+		_, err = audio.GetSummaryAudio(context.Background(), summaryExternalIDUUID)
+		s.Require().ErrorIs(err, application.Unauthorized)
+
+		events := NewEventAuthorization(NewEvents(s.repository, new(gatewaymocks.Subscriber)))
+		_, err = events.SubscribeSummaryEvents(context.Background(), summaryExternalIDUUID)
+		s.Require().ErrorIs(err, application.Unauthorized)
+
+		s.repository.AssertNotCalled(s.T(), "GetSummaryByExternalID", mock.Anything, mock.Anything, mock.Anything)
+		s.repository.AssertNotCalled(s.T(), "StreamSummaries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("unknown roles can not read summaries", func() {
+		unknown := principal
+		unknown.Role = "intern"
+		ctx := auth.WithPrincipal(context.Background(), unknown)
+
+		_, err := NewExportAuthorization(NewExport(new(gatewaymocks.Repository), new(gatewaymocks.Renderer))).
+			ExportSummary(ctx, summaryExternalIDUUID, "md")
+		s.Require().ErrorIs(err, application.Forbidden)
+	})
+}
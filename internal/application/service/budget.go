@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// estimatedBytesPerSecond assumes a 128 kbps recording to estimate the
+	// duration of an upload whose container does not declare it.
+	estimatedBytesPerSecond = 16_000
+	// estimatedTokensPerMinute covers the transcription sent twice as prompt
+	// to the summarizer plus the generated resume and full text.
+	estimatedTokensPerMinute = 1_000
+	budgetWarningRatio       = 0.8
+)
+
+// BudgetLimit is the monthly allowance of a caller. Zero means unlimited.
+type BudgetLimit struct {
+	AudioMinutes float64 `json:"audioMinutes"`
+	Tokens       int64   `json:"tokens"`
+}
+
+// SummaryBudget wraps a SummaryUseCase rejecting uploads whose estimated
+// cost would exceed the monthly budget of the caller, tracked from the
+// usage reported by the AI providers.
+type SummaryBudget struct {
+	next      SummaryUseCase
+	summaries repository.Repository
+	usage     repository.UsageRepository
+	publisher events.Publisher
+	limit     BudgetLimit
+	overrides map[uuid.UUID]BudgetLimit
+	now       func() time.Time
+}
+
+func NewSummaryBudget(
+	next SummaryUseCase,
+	summaries repository.Repository,
+	usage repository.UsageRepository,
+	publisher events.Publisher,
+	limit BudgetLimit,
+	overrides map[uuid.UUID]BudgetLimit,
+) *SummaryBudget {
+	return &SummaryBudget{
+		next:      next,
+		summaries: summaries,
+		usage:     usage,
+		publisher: publisher,
+		limit:     limit,
+		overrides: overrides,
+		now:       time.Now,
+	}
+}
+
+// CreateSummaryAndTriggerAIProccess estimates the audio minutes from the
+// duration declared by the container, falling back to the size when it is
+// unknown. Invalid audio is left for the summary to reject, and a duplicate
+// is not charged since the existing summary is returned without AI cost.
+func (b *SummaryBudget) CreateSummaryAndTriggerAIProccess(ctx context.Context, audio SummaryAudioInput) (*SummarySimpleOutput, error) {
+	if b.isDuplicate(ctx, audio) {
+		return b.next.CreateSummaryAndTriggerAIProccess(ctx, audio)
+	}
+
+	estimatedMinutes := float64(audio.Size) / estimatedBytesPerSecond / 60
+	if _, metadata, err := inspectAudio(ctx, audio); err == nil && metadata.Duration > 0 {
+		estimatedMinutes = metadata.Duration.Minutes()
+	}
+
+	if err := b.check(ctx, estimatedMinutes); err != nil {
+		return nil, err
+	}
+
+	return b.next.CreateSummaryAndTriggerAIProccess(ctx, audio)
+}
+
+// CreateSummaryFromURL only knows the audio size after the download, so it
+// is rejected when the budget is already consumed.
+func (b *SummaryBudget) CreateSummaryFromURL(ctx context.Context, input SummaryFromURLInput) (*SummarySimpleOutput, error) {
+	if err := b.check(ctx, 0); err != nil {
+		return nil, err
+	}
+
+	return b.next.CreateSummaryFromURL(ctx, input)
+}
+
+func (b *SummaryBudget) ListSummaries(ctx context.Context, filter SummaryFilterInput) (*SummaryListOutput, error) {
+	return b.next.ListSummaries(ctx, filter)
+}
+
+func (b *SummaryBudget) GetSummaryByExternalID(ctx context.Context, externalID uuid.UUID) (*SummaryDetailedOutput, error) {
+	return b.next.GetSummaryByExternalID(ctx, externalID)
+}
+
+func (b *SummaryBudget) DeleteSummaryByExternalID(ctx context.Context, externalID uuid.UUID) error {
+	return b.next.DeleteSummaryByExternalID(ctx, externalID)
+}
+
+func (b *SummaryBudget) isDuplicate(ctx context.Context, audio SummaryAudioInput) bool {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	hash, err := hashAudio(ctx, audio.Content)
+	if err != nil {
+		return false
+	}
+
+	return findDuplicate(ctx, b.summaries, principal.WorkspaceID, hash) != nil
+}
+
+func (b *SummaryBudget) check(ctx context.Context, estimatedMinutes float64) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return application.Unauthorized
+	}
+
+	limit, ok := b.overrides[principal.KeyID]
+	if !ok {
+		limit = b.limit
+	}
+
+	if limit.AudioMinutes <= 0 && limit.Tokens <= 0 {
+		return nil
+	}
+
+	period := b.now().UTC()
+	periodStart := time.Date(period.Year(), period.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	minutes, tokens, err := b.consumed(ctx, principal, periodStart)
+	if err != nil {
+		log.LogError(ctx, "error on get consumed budget", err)
+		return application.InternalDatabaseError
+	}
+
+	estimatedTokens := int64(estimatedMinutes * estimatedTokensPerMinute)
+
+	// without an estimate the upload is only rejected once the limit is hit
+	over := exceeds
+	if estimatedMinutes == 0 {
+		over = reached
+	}
+
+	if over(minutes+estimatedMinutes, limit.AudioMinutes) || over(float64(tokens+estimatedTokens), float64(limit.Tokens)) {
+		log.LogWarn(ctx, "upload rejected by monthly budget",
+			zap.String("user_id", principal.KeyID.String()),
+			zap.Float64("audio_minutes", minutes),
+			zap.Int64("tokens", tokens))
+		return application.BudgetExceeded
+	}
+
+	if exceeds(minutes+estimatedMinutes, limit.AudioMinutes*budgetWarningRatio) ||
+		exceeds(float64(tokens+estimatedTokens), float64(limit.Tokens)*budgetWarningRatio) {
+		b.warn(ctx, principal, periodStart, minutes+estimatedMinutes, tokens+estimatedTokens, limit)
+	}
+
+	return nil
+}
+
+func (b *SummaryBudget) consumed(ctx context.Context, principal auth.Principal, from time.Time) (float64, int64, error) {
+	rows, err := b.usage.GetUsage(ctx, principal.WorkspaceID, repository.UsageFilter{From: from})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var seconds float64
+	var tokens int64
+	for _, row := range rows {
+		if row.UserID != principal.KeyID {
+			continue
+		}
+
+		seconds += row.AudioSeconds
+		tokens += row.PromptTokens + row.CompletionTokens
+	}
+
+	return seconds / 60, tokens, nil
+}
+
+func (b *SummaryBudget) warn(
+	ctx context.Context,
+	principal auth.Principal,
+	periodStart time.Time,
+	minutes float64,
+	tokens int64,
+	limit BudgetLimit,
+) {
+	log.LogWarn(ctx, "monthly budget almost consumed",
+		zap.String("user_id", principal.KeyID.String()),
+		zap.Float64("audio_minutes", minutes),
+		zap.Int64("tokens", tokens))
+
+	b.publisher.Publish(ctx, events.Event{
+		ID:         uuid.New(),
+		Type:       events.BudgetWarning,
+		OccurredAt: b.now(),
+		Budget: &events.BudgetData{
+			WorkspaceID:       principal.WorkspaceID,
+			UserID:            principal.KeyID,
+			Period:            periodStart.Format("2006-01"),
+			AudioMinutesUsed:  minutes,
+			AudioMinutesLimit: limit.AudioMinutes,
+			TokensUsed:        tokens,
+			TokensLimit:       limit.Tokens,
+		},
+	})
+}
+
+func exceeds(value, limit float64) bool {
+	return limit > 0 && value > limit
+}
+
+func reached(value, limit float64) bool {
+	return limit > 0 && value >= limit
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *SummaryTestSuite) TestSummaryBudget() {
+	now, _ := time.Parse(timeLayout, "2025-01-25 15:04:05")
+	periodStart, _ := time.Parse(timeLayout, "2025-01-01 00:00:00")
+	limit := BudgetLimit{AudioMinutes: 10, Tokens: 10_000}
+	// 1 minute of audio at the estimated bitrate
+	audio := func() SummaryAudioInput {
+		return SummaryAudioInput{Content: bytes.NewReader(mp3Header), Size: estimatedBytesPerSecond * 60}
+	}
+
+	newBudget := func(usage *gatewaymocks.UsageRepository, publisher *gatewaymocks.Publisher, overrides map[uuid.UUID]BudgetLimit) *SummaryBudget {
+		budget := NewSummaryBudget(NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{}), s.repository, usage, publisher, limit, overrides)
+		budget.now = func() time.Time { return now }
+		return budget
+	}
+
+	usageOf := func(seconds float64, tokens int64) []repository.UsageAggregateOutput {
+		return []repository.UsageAggregateOutput{
+			{Day: periodStart, UserID: ownerIDUUID, AudioSeconds: seconds, PromptTokens: tokens},
+			{Day: periodStart, UserID: uuid.New(), AudioSeconds: 10 * 60, PromptTokens: 10_000},
+		}
+	}
+
+	s.Run("upload within budget", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, summaryCreateInput).
+			Return(nil, errors.New("some error"))
+
+		usage := new(gatewaymocks.UsageRepository)
+		usage.EXPECT().
+			GetUsage(mock.Anything, workspaceIDUUID, repository.UsageFilter{From: periodStart}).
+			Return(usageOf(3*60, 1_000), nil)
+
+		publisher := new(gatewaymocks.Publisher)
+
+		_, err := newBudget(usage, publisher, nil).CreateSummaryAndTriggerAIProccess(s.ctx, audio())
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+		publisher.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything)
+	})
+
+	s.Run("warn when 80 percent of the budget is consumed", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, summaryCreateInput).
+			Return(nil, errors.New("some error"))
+
+		usage := new(gatewaymocks.UsageRepository)
+		usage.EXPECT().
+			GetUsage(mock.Anything, workspaceIDUUID, repository.UsageFilter{From: periodStart}).
+			Return(usageOf(7.5*60, 1_000), nil)
+
+		var published events.Event
+		publisher := new(gatewaymocks.Publisher)
+		publisher.EXPECT().
+			Publish(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, event events.Event) { published = event })
+
+		_, err := newBudget(usage, publisher, nil).CreateSummaryAndTriggerAIProccess(s.ctx, audio())
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+		s.Equal(events.BudgetWarning, published.Type)
+		s.Equal(workspaceIDUUID, published.Budget.WorkspaceID)
+		s.Equal("2025-01", published.Budget.Period)
+		s.InDelta(8.5, published.Budget.AudioMinutesUsed, 0.001)
+	})
+
+	s.Run("reject upload above the budget", func() {
+		s.repository = newSummaryRepository()
+
+		usage := new(gatewaymocks.UsageRepository)
+		usage.EXPECT().
+			GetUsage(mock.Anything, workspaceIDUUID, repository.UsageFilter{From: periodStart}).
+			Return(usageOf(60, 9_500), nil)
+
+		_, err := newBudget(usage, new(gatewaymocks.Publisher), nil).CreateSummaryAndTriggerAIProccess(s.ctx, audio())
+		s.Require().ErrorIs(err, application.BudgetExceeded)
+		s.repository.AssertNotCalled(s.T(), "CreateSummary", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("estimate from the duration declared by the audio", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, summaryCreateInput).
+			Return(nil, errors.New("some error"))
+
+		usage := new(gatewaymocks.UsageRepository)
+		usage.EXPECT().
+			GetUsage(mock.Anything, workspaceIDUUID, repository.UsageFilter{From: periodStart}).
+			Return(usageOf(7*60, 1_000), nil)
+
+		// 5 minutes by the size, but the container declares 2 seconds
+		wav := SummaryAudioInput{Content: bytes.NewReader(wavAudio(2)), Size: estimatedBytesPerSecond * 60 * 5}
+
+		_, err := newBudget(usage, new(gatewaymocks.Publisher), nil).CreateSummaryAndTriggerAIProccess(s.ctx, wav)
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+	})
+
+	s.Run("duplicated upload is not charged", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByAudioHash(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(&repository.SummaryOutput{ExternalID: uuid.New(), Status: "SUMMARIZED"}, nil)
+
+		usage := new(gatewaymocks.UsageRepository)
+
+		output, err := newBudget(usage, new(gatewaymocks.Publisher), nil).CreateSummaryAndTriggerAIProccess(s.ctx, audio())
+		s.Require().NoError(err)
+		s.True(output.Duplicate)
+		usage.AssertNotCalled(s.T(), "GetUsage", mock.Anything, mock.Anything, mock.Anything)
+		s.repository.AssertNotCalled(s.T(), "CreateSummary", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("reject url upload when the budget is consumed", func() {
+		s.repository = newSummaryRepository()
+
+		usage := new(gatewaymocks.UsageRepository)
+		usage.EXPECT().
+			GetUsage(mock.Anything, workspaceIDUUID, repository.UsageFilter{From: periodStart}).
+			Return(usageOf(10*60, 1_000), nil)
+
+		_, err := newBudget(usage, new(gatewaymocks.Publisher), nil).
+			CreateSummaryFromURL(s.ctx, SummaryFromURLInput{URL: "https://example.com/meeting.mp3"})
+		s.Require().ErrorIs(err, application.BudgetExceeded)
+		s.repository.AssertNotCalled(s.T(), "CreateSummary", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("unlimited override skips the budget", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, summaryCreateInput).
+			Return(nil, errors.New("some error"))
+
+		usage := new(gatewaymocks.UsageRepository)
+		overrides := map[uuid.UUID]BudgetLimit{ownerIDUUID: {}}
+
+		_, err := newBudget(usage, new(gatewaymocks.Publisher), overrides).CreateSummaryAndTriggerAIProccess(s.ctx, audio())
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+		usage.AssertNotCalled(s.T(), "GetUsage", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("fail get consumed budget", func() {
+		usage := new(gatewaymocks.UsageRepository)
+		usage.EXPECT().
+			GetUsage(mock.Anything, workspaceIDUUID, repository.UsageFilter{From: periodStart}).
+			Return(nil, errors.New("some error"))
+
+		_, err := newBudget(usage, new(gatewaymocks.Publisher), nil).CreateSummaryAndTriggerAIProccess(s.ctx, audio())
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+	})
+}
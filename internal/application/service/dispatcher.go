@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/gateway/webhook"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"go.uber.org/zap"
+)
+
+const (
+	webhookEventHeader     = "X-ExplicAI-Event"
+	webhookEventIDHeader   = "X-ExplicAI-Event-Id"
+	webhookTimestampHeader = "X-ExplicAI-Timestamp"
+	webhookSignatureHeader = "X-ExplicAI-Signature"
+)
+
+type WebhookRetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+type WebhookDispatcher struct {
+	repository repository.WebhookRepository
+	sender     webhook.Sender
+	policy     WebhookRetryPolicy
+}
+
+func NewWebhookDispatcher(
+	repository repository.WebhookRepository,
+	sender webhook.Sender,
+	policy WebhookRetryPolicy,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		repository: repository,
+		sender:     sender,
+		policy:     policy,
+	}
+}
+
+func (d *WebhookDispatcher) Publish(ctx context.Context, event events.Event) {
+	go d.Dispatch(context.WithoutCancel(ctx), event)
+}
+
+func (d *WebhookDispatcher) Dispatch(ctx context.Context, event events.Event) {
+	if event.Summary == nil {
+		return
+	}
+
+	webhooks, err := d.repository.GetActiveWebhooksByStatus(ctx, event.Summary.WorkspaceID, event.Summary.Status)
+	if err != nil {
+		log.LogError(ctx, "failed to get webhooks to dispatch", err, zap.String("event_id", event.ID.String()))
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.LogError(ctx, "failed to marshal webhook event", err, zap.String("event_id", event.ID.String()))
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, target := range webhooks {
+		wg.Add(1)
+		go func(target repository.WebhookOutput) {
+			defer wg.Done()
+			d.deliver(ctx, target, event, payload)
+		}(target)
+	}
+
+	wg.Wait()
+}
+
+func (d *WebhookDispatcher) deliver(
+	ctx context.Context,
+	target repository.WebhookOutput,
+	event events.Event,
+	payload []byte,
+) {
+	fields := []zap.Field{
+		zap.String("webhook_id", target.ExternalID.String()),
+		zap.String("event_id", event.ID.String()),
+	}
+
+	for attempt := 1; attempt <= d.policy.MaxAttempts; attempt++ {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+		result, err := d.sender.Send(ctx, webhook.SendInput{
+			URL:     target.URL,
+			Payload: payload,
+			Headers: map[string]string{
+				webhookEventHeader:     string(event.Type),
+				webhookEventIDHeader:   event.ID.String(),
+				webhookTimestampHeader: timestamp,
+				webhookSignatureHeader: "sha256=" + signWebhookPayload(target.Secret, timestamp, payload),
+			},
+		})
+
+		delivery := repository.WebhookDeliveryCreateInput{
+			WebhookExternalID: target.ExternalID,
+			EventID:           event.ID,
+			EventType:         string(event.Type),
+			Attempt:           attempt,
+			Payload:           string(payload),
+		}
+
+		if err != nil {
+			delivery.Error = sql.NullString{String: err.Error(), Valid: true}
+		} else {
+			delivery.StatusCode = sql.NullInt32{Int32: int32(result.StatusCode), Valid: true}
+			delivery.Delivered = result.StatusCode >= http.StatusOK && result.StatusCode < http.StatusMultipleChoices
+			if !delivery.Delivered {
+				delivery.Error = sql.NullString{String: "unexpected status " + http.StatusText(result.StatusCode), Valid: true}
+			}
+		}
+
+		if err = d.repository.CreateWebhookDelivery(ctx, delivery); err != nil {
+			log.LogError(ctx, "failed to save webhook delivery in db", err, fields...)
+		}
+
+		if delivery.Delivered {
+			log.LogInfo(ctx, "successful webhook delivery", append(fields, zap.Int("attempt", attempt))...)
+			return
+		}
+
+		if attempt < d.policy.MaxAttempts {
+			time.Sleep(d.backoff(attempt))
+		}
+	}
+
+	log.LogWarn(ctx, "webhook delivery failed after all attempts",
+		append(fields, zap.Int("attempts", d.policy.MaxAttempts))...)
+}
+
+func (d *WebhookDispatcher) backoff(attempt int) time.Duration {
+	backoff := d.policy.InitialBackoff << (attempt - 1)
+	if backoff <= 0 || backoff > d.policy.MaxBackoff {
+		return d.policy.MaxBackoff
+	}
+
+	return backoff
+}
+
+func signWebhookPayload(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
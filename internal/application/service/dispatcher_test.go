@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/gateway/webhook"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+var retryPolicy = WebhookRetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     2 * time.Millisecond,
+}
+
+func statusChangedEvent() events.Event {
+	return events.Event{
+		ID:         uuid.MustParse("5f0c3a7e-1f7b-4b43-9b65-2f1f4f8f7e11"),
+		Type:       events.SummaryStatusChanged,
+		OccurredAt: createdAt,
+		Summary: &events.SummaryData{
+			ExternalID:     summaryExternalIDUUID,
+			WorkspaceID:    workspaceIDUUID,
+			Status:         "SUMMARIZED",
+			PreviousStatus: "TRANSCRIBED",
+			Progress:       100,
+		},
+	}
+}
+
+func (s *WebhookTestSuite) TestDispatch() {
+	s.Run("successful signed delivery", func() {
+		event := statusChangedEvent()
+
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetActiveWebhooksByStatus(mock.Anything, workspaceIDUUID, "SUMMARIZED").
+			Return([]repository.WebhookOutput{
+				{ExternalID: webhookExternalIDUUID, URL: webhookURL, Secret: webhookSecret, Active: true},
+			}, nil)
+		s.repository.EXPECT().
+			CreateWebhookDelivery(mock.Anything, mock.MatchedBy(func(input repository.WebhookDeliveryCreateInput) bool {
+				return input.Delivered && input.Attempt == 1 && input.StatusCode.Int32 == http.StatusNoContent
+			})).
+			Return(nil).Once()
+
+		var sent webhook.SendInput
+		s.sender = new(gatewaymocks.Sender)
+		s.sender.EXPECT().
+			Send(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, input webhook.SendInput) (*webhook.SendOutput, error) {
+				sent = input
+				return &webhook.SendOutput{StatusCode: http.StatusNoContent}, nil
+			}).Once()
+
+		dispatcher := NewWebhookDispatcher(s.repository, s.sender, retryPolicy)
+		dispatcher.Dispatch(s.ctx, event)
+
+		s.Equal(webhookURL, sent.URL)
+		s.Equal("summary.status_changed", sent.Headers[webhookEventHeader])
+		s.Equal(event.ID.String(), sent.Headers[webhookEventIDHeader])
+
+		mac := hmac.New(sha256.New, []byte(webhookSecret))
+		mac.Write([]byte(sent.Headers[webhookTimestampHeader] + "."))
+		mac.Write(sent.Payload)
+		s.Equal("sha256="+hex.EncodeToString(mac.Sum(nil)), sent.Headers[webhookSignatureHeader])
+
+		var payload events.Event
+		s.Require().NoError(json.Unmarshal(sent.Payload, &payload))
+		s.Equal(summaryExternalIDUUID, payload.Summary.ExternalID)
+		s.Equal("SUMMARIZED", payload.Summary.Status)
+		s.Equal("TRANSCRIBED", payload.Summary.PreviousStatus)
+
+		s.repository.AssertExpectations(s.T())
+		s.sender.AssertExpectations(s.T())
+	})
+
+	s.Run("retry failed deliveries until success", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetActiveWebhooksByStatus(mock.Anything, workspaceIDUUID, "SUMMARIZED").
+			Return([]repository.WebhookOutput{
+				{ExternalID: webhookExternalIDUUID, URL: webhookURL, Secret: webhookSecret, Active: true},
+			}, nil)
+
+		var attempts []repository.WebhookDeliveryCreateInput
+		s.repository.EXPECT().
+			CreateWebhookDelivery(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, input repository.WebhookDeliveryCreateInput) error {
+				attempts = append(attempts, input)
+				return nil
+			})
+
+		s.sender = new(gatewaymocks.Sender)
+		s.sender.EXPECT().
+			Send(mock.Anything, mock.Anything).
+			Return(nil, errors.New("connection refused")).Once()
+		s.sender.EXPECT().
+			Send(mock.Anything, mock.Anything).
+			Return(&webhook.SendOutput{StatusCode: http.StatusServiceUnavailable}, nil).Once()
+		s.sender.EXPECT().
+			Send(mock.Anything, mock.Anything).
+			Return(&webhook.SendOutput{StatusCode: http.StatusOK}, nil).Once()
+
+		dispatcher := NewWebhookDispatcher(s.repository, s.sender, retryPolicy)
+		dispatcher.Dispatch(s.ctx, statusChangedEvent())
+
+		s.Require().Len(attempts, 3)
+		s.Equal(1, attempts[0].Attempt)
+		s.Equal("connection refused", attempts[0].Error.String)
+		s.False(attempts[0].StatusCode.Valid)
+		s.Equal(2, attempts[1].Attempt)
+		s.Equal(int32(http.StatusServiceUnavailable), attempts[1].StatusCode.Int32)
+		s.False(attempts[1].Delivered)
+		s.Equal(3, attempts[2].Attempt)
+		s.True(attempts[2].Delivered)
+		s.sender.AssertExpectations(s.T())
+	})
+
+	s.Run("give up after max attempts", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetActiveWebhooksByStatus(mock.Anything, workspaceIDUUID, "SUMMARIZED").
+			Return([]repository.WebhookOutput{
+				{ExternalID: webhookExternalIDUUID, URL: webhookURL, Secret: webhookSecret, Active: true},
+			}, nil)
+		s.repository.EXPECT().
+			CreateWebhookDelivery(mock.Anything, mock.Anything).
+			Return(nil).Times(3)
+
+		s.sender = new(gatewaymocks.Sender)
+		s.sender.EXPECT().
+			Send(mock.Anything, mock.Anything).
+			Return(&webhook.SendOutput{StatusCode: http.StatusInternalServerError}, nil).Times(3)
+
+		dispatcher := NewWebhookDispatcher(s.repository, s.sender, retryPolicy)
+		dispatcher.Dispatch(s.ctx, statusChangedEvent())
+
+		s.repository.AssertExpectations(s.T())
+		s.sender.AssertExpectations(s.T())
+	})
+
+	s.Run("no subscribed webhooks", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetActiveWebhooksByStatus(mock.Anything, workspaceIDUUID, "SUMMARIZED").
+			Return(nil, nil)
+
+		s.sender = new(gatewaymocks.Sender)
+
+		dispatcher := NewWebhookDispatcher(s.repository, s.sender, retryPolicy)
+		dispatcher.Dispatch(s.ctx, statusChangedEvent())
+
+		s.sender.AssertNotCalled(s.T(), "Send", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *WebhookTestSuite) TestBackoff() {
+	dispatcher := NewWebhookDispatcher(nil, nil, WebhookRetryPolicy{
+		MaxAttempts:    10,
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+	})
+
+	s.Equal(time.Second, dispatcher.backoff(1))
+	s.Equal(2*time.Second, dispatcher.backoff(2))
+	s.Equal(4*time.Second, dispatcher.backoff(3))
+	s.Equal(8*time.Second, dispatcher.backoff(4))
+	s.Equal(10*time.Second, dispatcher.backoff(5))
+	s.Equal(10*time.Second, dispatcher.backoff(70))
+}
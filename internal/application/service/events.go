@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+)
+
+type EventUseCase interface {
+	SubscribeEvents(ctx context.Context) (*EventSubscriptionOutput, error)
+	SubscribeSummaryEvents(ctx context.Context, externalID uuid.UUID) (*EventSubscriptionOutput, error)
+}
+
+type Events struct {
+	repository repository.Repository
+	subscriber events.Subscriber
+}
+
+func NewEvents(repository repository.Repository, subscriber events.Subscriber) *Events {
+	return &Events{
+		repository: repository,
+		subscriber: subscriber,
+	}
+}
+
+// SubscribeEvents streams the status changes of every summary owned by the
+// caller, along with the budget warnings of the workspace.
+func (e *Events) SubscribeEvents(ctx context.Context) (*EventSubscriptionOutput, error) {
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source, unsubscribe := e.subscriber.Subscribe(ctx)
+
+	return forwardEvents(source, unsubscribe, nil, func(event events.Event) (bool, bool) {
+		if event.Budget != nil {
+			return event.Budget.WorkspaceID == workspaceID, false
+		}
+
+		return event.Summary != nil && event.Summary.WorkspaceID == workspaceID, false
+	}), nil
+}
+
+// SubscribeSummaryEvents streams the current status of the summary followed by
+// its status changes. The stream ends once the summary reaches a final status.
+func (e *Events) SubscribeSummaryEvents(ctx context.Context, externalID uuid.UUID) (*EventSubscriptionOutput, error) {
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// subscribe before reading the current status so no change is lost in between
+	source, unsubscribe := e.subscriber.Subscribe(ctx)
+
+	summary, err := e.repository.GetSummaryByExternalID(ctx, workspaceID, externalID)
+	if err == application.SummaryNotFound {
+		unsubscribe()
+		return nil, err
+	}
+
+	if err != nil {
+		unsubscribe()
+		log.LogError(ctx, "error on get summary to subscribe events", err)
+		return nil, err
+	}
+
+	snapshot := events.Event{
+		ID:         uuid.New(),
+		Type:       events.SummaryStatusSnapshot,
+		OccurredAt: time.Now(),
+		Summary: &events.SummaryData{
+			ExternalID:  summary.ExternalID,
+			WorkspaceID: workspaceID,
+			Status:      summary.Status,
+			Progress:    int(summary.Progress.Int32),
+		},
+	}
+
+	return forwardEvents(source, unsubscribe, &snapshot, func(event events.Event) (bool, bool) {
+		if event.Summary == nil || event.Summary.ExternalID != externalID {
+			return false, false
+		}
+		return true, isFinalStatus(event.Summary.Status)
+	}), nil
+}
+
+// forwardEvents relays the initial event and then the source events accepted
+// by filter, until filter marks an event as the last one, the source ends or
+// the subscription is closed.
+func forwardEvents(
+	source <-chan events.Event,
+	unsubscribe func(),
+	initial *events.Event,
+	filter func(events.Event) (accept bool, last bool),
+) *EventSubscriptionOutput {
+	output := make(chan events.Event)
+	done := make(chan struct{})
+
+	var once sync.Once
+	closeSubscription := func() {
+		once.Do(func() {
+			close(done)
+			unsubscribe()
+		})
+	}
+
+	go func() {
+		defer close(output)
+
+		next := initial
+		for {
+			if next == nil {
+				select {
+				case event, ok := <-source:
+					if !ok {
+						return
+					}
+					next = &event
+				case <-done:
+					return
+				}
+			}
+
+			event := *next
+			next = nil
+
+			accept, last := filter(event)
+			if !accept {
+				continue
+			}
+
+			select {
+			case output <- event:
+			case <-done:
+				return
+			}
+
+			if last {
+				return
+			}
+		}
+	}()
+
+	return &EventSubscriptionOutput{
+		Events: output,
+		Close:  closeSubscription,
+	}
+}
+
+func isFinalStatus(status string) bool {
+	switch status {
+	case repository.StatusToString[repository.Summarized].Status,
+		repository.StatusToString[repository.SummarizedFailed].Status,
+		repository.StatusToString[repository.TranscribedFailed].Status,
+		repository.StatusToString[repository.DownloadFailed].Status:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func statusEvent(externalID uuid.UUID, status repository.Status) events.Event {
+	return events.Event{
+		ID:   uuid.New(),
+		Type: events.SummaryStatusChanged,
+		Summary: &events.SummaryData{
+			ExternalID:  externalID,
+			WorkspaceID: workspaceIDUUID,
+			Status:      repository.StatusToString[status].Status,
+			Progress:    repository.StatusToString[status].Percentage,
+		},
+	}
+}
+
+func (s *SummaryTestSuite) subscriberWith(source chan events.Event, unsubscribed *bool) *gatewaymocks.Subscriber {
+	subscriber := new(gatewaymocks.Subscriber)
+	subscriber.EXPECT().
+		Subscribe(mock.Anything).
+		Return((<-chan events.Event)(source), func() { *unsubscribed = true })
+	return subscriber
+}
+
+func (s *SummaryTestSuite) TestSubscribeSummaryEvents() {
+	s.Run("stream snapshot and changes until final status", func() {
+		source := make(chan events.Event, 3)
+		source <- statusEvent(uuid.New(), repository.Trancribed)
+		source <- statusEvent(summaryExternalIDUUID, repository.Trancribed)
+		source <- statusEvent(summaryExternalIDUUID, repository.Summarized)
+
+		unsubscribed := false
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(&repository.SummaryOutput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     "RECEIVED_FILE",
+				Progress:   sql.NullInt32{Int32: 33, Valid: true},
+			}, nil)
+
+		service := NewEvents(s.repository, s.subscriberWith(source, &unsubscribed))
+		output, err := service.SubscribeSummaryEvents(s.ctx, summaryExternalIDUUID)
+		s.Require().NoError(err)
+		defer output.Close()
+
+		var received []events.Event
+		for event := range output.Events {
+			received = append(received, event)
+		}
+
+		s.Require().Len(received, 3)
+		s.Equal(events.SummaryStatusSnapshot, received[0].Type)
+		s.Equal("RECEIVED_FILE", received[0].Summary.Status)
+		s.Equal(33, received[0].Summary.Progress)
+		s.Equal("TRANSCRIBED", received[1].Summary.Status)
+		s.Equal("SUMMARIZED", received[2].Summary.Status)
+	})
+
+	s.Run("stream only snapshot of finished summary", func() {
+		unsubscribed := false
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(&repository.SummaryOutput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     "SUMMARIZED_FAILED",
+			}, nil)
+
+		service := NewEvents(s.repository, s.subscriberWith(make(chan events.Event), &unsubscribed))
+		output, err := service.SubscribeSummaryEvents(s.ctx, summaryExternalIDUUID)
+		s.Require().NoError(err)
+
+		event := <-output.Events
+		s.Equal("SUMMARIZED_FAILED", event.Summary.Status)
+		_, open := <-output.Events
+		s.False(open)
+
+		output.Close()
+		s.True(unsubscribed)
+	})
+
+	s.Run("close subscription before reading", func() {
+		unsubscribed := false
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(&repository.SummaryOutput{ExternalID: summaryExternalIDUUID, Status: "RECEIVED_FILE"}, nil)
+
+		service := NewEvents(s.repository, s.subscriberWith(make(chan events.Event), &unsubscribed))
+		output, err := service.SubscribeSummaryEvents(s.ctx, summaryExternalIDUUID)
+		s.Require().NoError(err)
+
+		output.Close()
+		output.Close()
+
+		for range output.Events {
+		}
+		s.True(unsubscribed)
+	})
+
+	s.Run("summary not found", func() {
+		unsubscribed := false
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(nil, application.SummaryNotFound)
+
+		service := NewEvents(s.repository, s.subscriberWith(make(chan events.Event), &unsubscribed))
+		_, err := service.SubscribeSummaryEvents(s.ctx, summaryExternalIDUUID)
+		s.Require().ErrorIs(err, application.SummaryNotFound)
+		s.True(unsubscribed)
+	})
+
+	s.Run("fail get summary", func() {
+		unsubscribed := false
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(nil, errors.New("some error"))
+
+		service := NewEvents(s.repository, s.subscriberWith(make(chan events.Event), &unsubscribed))
+		_, err := service.SubscribeSummaryEvents(s.ctx, summaryExternalIDUUID)
+		s.Require().Error(err)
+		s.True(unsubscribed)
+	})
+}
+
+func (s *SummaryTestSuite) TestSubscribeEvents() {
+	s.Run("stream events of the caller workspace summaries", func() {
+		source := make(chan events.Event, 3)
+		first := statusEvent(uuid.New(), repository.ReceivedFile)
+		other := statusEvent(uuid.New(), repository.ReceivedFile)
+		other.Summary.WorkspaceID = uuid.New()
+		second := statusEvent(summaryExternalIDUUID, repository.Summarized)
+		source <- first
+		source <- other
+		source <- second
+
+		unsubscribed := false
+		service := NewEvents(new(gatewaymocks.Repository), s.subscriberWith(source, &unsubscribed))
+		output, err := service.SubscribeEvents(s.ctx)
+		s.Require().NoError(err)
+
+		s.Equal(first, <-output.Events)
+		s.Equal(second, <-output.Events)
+
+		output.Close()
+		s.True(unsubscribed)
+	})
+
+	s.Run("stream budget warnings of the caller workspace", func() {
+		source := make(chan events.Event, 2)
+		other := events.Event{ID: uuid.New(), Type: events.BudgetWarning, Budget: &events.BudgetData{WorkspaceID: uuid.New()}}
+		warning := events.Event{ID: uuid.New(), Type: events.BudgetWarning, Budget: &events.BudgetData{WorkspaceID: workspaceIDUUID}}
+		source <- other
+		source <- warning
+
+		unsubscribed := false
+		service := NewEvents(new(gatewaymocks.Repository), s.subscriberWith(source, &unsubscribed))
+		output, err := service.SubscribeEvents(s.ctx)
+		s.Require().NoError(err)
+
+		s.Equal(warning, <-output.Events)
+
+		output.Close()
+		s.True(unsubscribed)
+	})
+
+	s.Run("subscribe without principal", func() {
+		service := NewEvents(new(gatewaymocks.Repository), new(gatewaymocks.Subscriber))
+		_, err := service.SubscribeEvents(context.Background())
+		s.Require().ErrorIs(err, application.Unauthorized)
+
+		_, err = service.SubscribeSummaryEvents(context.Background(), summaryExternalIDUUID)
+		s.Require().ErrorIs(err, application.Unauthorized)
+	})
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/document"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ExportUseCase interface {
+	ExportSummary(ctx context.Context, externalID uuid.UUID, format string) (*SummaryExportOutput, error)
+	ExportSummariesCSV(ctx context.Context, filter SummaryFilterInput, w io.Writer) error
+}
+
+var summariesCSVHeader = []string{
+	"externalId",
+	"status",
+	"createdAt",
+	"updatedAt",
+	"title",
+	"description",
+	"briefResume",
+}
+
+type Export struct {
+	repository repository.Repository
+	renderer   document.Renderer
+}
+
+func NewExport(repository repository.Repository, renderer document.Renderer) *Export {
+	return &Export{
+		repository: repository,
+		renderer:   renderer,
+	}
+}
+
+func (e *Export) ExportSummary(ctx context.Context, externalID uuid.UUID, format string) (*SummaryExportOutput, error) {
+	documentFormat := document.Format(format)
+	formatDomain, ok := document.Formats[documentFormat]
+	if !ok {
+		return nil, application.InvalidExportFormat
+	}
+
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := e.repository.GetSummaryByExternalID(ctx, workspaceID, externalID)
+	if err == application.SummaryNotFound {
+		return nil, err
+	}
+
+	if err != nil {
+		log.LogError(ctx, "error on get summary to export", err)
+		return nil, err
+	}
+
+	content, err := e.renderer.Render(ctx, documentFormat, document.SummaryDocument{
+		ExternalID:   summary.ExternalID,
+		Status:       summary.Status,
+		CreatedAt:    summary.CreatedAt,
+		UpdatedAt:    summary.UpdatedAt,
+		Title:        summary.Title.String,
+		Description:  summary.Description.String,
+		BriefResume:  summary.BriefResume.String,
+		MediumResume: summary.MediumResume.String,
+		FullText:     summary.FullText.String,
+	})
+	if err != nil {
+		log.LogError(ctx, "failed to render summary document", err,
+			zap.String("external_id", externalID.String()), zap.String("format", format))
+		return nil, application.ExportFailed
+	}
+
+	return &SummaryExportOutput{
+		FileName:    "summary-" + summary.ExternalID.String() + formatDomain.Extension,
+		ContentType: formatDomain.ContentType,
+		Content:     content,
+	}, nil
+}
+
+func (e *Export) ExportSummariesCSV(ctx context.Context, filter SummaryFilterInput, w io.Writer) error {
+	repositoryFilter, err := filter.toRepositoryFilter()
+	if err != nil {
+		return err
+	}
+
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err = writer.Write(summariesCSVHeader); err != nil {
+		log.LogError(ctx, "failed to write summaries csv header", err)
+		return application.ExportFailed
+	}
+
+	err = e.repository.StreamSummaries(ctx, workspaceID, repositoryFilter, func(summary repository.SummaryOutput) error {
+		return writer.Write([]string{
+			summary.ExternalID.String(),
+			summary.Status,
+			summary.CreatedAt.Format(time.RFC3339),
+			summary.UpdatedAt.Format(time.RFC3339),
+			summary.Title.String,
+			summary.Description.String,
+			summary.BriefResume.String,
+		})
+	})
+	if err != nil {
+		log.LogError(ctx, "failed to stream summaries csv", err)
+		return application.ExportFailed
+	}
+
+	writer.Flush()
+	if err = writer.Error(); err != nil {
+		log.LogError(ctx, "failed to flush summaries csv", err)
+		return application.ExportFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/document"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *SummaryTestSuite) TestExportSummary() {
+	s.Run("successful export summary", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(&repository.SummaryOutput{
+				ExternalID:   summaryExternalIDUUID,
+				Status:       repository.StatusToString[repository.Summarized].Status,
+				CreatedAt:    createdAt,
+				UpdatedAt:    createdAt,
+				Progress:     sql.NullInt32{Int32: 100, Valid: true},
+				Title:        sql.NullString{String: title, Valid: true},
+				Description:  sql.NullString{String: description, Valid: true},
+				BriefResume:  sql.NullString{String: briefResume, Valid: true},
+				MediumResume: sql.NullString{String: mediumResume, Valid: true},
+				FullText:     sql.NullString{String: fulltext, Valid: true},
+			}, nil)
+
+		renderer := new(gatewaymocks.Renderer)
+		renderer.EXPECT().
+			Render(mock.Anything, document.Markdown, document.SummaryDocument{
+				ExternalID:   summaryExternalIDUUID,
+				Status:       repository.StatusToString[repository.Summarized].Status,
+				CreatedAt:    createdAt,
+				UpdatedAt:    createdAt,
+				Title:        title,
+				Description:  description,
+				BriefResume:  briefResume,
+				MediumResume: mediumResume,
+				FullText:     fulltext,
+			}).
+			Return([]byte("# title"), nil)
+
+		service := NewExport(s.repository, renderer)
+		output, err := service.ExportSummary(s.ctx, summaryExternalIDUUID, "md")
+		s.Require().NoError(err)
+		s.Equal("summary-"+summaryExternalIDStr+".md", output.FileName)
+		s.Equal("text/markdown; charset=utf-8", output.ContentType)
+		s.Equal([]byte("# title"), output.Content)
+	})
+
+	s.Run("invalid export format", func() {
+		s.repository = new(gatewaymocks.Repository)
+		renderer := new(gatewaymocks.Renderer)
+
+		service := NewExport(s.repository, renderer)
+		_, err := service.ExportSummary(s.ctx, summaryExternalIDUUID, "pdf")
+		s.Require().ErrorIs(err, application.InvalidExportFormat)
+		s.repository.AssertNotCalled(s.T(), "GetSummaryByExternalID", mock.Anything, mock.Anything)
+	})
+
+	s.Run("export summary not found", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(nil, application.SummaryNotFound)
+
+		service := NewExport(s.repository, new(gatewaymocks.Renderer))
+		_, err := service.ExportSummary(s.ctx, summaryExternalIDUUID, "html")
+		s.Require().ErrorIs(err, application.SummaryNotFound)
+	})
+
+	s.Run("fail render summary document", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
+			Return(&repository.SummaryOutput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     repository.StatusToString[repository.ReceivedFile].Status,
+				CreatedAt:  createdAt,
+				UpdatedAt:  createdAt,
+			}, nil)
+
+		renderer := new(gatewaymocks.Renderer)
+		renderer.EXPECT().
+			Render(mock.Anything, document.JSON, mock.Anything).
+			Return(nil, errors.New("some error"))
+
+		service := NewExport(s.repository, renderer)
+		_, err := service.ExportSummary(s.ctx, summaryExternalIDUUID, "json")
+		s.Require().ErrorIs(err, application.ExportFailed)
+	})
+}
+
+func (s *SummaryTestSuite) TestExportSummariesCSV() {
+	s.Run("successful export summaries csv", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			StreamSummaries(mock.Anything, workspaceIDUUID, repository.SummaryFilter{Status: "SUMMARIZED"}, mock.Anything).
+			RunAndReturn(func(ctx context.Context, workspaceID uuid.UUID, filter repository.SummaryFilter, fn func(repository.SummaryOutput) error) error {
+				s.Require().NoError(fn(repository.SummaryOutput{
+					ExternalID:  summaryExternalIDUUID,
+					Status:      "SUMMARIZED",
+					CreatedAt:   createdAt,
+					UpdatedAt:   createdAt,
+					Title:       sql.NullString{String: "title, with comma", Valid: true},
+					Description: sql.NullString{String: description, Valid: true},
+					BriefResume: sql.NullString{String: "line 1\nline \"2\"", Valid: true},
+				}))
+				return nil
+			})
+
+		var buf bytes.Buffer
+		service := NewExport(s.repository, new(gatewaymocks.Renderer))
+		err := service.ExportSummariesCSV(s.ctx, SummaryFilterInput{Status: "SUMMARIZED"}, &buf)
+		s.Require().NoError(err)
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		s.Require().NoError(err)
+		s.Len(records, 2)
+		s.Equal(summariesCSVHeader, records[0])
+		s.Equal([]string{
+			summaryExternalIDStr,
+			"SUMMARIZED",
+			"2025-01-25T15:04:05Z",
+			"2025-01-25T15:04:05Z",
+			"title, with comma",
+			description,
+			"line 1\nline \"2\"",
+		}, records[1])
+	})
+
+	s.Run("invalid filter on export summaries csv", func() {
+		s.repository = new(gatewaymocks.Repository)
+
+		var buf bytes.Buffer
+		service := NewExport(s.repository, new(gatewaymocks.Renderer))
+		err := service.ExportSummariesCSV(s.ctx, SummaryFilterInput{Status: "UNKNOWN"}, &buf)
+		s.Require().ErrorIs(err, application.InvalidSummaryFilter)
+		s.Empty(buf.String())
+	})
+
+	s.Run("fail stream summaries csv", func() {
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			StreamSummaries(mock.Anything, workspaceIDUUID, repository.SummaryFilter{}, mock.Anything).
+			Return(errors.New("some error"))
+
+		var buf bytes.Buffer
+		service := NewExport(s.repository, new(gatewaymocks.Renderer))
+		err := service.ExportSummariesCSV(s.ctx, SummaryFilterInput{}, &buf)
+		s.Require().ErrorIs(err, application.ExportFailed)
+	})
+}
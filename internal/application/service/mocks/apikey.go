@@ -0,0 +1,265 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package servicemocks
+
+import (
+	context "context"
+
+	auth "github.com/diegofsousa/explicAI/internal/application/auth"
+
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/diegofsousa/explicAI/internal/application/service"
+
+	uuid "github.com/google/uuid"
+)
+
+// APIKeyUseCase is an autogenerated mock type for the APIKeyUseCase type
+type APIKeyUseCase struct {
+	mock.Mock
+}
+
+type APIKeyUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *APIKeyUseCase) EXPECT() *APIKeyUseCase_Expecter {
+	return &APIKeyUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function with given fields: ctx, key
+func (_m *APIKeyUseCase) Authenticate(ctx context.Context, key string) (*auth.Principal, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *auth.Principal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*auth.Principal, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *auth.Principal); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Principal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyUseCase_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type APIKeyUseCase_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *APIKeyUseCase_Expecter) Authenticate(ctx interface{}, key interface{}) *APIKeyUseCase_Authenticate_Call {
+	return &APIKeyUseCase_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, key)}
+}
+
+func (_c *APIKeyUseCase_Authenticate_Call) Run(run func(ctx context.Context, key string)) *APIKeyUseCase_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *APIKeyUseCase_Authenticate_Call) Return(_a0 *auth.Principal, _a1 error) *APIKeyUseCase_Authenticate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyUseCase_Authenticate_Call) RunAndReturn(run func(context.Context, string) (*auth.Principal, error)) *APIKeyUseCase_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, input
+func (_m *APIKeyUseCase) CreateAPIKey(ctx context.Context, input service.APIKeyCreateInput) (*service.APIKeyOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *service.APIKeyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.APIKeyCreateInput) (*service.APIKeyOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.APIKeyCreateInput) *service.APIKeyOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.APIKeyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.APIKeyCreateInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyUseCase_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type APIKeyUseCase_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input service.APIKeyCreateInput
+func (_e *APIKeyUseCase_Expecter) CreateAPIKey(ctx interface{}, input interface{}) *APIKeyUseCase_CreateAPIKey_Call {
+	return &APIKeyUseCase_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", ctx, input)}
+}
+
+func (_c *APIKeyUseCase_CreateAPIKey_Call) Run(run func(ctx context.Context, input service.APIKeyCreateInput)) *APIKeyUseCase_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.APIKeyCreateInput))
+	})
+	return _c
+}
+
+func (_c *APIKeyUseCase_CreateAPIKey_Call) Return(_a0 *service.APIKeyOutput, _a1 error) *APIKeyUseCase_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyUseCase_CreateAPIKey_Call) RunAndReturn(run func(context.Context, service.APIKeyCreateInput) (*service.APIKeyOutput, error)) *APIKeyUseCase_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function with given fields: ctx
+func (_m *APIKeyUseCase) ListAPIKeys(ctx context.Context) (*service.APIKeyListOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 *service.APIKeyListOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*service.APIKeyListOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *service.APIKeyListOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.APIKeyListOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyUseCase_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type APIKeyUseCase_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *APIKeyUseCase_Expecter) ListAPIKeys(ctx interface{}) *APIKeyUseCase_ListAPIKeys_Call {
+	return &APIKeyUseCase_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", ctx)}
+}
+
+func (_c *APIKeyUseCase_ListAPIKeys_Call) Run(run func(ctx context.Context)) *APIKeyUseCase_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *APIKeyUseCase_ListAPIKeys_Call) Return(_a0 *service.APIKeyListOutput, _a1 error) *APIKeyUseCase_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyUseCase_ListAPIKeys_Call) RunAndReturn(run func(context.Context) (*service.APIKeyListOutput, error)) *APIKeyUseCase_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: ctx, externalID
+func (_m *APIKeyUseCase) RevokeAPIKey(ctx context.Context, externalID uuid.UUID) error {
+	ret := _m.Called(ctx, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, externalID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// APIKeyUseCase_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type APIKeyUseCase_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID uuid.UUID
+func (_e *APIKeyUseCase_Expecter) RevokeAPIKey(ctx interface{}, externalID interface{}) *APIKeyUseCase_RevokeAPIKey_Call {
+	return &APIKeyUseCase_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", ctx, externalID)}
+}
+
+func (_c *APIKeyUseCase_RevokeAPIKey_Call) Run(run func(ctx context.Context, externalID uuid.UUID)) *APIKeyUseCase_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *APIKeyUseCase_RevokeAPIKey_Call) Return(_a0 error) *APIKeyUseCase_RevokeAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *APIKeyUseCase_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *APIKeyUseCase_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAPIKeyUseCase creates a new instance of APIKeyUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyUseCase {
+	mock := &APIKeyUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
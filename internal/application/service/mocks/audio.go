@@ -0,0 +1,98 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package servicemocks
+
+import (
+	context "context"
+
+	service "github.com/diegofsousa/explicAI/internal/application/service"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// AudioUseCase is an autogenerated mock type for the AudioUseCase type
+type AudioUseCase struct {
+	mock.Mock
+}
+
+type AudioUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AudioUseCase) EXPECT() *AudioUseCase_Expecter {
+	return &AudioUseCase_Expecter{mock: &_m.Mock}
+}
+
+// GetSummaryAudio provides a mock function with given fields: ctx, externalID
+func (_m *AudioUseCase) GetSummaryAudio(ctx context.Context, externalID uuid.UUID) (*service.AudioOutput, error) {
+	ret := _m.Called(ctx, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSummaryAudio")
+	}
+
+	var r0 *service.AudioOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*service.AudioOutput, error)); ok {
+		return rf(ctx, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *service.AudioOutput); ok {
+		r0 = rf(ctx, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.AudioOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AudioUseCase_GetSummaryAudio_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSummaryAudio'
+type AudioUseCase_GetSummaryAudio_Call struct {
+	*mock.Call
+}
+
+// GetSummaryAudio is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID uuid.UUID
+func (_e *AudioUseCase_Expecter) GetSummaryAudio(ctx interface{}, externalID interface{}) *AudioUseCase_GetSummaryAudio_Call {
+	return &AudioUseCase_GetSummaryAudio_Call{Call: _e.mock.On("GetSummaryAudio", ctx, externalID)}
+}
+
+func (_c *AudioUseCase_GetSummaryAudio_Call) Run(run func(ctx context.Context, externalID uuid.UUID)) *AudioUseCase_GetSummaryAudio_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AudioUseCase_GetSummaryAudio_Call) Return(_a0 *service.AudioOutput, _a1 error) *AudioUseCase_GetSummaryAudio_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AudioUseCase_GetSummaryAudio_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*service.AudioOutput, error)) *AudioUseCase_GetSummaryAudio_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAudioUseCase creates a new instance of AudioUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAudioUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AudioUseCase {
+	mock := &AudioUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,156 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package servicemocks
+
+import (
+	context "context"
+
+	service "github.com/diegofsousa/explicAI/internal/application/service"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// EventUseCase is an autogenerated mock type for the EventUseCase type
+type EventUseCase struct {
+	mock.Mock
+}
+
+type EventUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EventUseCase) EXPECT() *EventUseCase_Expecter {
+	return &EventUseCase_Expecter{mock: &_m.Mock}
+}
+
+// SubscribeEvents provides a mock function with given fields: ctx
+func (_m *EventUseCase) SubscribeEvents(ctx context.Context) (*service.EventSubscriptionOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeEvents")
+	}
+
+	var r0 *service.EventSubscriptionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*service.EventSubscriptionOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *service.EventSubscriptionOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.EventSubscriptionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventUseCase_SubscribeEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeEvents'
+type EventUseCase_SubscribeEvents_Call struct {
+	*mock.Call
+}
+
+// SubscribeEvents is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *EventUseCase_Expecter) SubscribeEvents(ctx interface{}) *EventUseCase_SubscribeEvents_Call {
+	return &EventUseCase_SubscribeEvents_Call{Call: _e.mock.On("SubscribeEvents", ctx)}
+}
+
+func (_c *EventUseCase_SubscribeEvents_Call) Run(run func(ctx context.Context)) *EventUseCase_SubscribeEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *EventUseCase_SubscribeEvents_Call) Return(_a0 *service.EventSubscriptionOutput, _a1 error) *EventUseCase_SubscribeEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EventUseCase_SubscribeEvents_Call) RunAndReturn(run func(context.Context) (*service.EventSubscriptionOutput, error)) *EventUseCase_SubscribeEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeSummaryEvents provides a mock function with given fields: ctx, externalID
+func (_m *EventUseCase) SubscribeSummaryEvents(ctx context.Context, externalID uuid.UUID) (*service.EventSubscriptionOutput, error) {
+	ret := _m.Called(ctx, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeSummaryEvents")
+	}
+
+	var r0 *service.EventSubscriptionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*service.EventSubscriptionOutput, error)); ok {
+		return rf(ctx, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *service.EventSubscriptionOutput); ok {
+		r0 = rf(ctx, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.EventSubscriptionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventUseCase_SubscribeSummaryEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeSummaryEvents'
+type EventUseCase_SubscribeSummaryEvents_Call struct {
+	*mock.Call
+}
+
+// SubscribeSummaryEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID uuid.UUID
+func (_e *EventUseCase_Expecter) SubscribeSummaryEvents(ctx interface{}, externalID interface{}) *EventUseCase_SubscribeSummaryEvents_Call {
+	return &EventUseCase_SubscribeSummaryEvents_Call{Call: _e.mock.On("SubscribeSummaryEvents", ctx, externalID)}
+}
+
+func (_c *EventUseCase_SubscribeSummaryEvents_Call) Run(run func(ctx context.Context, externalID uuid.UUID)) *EventUseCase_SubscribeSummaryEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *EventUseCase_SubscribeSummaryEvents_Call) Return(_a0 *service.EventSubscriptionOutput, _a1 error) *EventUseCase_SubscribeSummaryEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EventUseCase_SubscribeSummaryEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*service.EventSubscriptionOutput, error)) *EventUseCase_SubscribeSummaryEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEventUseCase creates a new instance of EventUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventUseCase {
+	mock := &EventUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
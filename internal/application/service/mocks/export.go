@@ -0,0 +1,149 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package servicemocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/diegofsousa/explicAI/internal/application/service"
+
+	uuid "github.com/google/uuid"
+)
+
+// ExportUseCase is an autogenerated mock type for the ExportUseCase type
+type ExportUseCase struct {
+	mock.Mock
+}
+
+type ExportUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExportUseCase) EXPECT() *ExportUseCase_Expecter {
+	return &ExportUseCase_Expecter{mock: &_m.Mock}
+}
+
+// ExportSummariesCSV provides a mock function with given fields: ctx, filter, w
+func (_m *ExportUseCase) ExportSummariesCSV(ctx context.Context, filter service.SummaryFilterInput, w io.Writer) error {
+	ret := _m.Called(ctx, filter, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportSummariesCSV")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.SummaryFilterInput, io.Writer) error); ok {
+		r0 = rf(ctx, filter, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportUseCase_ExportSummariesCSV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportSummariesCSV'
+type ExportUseCase_ExportSummariesCSV_Call struct {
+	*mock.Call
+}
+
+// ExportSummariesCSV is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter service.SummaryFilterInput
+//   - w io.Writer
+func (_e *ExportUseCase_Expecter) ExportSummariesCSV(ctx interface{}, filter interface{}, w interface{}) *ExportUseCase_ExportSummariesCSV_Call {
+	return &ExportUseCase_ExportSummariesCSV_Call{Call: _e.mock.On("ExportSummariesCSV", ctx, filter, w)}
+}
+
+func (_c *ExportUseCase_ExportSummariesCSV_Call) Run(run func(ctx context.Context, filter service.SummaryFilterInput, w io.Writer)) *ExportUseCase_ExportSummariesCSV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.SummaryFilterInput), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *ExportUseCase_ExportSummariesCSV_Call) Return(_a0 error) *ExportUseCase_ExportSummariesCSV_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExportUseCase_ExportSummariesCSV_Call) RunAndReturn(run func(context.Context, service.SummaryFilterInput, io.Writer) error) *ExportUseCase_ExportSummariesCSV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportSummary provides a mock function with given fields: ctx, externalID, format
+func (_m *ExportUseCase) ExportSummary(ctx context.Context, externalID uuid.UUID, format string) (*service.SummaryExportOutput, error) {
+	ret := _m.Called(ctx, externalID, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportSummary")
+	}
+
+	var r0 *service.SummaryExportOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*service.SummaryExportOutput, error)); ok {
+		return rf(ctx, externalID, format)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *service.SummaryExportOutput); ok {
+		r0 = rf(ctx, externalID, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.SummaryExportOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, externalID, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportUseCase_ExportSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportSummary'
+type ExportUseCase_ExportSummary_Call struct {
+	*mock.Call
+}
+
+// ExportSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID uuid.UUID
+//   - format string
+func (_e *ExportUseCase_Expecter) ExportSummary(ctx interface{}, externalID interface{}, format interface{}) *ExportUseCase_ExportSummary_Call {
+	return &ExportUseCase_ExportSummary_Call{Call: _e.mock.On("ExportSummary", ctx, externalID, format)}
+}
+
+func (_c *ExportUseCase_ExportSummary_Call) Run(run func(ctx context.Context, externalID uuid.UUID, format string)) *ExportUseCase_ExportSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ExportUseCase_ExportSummary_Call) Return(_a0 *service.SummaryExportOutput, _a1 error) *ExportUseCase_ExportSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExportUseCase_ExportSummary_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*service.SummaryExportOutput, error)) *ExportUseCase_ExportSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExportUseCase creates a new instance of ExportUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExportUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExportUseCase {
+	mock := &ExportUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -25,7 +25,7 @@ func (_m *SummaryUseCase) EXPECT() *SummaryUseCase_Expecter {
 }
 
 // CreateSummaryAndTriggerAIProccess provides a mock function with given fields: ctx, audio
-func (_m *SummaryUseCase) CreateSummaryAndTriggerAIProccess(ctx context.Context, audio []byte) (*service.SummarySimpleOutput, error) {
+func (_m *SummaryUseCase) CreateSummaryAndTriggerAIProccess(ctx context.Context, audio service.SummaryAudioInput) (*service.SummarySimpleOutput, error) {
 	ret := _m.Called(ctx, audio)
 
 	if len(ret) == 0 {
@@ -34,10 +34,10 @@ func (_m *SummaryUseCase) CreateSummaryAndTriggerAIProccess(ctx context.Context,
 
 	var r0 *service.SummarySimpleOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []byte) (*service.SummarySimpleOutput, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, service.SummaryAudioInput) (*service.SummarySimpleOutput, error)); ok {
 		return rf(ctx, audio)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []byte) *service.SummarySimpleOutput); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, service.SummaryAudioInput) *service.SummarySimpleOutput); ok {
 		r0 = rf(ctx, audio)
 	} else {
 		if ret.Get(0) != nil {
@@ -45,7 +45,7 @@ func (_m *SummaryUseCase) CreateSummaryAndTriggerAIProccess(ctx context.Context,
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, service.SummaryAudioInput) error); ok {
 		r1 = rf(ctx, audio)
 	} else {
 		r1 = ret.Error(1)
@@ -61,14 +61,14 @@ type SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call struct {
 
 // CreateSummaryAndTriggerAIProccess is a helper method to define mock.On call
 //   - ctx context.Context
-//   - audio []byte
+//   - audio service.SummaryAudioInput
 func (_e *SummaryUseCase_Expecter) CreateSummaryAndTriggerAIProccess(ctx interface{}, audio interface{}) *SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call {
 	return &SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call{Call: _e.mock.On("CreateSummaryAndTriggerAIProccess", ctx, audio)}
 }
 
-func (_c *SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call) Run(run func(ctx context.Context, audio []byte)) *SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call {
+func (_c *SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call) Run(run func(ctx context.Context, audio service.SummaryAudioInput)) *SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]byte))
+		run(args[0].(context.Context), args[1].(service.SummaryAudioInput))
 	})
 	return _c
 }
@@ -78,7 +78,66 @@ func (_c *SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call) Return(_a0 *ser
 	return _c
 }
 
-func (_c *SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call) RunAndReturn(run func(context.Context, []byte) (*service.SummarySimpleOutput, error)) *SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call {
+func (_c *SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call) RunAndReturn(run func(context.Context, service.SummaryAudioInput) (*service.SummarySimpleOutput, error)) *SummaryUseCase_CreateSummaryAndTriggerAIProccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSummaryFromURL provides a mock function with given fields: ctx, input
+func (_m *SummaryUseCase) CreateSummaryFromURL(ctx context.Context, input service.SummaryFromURLInput) (*service.SummarySimpleOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSummaryFromURL")
+	}
+
+	var r0 *service.SummarySimpleOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.SummaryFromURLInput) (*service.SummarySimpleOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.SummaryFromURLInput) *service.SummarySimpleOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.SummarySimpleOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.SummaryFromURLInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SummaryUseCase_CreateSummaryFromURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSummaryFromURL'
+type SummaryUseCase_CreateSummaryFromURL_Call struct {
+	*mock.Call
+}
+
+// CreateSummaryFromURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input service.SummaryFromURLInput
+func (_e *SummaryUseCase_Expecter) CreateSummaryFromURL(ctx interface{}, input interface{}) *SummaryUseCase_CreateSummaryFromURL_Call {
+	return &SummaryUseCase_CreateSummaryFromURL_Call{Call: _e.mock.On("CreateSummaryFromURL", ctx, input)}
+}
+
+func (_c *SummaryUseCase_CreateSummaryFromURL_Call) Run(run func(ctx context.Context, input service.SummaryFromURLInput)) *SummaryUseCase_CreateSummaryFromURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.SummaryFromURLInput))
+	})
+	return _c
+}
+
+func (_c *SummaryUseCase_CreateSummaryFromURL_Call) Return(_a0 *service.SummarySimpleOutput, _a1 error) *SummaryUseCase_CreateSummaryFromURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SummaryUseCase_CreateSummaryFromURL_Call) RunAndReturn(run func(context.Context, service.SummaryFromURLInput) (*service.SummarySimpleOutput, error)) *SummaryUseCase_CreateSummaryFromURL_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -189,9 +248,9 @@ func (_c *SummaryUseCase_GetSummaryByExternalID_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// ListSummaries provides a mock function with given fields: ctx
-func (_m *SummaryUseCase) ListSummaries(ctx context.Context) (*service.SummaryListOutput, error) {
-	ret := _m.Called(ctx)
+// ListSummaries provides a mock function with given fields: ctx, filter
+func (_m *SummaryUseCase) ListSummaries(ctx context.Context, filter service.SummaryFilterInput) (*service.SummaryListOutput, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListSummaries")
@@ -199,19 +258,19 @@ func (_m *SummaryUseCase) ListSummaries(ctx context.Context) (*service.SummaryLi
 
 	var r0 *service.SummaryListOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*service.SummaryListOutput, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, service.SummaryFilterInput) (*service.SummaryListOutput, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *service.SummaryListOutput); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, service.SummaryFilterInput) *service.SummaryListOutput); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*service.SummaryListOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, service.SummaryFilterInput) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -226,13 +285,14 @@ type SummaryUseCase_ListSummaries_Call struct {
 
 // ListSummaries is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *SummaryUseCase_Expecter) ListSummaries(ctx interface{}) *SummaryUseCase_ListSummaries_Call {
-	return &SummaryUseCase_ListSummaries_Call{Call: _e.mock.On("ListSummaries", ctx)}
+//   - filter service.SummaryFilterInput
+func (_e *SummaryUseCase_Expecter) ListSummaries(ctx interface{}, filter interface{}) *SummaryUseCase_ListSummaries_Call {
+	return &SummaryUseCase_ListSummaries_Call{Call: _e.mock.On("ListSummaries", ctx, filter)}
 }
 
-func (_c *SummaryUseCase_ListSummaries_Call) Run(run func(ctx context.Context)) *SummaryUseCase_ListSummaries_Call {
+func (_c *SummaryUseCase_ListSummaries_Call) Run(run func(ctx context.Context, filter service.SummaryFilterInput)) *SummaryUseCase_ListSummaries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(service.SummaryFilterInput))
 	})
 	return _c
 }
@@ -242,7 +302,7 @@ func (_c *SummaryUseCase_ListSummaries_Call) Return(_a0 *service.SummaryListOutp
 	return _c
 }
 
-func (_c *SummaryUseCase_ListSummaries_Call) RunAndReturn(run func(context.Context) (*service.SummaryListOutput, error)) *SummaryUseCase_ListSummaries_Call {
+func (_c *SummaryUseCase_ListSummaries_Call) RunAndReturn(run func(context.Context, service.SummaryFilterInput) (*service.SummaryListOutput, error)) *SummaryUseCase_ListSummaries_Call {
 	_c.Call.Return(run)
 	return _c
 }
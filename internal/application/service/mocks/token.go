@@ -0,0 +1,97 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package servicemocks
+
+import (
+	context "context"
+
+	auth "github.com/diegofsousa/explicAI/internal/application/auth"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TokenUseCase is an autogenerated mock type for the TokenUseCase type
+type TokenUseCase struct {
+	mock.Mock
+}
+
+type TokenUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenUseCase) EXPECT() *TokenUseCase_Expecter {
+	return &TokenUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function with given fields: ctx, token
+func (_m *TokenUseCase) Authenticate(ctx context.Context, token string) (*auth.Principal, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *auth.Principal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*auth.Principal, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *auth.Principal); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Principal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenUseCase_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type TokenUseCase_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *TokenUseCase_Expecter) Authenticate(ctx interface{}, token interface{}) *TokenUseCase_Authenticate_Call {
+	return &TokenUseCase_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, token)}
+}
+
+func (_c *TokenUseCase_Authenticate_Call) Run(run func(ctx context.Context, token string)) *TokenUseCase_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TokenUseCase_Authenticate_Call) Return(_a0 *auth.Principal, _a1 error) *TokenUseCase_Authenticate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenUseCase_Authenticate_Call) RunAndReturn(run func(context.Context, string) (*auth.Principal, error)) *TokenUseCase_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTokenUseCase creates a new instance of TokenUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenUseCase {
+	mock := &TokenUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,263 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package servicemocks
+
+import (
+	context "context"
+
+	service "github.com/diegofsousa/explicAI/internal/application/service"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// UploadUseCase is an autogenerated mock type for the UploadUseCase type
+type UploadUseCase struct {
+	mock.Mock
+}
+
+type UploadUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UploadUseCase) EXPECT() *UploadUseCase_Expecter {
+	return &UploadUseCase_Expecter{mock: &_m.Mock}
+}
+
+// CreateUpload provides a mock function with given fields: ctx, input
+func (_m *UploadUseCase) CreateUpload(ctx context.Context, input service.UploadCreateInput) (*service.UploadOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUpload")
+	}
+
+	var r0 *service.UploadOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.UploadCreateInput) (*service.UploadOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.UploadCreateInput) *service.UploadOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.UploadOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.UploadCreateInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadUseCase_CreateUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUpload'
+type UploadUseCase_CreateUpload_Call struct {
+	*mock.Call
+}
+
+// CreateUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input service.UploadCreateInput
+func (_e *UploadUseCase_Expecter) CreateUpload(ctx interface{}, input interface{}) *UploadUseCase_CreateUpload_Call {
+	return &UploadUseCase_CreateUpload_Call{Call: _e.mock.On("CreateUpload", ctx, input)}
+}
+
+func (_c *UploadUseCase_CreateUpload_Call) Run(run func(ctx context.Context, input service.UploadCreateInput)) *UploadUseCase_CreateUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.UploadCreateInput))
+	})
+	return _c
+}
+
+func (_c *UploadUseCase_CreateUpload_Call) Return(_a0 *service.UploadOutput, _a1 error) *UploadUseCase_CreateUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadUseCase_CreateUpload_Call) RunAndReturn(run func(context.Context, service.UploadCreateInput) (*service.UploadOutput, error)) *UploadUseCase_CreateUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUpload provides a mock function with given fields: ctx, id
+func (_m *UploadUseCase) DeleteUpload(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUpload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadUseCase_DeleteUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUpload'
+type UploadUseCase_DeleteUpload_Call struct {
+	*mock.Call
+}
+
+// DeleteUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *UploadUseCase_Expecter) DeleteUpload(ctx interface{}, id interface{}) *UploadUseCase_DeleteUpload_Call {
+	return &UploadUseCase_DeleteUpload_Call{Call: _e.mock.On("DeleteUpload", ctx, id)}
+}
+
+func (_c *UploadUseCase_DeleteUpload_Call) Run(run func(ctx context.Context, id uuid.UUID)) *UploadUseCase_DeleteUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UploadUseCase_DeleteUpload_Call) Return(_a0 error) *UploadUseCase_DeleteUpload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadUseCase_DeleteUpload_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *UploadUseCase_DeleteUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUpload provides a mock function with given fields: ctx, id
+func (_m *UploadUseCase) GetUpload(ctx context.Context, id uuid.UUID) (*service.UploadOutput, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpload")
+	}
+
+	var r0 *service.UploadOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*service.UploadOutput, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *service.UploadOutput); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.UploadOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadUseCase_GetUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpload'
+type UploadUseCase_GetUpload_Call struct {
+	*mock.Call
+}
+
+// GetUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *UploadUseCase_Expecter) GetUpload(ctx interface{}, id interface{}) *UploadUseCase_GetUpload_Call {
+	return &UploadUseCase_GetUpload_Call{Call: _e.mock.On("GetUpload", ctx, id)}
+}
+
+func (_c *UploadUseCase_GetUpload_Call) Run(run func(ctx context.Context, id uuid.UUID)) *UploadUseCase_GetUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UploadUseCase_GetUpload_Call) Return(_a0 *service.UploadOutput, _a1 error) *UploadUseCase_GetUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadUseCase_GetUpload_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*service.UploadOutput, error)) *UploadUseCase_GetUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteUploadChunk provides a mock function with given fields: ctx, input
+func (_m *UploadUseCase) WriteUploadChunk(ctx context.Context, input service.UploadChunkInput) (*service.UploadOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteUploadChunk")
+	}
+
+	var r0 *service.UploadOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.UploadChunkInput) (*service.UploadOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.UploadChunkInput) *service.UploadOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.UploadOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.UploadChunkInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadUseCase_WriteUploadChunk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteUploadChunk'
+type UploadUseCase_WriteUploadChunk_Call struct {
+	*mock.Call
+}
+
+// WriteUploadChunk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input service.UploadChunkInput
+func (_e *UploadUseCase_Expecter) WriteUploadChunk(ctx interface{}, input interface{}) *UploadUseCase_WriteUploadChunk_Call {
+	return &UploadUseCase_WriteUploadChunk_Call{Call: _e.mock.On("WriteUploadChunk", ctx, input)}
+}
+
+func (_c *UploadUseCase_WriteUploadChunk_Call) Run(run func(ctx context.Context, input service.UploadChunkInput)) *UploadUseCase_WriteUploadChunk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.UploadChunkInput))
+	})
+	return _c
+}
+
+func (_c *UploadUseCase_WriteUploadChunk_Call) Return(_a0 *service.UploadOutput, _a1 error) *UploadUseCase_WriteUploadChunk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadUseCase_WriteUploadChunk_Call) RunAndReturn(run func(context.Context, service.UploadChunkInput) (*service.UploadOutput, error)) *UploadUseCase_WriteUploadChunk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUploadUseCase creates a new instance of UploadUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUploadUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UploadUseCase {
+	mock := &UploadUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package servicemocks
+
+import (
+	context "context"
+
+	service "github.com/diegofsousa/explicAI/internal/application/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UsageUseCase is an autogenerated mock type for the UsageUseCase type
+type UsageUseCase struct {
+	mock.Mock
+}
+
+type UsageUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UsageUseCase) EXPECT() *UsageUseCase_Expecter {
+	return &UsageUseCase_Expecter{mock: &_m.Mock}
+}
+
+// GetUsage provides a mock function with given fields: ctx, filter
+func (_m *UsageUseCase) GetUsage(ctx context.Context, filter service.UsageFilterInput) (*service.UsageReportOutput, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsage")
+	}
+
+	var r0 *service.UsageReportOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.UsageFilterInput) (*service.UsageReportOutput, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.UsageFilterInput) *service.UsageReportOutput); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.UsageReportOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.UsageFilterInput) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UsageUseCase_GetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsage'
+type UsageUseCase_GetUsage_Call struct {
+	*mock.Call
+}
+
+// GetUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter service.UsageFilterInput
+func (_e *UsageUseCase_Expecter) GetUsage(ctx interface{}, filter interface{}) *UsageUseCase_GetUsage_Call {
+	return &UsageUseCase_GetUsage_Call{Call: _e.mock.On("GetUsage", ctx, filter)}
+}
+
+func (_c *UsageUseCase_GetUsage_Call) Run(run func(ctx context.Context, filter service.UsageFilterInput)) *UsageUseCase_GetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.UsageFilterInput))
+	})
+	return _c
+}
+
+func (_c *UsageUseCase_GetUsage_Call) Return(_a0 *service.UsageReportOutput, _a1 error) *UsageUseCase_GetUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UsageUseCase_GetUsage_Call) RunAndReturn(run func(context.Context, service.UsageFilterInput) (*service.UsageReportOutput, error)) *UsageUseCase_GetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUsageUseCase creates a new instance of UsageUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUsageUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UsageUseCase {
+	mock := &UsageUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
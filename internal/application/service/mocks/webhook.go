@@ -0,0 +1,381 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package servicemocks
+
+import (
+	context "context"
+
+	service "github.com/diegofsousa/explicAI/internal/application/service"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// WebhookUseCase is an autogenerated mock type for the WebhookUseCase type
+type WebhookUseCase struct {
+	mock.Mock
+}
+
+type WebhookUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookUseCase) EXPECT() *WebhookUseCase_Expecter {
+	return &WebhookUseCase_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, input
+func (_m *WebhookUseCase) CreateWebhook(ctx context.Context, input service.WebhookCreateInput) (*service.WebhookOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 *service.WebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.WebhookCreateInput) (*service.WebhookOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.WebhookCreateInput) *service.WebhookOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.WebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.WebhookCreateInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookUseCase_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type WebhookUseCase_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input service.WebhookCreateInput
+func (_e *WebhookUseCase_Expecter) CreateWebhook(ctx interface{}, input interface{}) *WebhookUseCase_CreateWebhook_Call {
+	return &WebhookUseCase_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, input)}
+}
+
+func (_c *WebhookUseCase_CreateWebhook_Call) Run(run func(ctx context.Context, input service.WebhookCreateInput)) *WebhookUseCase_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.WebhookCreateInput))
+	})
+	return _c
+}
+
+func (_c *WebhookUseCase_CreateWebhook_Call) Return(_a0 *service.WebhookOutput, _a1 error) *WebhookUseCase_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookUseCase_CreateWebhook_Call) RunAndReturn(run func(context.Context, service.WebhookCreateInput) (*service.WebhookOutput, error)) *WebhookUseCase_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhookByExternalID provides a mock function with given fields: ctx, externalID
+func (_m *WebhookUseCase) DeleteWebhookByExternalID(ctx context.Context, externalID uuid.UUID) error {
+	ret := _m.Called(ctx, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookByExternalID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, externalID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookUseCase_DeleteWebhookByExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhookByExternalID'
+type WebhookUseCase_DeleteWebhookByExternalID_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhookByExternalID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID uuid.UUID
+func (_e *WebhookUseCase_Expecter) DeleteWebhookByExternalID(ctx interface{}, externalID interface{}) *WebhookUseCase_DeleteWebhookByExternalID_Call {
+	return &WebhookUseCase_DeleteWebhookByExternalID_Call{Call: _e.mock.On("DeleteWebhookByExternalID", ctx, externalID)}
+}
+
+func (_c *WebhookUseCase_DeleteWebhookByExternalID_Call) Run(run func(ctx context.Context, externalID uuid.UUID)) *WebhookUseCase_DeleteWebhookByExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookUseCase_DeleteWebhookByExternalID_Call) Return(_a0 error) *WebhookUseCase_DeleteWebhookByExternalID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookUseCase_DeleteWebhookByExternalID_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *WebhookUseCase_DeleteWebhookByExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookByExternalID provides a mock function with given fields: ctx, externalID
+func (_m *WebhookUseCase) GetWebhookByExternalID(ctx context.Context, externalID uuid.UUID) (*service.WebhookOutput, error) {
+	ret := _m.Called(ctx, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookByExternalID")
+	}
+
+	var r0 *service.WebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*service.WebhookOutput, error)); ok {
+		return rf(ctx, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *service.WebhookOutput); ok {
+		r0 = rf(ctx, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.WebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookUseCase_GetWebhookByExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookByExternalID'
+type WebhookUseCase_GetWebhookByExternalID_Call struct {
+	*mock.Call
+}
+
+// GetWebhookByExternalID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID uuid.UUID
+func (_e *WebhookUseCase_Expecter) GetWebhookByExternalID(ctx interface{}, externalID interface{}) *WebhookUseCase_GetWebhookByExternalID_Call {
+	return &WebhookUseCase_GetWebhookByExternalID_Call{Call: _e.mock.On("GetWebhookByExternalID", ctx, externalID)}
+}
+
+func (_c *WebhookUseCase_GetWebhookByExternalID_Call) Run(run func(ctx context.Context, externalID uuid.UUID)) *WebhookUseCase_GetWebhookByExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookUseCase_GetWebhookByExternalID_Call) Return(_a0 *service.WebhookOutput, _a1 error) *WebhookUseCase_GetWebhookByExternalID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookUseCase_GetWebhookByExternalID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*service.WebhookOutput, error)) *WebhookUseCase_GetWebhookByExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookDeliveries provides a mock function with given fields: ctx, externalID
+func (_m *WebhookUseCase) ListWebhookDeliveries(ctx context.Context, externalID uuid.UUID) (*service.WebhookDeliveryListOutput, error) {
+	ret := _m.Called(ctx, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookDeliveries")
+	}
+
+	var r0 *service.WebhookDeliveryListOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*service.WebhookDeliveryListOutput, error)); ok {
+		return rf(ctx, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *service.WebhookDeliveryListOutput); ok {
+		r0 = rf(ctx, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.WebhookDeliveryListOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookUseCase_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type WebhookUseCase_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID uuid.UUID
+func (_e *WebhookUseCase_Expecter) ListWebhookDeliveries(ctx interface{}, externalID interface{}) *WebhookUseCase_ListWebhookDeliveries_Call {
+	return &WebhookUseCase_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", ctx, externalID)}
+}
+
+func (_c *WebhookUseCase_ListWebhookDeliveries_Call) Run(run func(ctx context.Context, externalID uuid.UUID)) *WebhookUseCase_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookUseCase_ListWebhookDeliveries_Call) Return(_a0 *service.WebhookDeliveryListOutput, _a1 error) *WebhookUseCase_ListWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookUseCase_ListWebhookDeliveries_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*service.WebhookDeliveryListOutput, error)) *WebhookUseCase_ListWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function with given fields: ctx
+func (_m *WebhookUseCase) ListWebhooks(ctx context.Context) (*service.WebhookListOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhooks")
+	}
+
+	var r0 *service.WebhookListOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*service.WebhookListOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *service.WebhookListOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.WebhookListOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookUseCase_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type WebhookUseCase_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookUseCase_Expecter) ListWebhooks(ctx interface{}) *WebhookUseCase_ListWebhooks_Call {
+	return &WebhookUseCase_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", ctx)}
+}
+
+func (_c *WebhookUseCase_ListWebhooks_Call) Run(run func(ctx context.Context)) *WebhookUseCase_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookUseCase_ListWebhooks_Call) Return(_a0 *service.WebhookListOutput, _a1 error) *WebhookUseCase_ListWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookUseCase_ListWebhooks_Call) RunAndReturn(run func(context.Context) (*service.WebhookListOutput, error)) *WebhookUseCase_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function with given fields: ctx, externalID, input
+func (_m *WebhookUseCase) UpdateWebhook(ctx context.Context, externalID uuid.UUID, input service.WebhookUpdateInput) (*service.WebhookOutput, error) {
+	ret := _m.Called(ctx, externalID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 *service.WebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, service.WebhookUpdateInput) (*service.WebhookOutput, error)); ok {
+		return rf(ctx, externalID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, service.WebhookUpdateInput) *service.WebhookOutput); ok {
+		r0 = rf(ctx, externalID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.WebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, service.WebhookUpdateInput) error); ok {
+		r1 = rf(ctx, externalID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookUseCase_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type WebhookUseCase_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID uuid.UUID
+//   - input service.WebhookUpdateInput
+func (_e *WebhookUseCase_Expecter) UpdateWebhook(ctx interface{}, externalID interface{}, input interface{}) *WebhookUseCase_UpdateWebhook_Call {
+	return &WebhookUseCase_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", ctx, externalID, input)}
+}
+
+func (_c *WebhookUseCase_UpdateWebhook_Call) Run(run func(ctx context.Context, externalID uuid.UUID, input service.WebhookUpdateInput)) *WebhookUseCase_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(service.WebhookUpdateInput))
+	})
+	return _c
+}
+
+func (_c *WebhookUseCase_UpdateWebhook_Call) Return(_a0 *service.WebhookOutput, _a1 error) *WebhookUseCase_UpdateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookUseCase_UpdateWebhook_Call) RunAndReturn(run func(context.Context, uuid.UUID, service.WebhookUpdateInput) (*service.WebhookOutput, error)) *WebhookUseCase_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookUseCase creates a new instance of WebhookUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookUseCase {
+	mock := &WebhookUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
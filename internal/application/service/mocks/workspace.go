@@ -0,0 +1,215 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package servicemocks
+
+import (
+	context "context"
+
+	service "github.com/diegofsousa/explicAI/internal/application/service"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// WorkspaceUseCase is an autogenerated mock type for the WorkspaceUseCase type
+type WorkspaceUseCase struct {
+	mock.Mock
+}
+
+type WorkspaceUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WorkspaceUseCase) EXPECT() *WorkspaceUseCase_Expecter {
+	return &WorkspaceUseCase_Expecter{mock: &_m.Mock}
+}
+
+// CreateWorkspace provides a mock function with given fields: ctx, input
+func (_m *WorkspaceUseCase) CreateWorkspace(ctx context.Context, input service.WorkspaceCreateInput) (*service.WorkspaceOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspace")
+	}
+
+	var r0 *service.WorkspaceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.WorkspaceCreateInput) (*service.WorkspaceOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.WorkspaceCreateInput) *service.WorkspaceOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.WorkspaceOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.WorkspaceCreateInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkspaceUseCase_CreateWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorkspace'
+type WorkspaceUseCase_CreateWorkspace_Call struct {
+	*mock.Call
+}
+
+// CreateWorkspace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input service.WorkspaceCreateInput
+func (_e *WorkspaceUseCase_Expecter) CreateWorkspace(ctx interface{}, input interface{}) *WorkspaceUseCase_CreateWorkspace_Call {
+	return &WorkspaceUseCase_CreateWorkspace_Call{Call: _e.mock.On("CreateWorkspace", ctx, input)}
+}
+
+func (_c *WorkspaceUseCase_CreateWorkspace_Call) Run(run func(ctx context.Context, input service.WorkspaceCreateInput)) *WorkspaceUseCase_CreateWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.WorkspaceCreateInput))
+	})
+	return _c
+}
+
+func (_c *WorkspaceUseCase_CreateWorkspace_Call) Return(_a0 *service.WorkspaceOutput, _a1 error) *WorkspaceUseCase_CreateWorkspace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkspaceUseCase_CreateWorkspace_Call) RunAndReturn(run func(context.Context, service.WorkspaceCreateInput) (*service.WorkspaceOutput, error)) *WorkspaceUseCase_CreateWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkspace provides a mock function with given fields: ctx, externalID
+func (_m *WorkspaceUseCase) GetWorkspace(ctx context.Context, externalID uuid.UUID) (*service.WorkspaceOutput, error) {
+	ret := _m.Called(ctx, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspace")
+	}
+
+	var r0 *service.WorkspaceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*service.WorkspaceOutput, error)); ok {
+		return rf(ctx, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *service.WorkspaceOutput); ok {
+		r0 = rf(ctx, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.WorkspaceOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkspaceUseCase_GetWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspace'
+type WorkspaceUseCase_GetWorkspace_Call struct {
+	*mock.Call
+}
+
+// GetWorkspace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID uuid.UUID
+func (_e *WorkspaceUseCase_Expecter) GetWorkspace(ctx interface{}, externalID interface{}) *WorkspaceUseCase_GetWorkspace_Call {
+	return &WorkspaceUseCase_GetWorkspace_Call{Call: _e.mock.On("GetWorkspace", ctx, externalID)}
+}
+
+func (_c *WorkspaceUseCase_GetWorkspace_Call) Run(run func(ctx context.Context, externalID uuid.UUID)) *WorkspaceUseCase_GetWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WorkspaceUseCase_GetWorkspace_Call) Return(_a0 *service.WorkspaceOutput, _a1 error) *WorkspaceUseCase_GetWorkspace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkspaceUseCase_GetWorkspace_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*service.WorkspaceOutput, error)) *WorkspaceUseCase_GetWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorkspaces provides a mock function with given fields: ctx
+func (_m *WorkspaceUseCase) ListWorkspaces(ctx context.Context) (*service.WorkspaceListOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspaces")
+	}
+
+	var r0 *service.WorkspaceListOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*service.WorkspaceListOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *service.WorkspaceListOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.WorkspaceListOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkspaceUseCase_ListWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkspaces'
+type WorkspaceUseCase_ListWorkspaces_Call struct {
+	*mock.Call
+}
+
+// ListWorkspaces is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WorkspaceUseCase_Expecter) ListWorkspaces(ctx interface{}) *WorkspaceUseCase_ListWorkspaces_Call {
+	return &WorkspaceUseCase_ListWorkspaces_Call{Call: _e.mock.On("ListWorkspaces", ctx)}
+}
+
+func (_c *WorkspaceUseCase_ListWorkspaces_Call) Run(run func(ctx context.Context)) *WorkspaceUseCase_ListWorkspaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WorkspaceUseCase_ListWorkspaces_Call) Return(_a0 *service.WorkspaceListOutput, _a1 error) *WorkspaceUseCase_ListWorkspaces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkspaceUseCase_ListWorkspaces_Call) RunAndReturn(run func(context.Context) (*service.WorkspaceListOutput, error)) *WorkspaceUseCase_ListWorkspaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWorkspaceUseCase creates a new instance of WorkspaceUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorkspaceUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WorkspaceUseCase {
+	mock := &WorkspaceUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,8 +1,13 @@
 package service
 
 import (
+	"io"
 	"time"
 
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/audioformat"
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
 	"github.com/google/uuid"
 )
 
@@ -15,6 +20,7 @@ type (
 		Progress    int       `json:"progress"`
 		Title       string    `json:"title,omitempty"`
 		Description string    `json:"description,omitempty"`
+		Duplicate   bool      `json:"duplicate,omitempty"`
 	}
 
 	SummaryDetailedOutput struct {
@@ -28,9 +34,230 @@ type (
 		BriefResume  string    `json:"briefResume,omitempty"`
 		MediumResume string    `json:"mediumResume,omitempty"`
 		FullText     string    `json:"fullText,omitempty"`
+
+		Audio *AudioMetadataOutput `json:"audio,omitempty"`
+	}
+
+	AudioMetadataOutput struct {
+		DurationSeconds float64 `json:"durationSeconds,omitempty"`
+		Codec           string  `json:"codec,omitempty"`
+		Bitrate         int     `json:"bitrate,omitempty"`
+		SampleRate      int     `json:"sampleRate,omitempty"`
+		Channels        int     `json:"channels,omitempty"`
 	}
 
 	SummaryListOutput struct {
 		Data []SummarySimpleOutput `json:"data"`
 	}
+
+	SummaryAudioInput struct {
+		Content  io.ReadSeeker
+		Size     int64
+		Filename string
+	}
+
+	StoredAudio struct {
+		Key         string
+		Filename    string
+		ContentType string
+		Hash        string
+		Metadata    audioformat.Metadata
+	}
+
+	SummaryFromURLInput struct {
+		URL string `json:"url"`
+	}
+
+	SummaryFilterInput struct {
+		Status      string
+		CreatedFrom time.Time
+		CreatedTo   time.Time
+	}
+
+	WebhookCreateInput struct {
+		URL      string   `json:"url"`
+		Secret   string   `json:"secret,omitempty"`
+		Statuses []string `json:"statuses,omitempty"`
+	}
+
+	WebhookUpdateInput struct {
+		URL      *string   `json:"url,omitempty"`
+		Statuses *[]string `json:"statuses,omitempty"`
+		Active   *bool     `json:"active,omitempty"`
+	}
+
+	WebhookOutput struct {
+		ExternalID uuid.UUID `json:"externalId"`
+		CreatedAt  time.Time `json:"createdAt"`
+		UpdatedAt  time.Time `json:"updatedAt"`
+		URL        string    `json:"url"`
+		Secret     string    `json:"secret,omitempty"`
+		Statuses   []string  `json:"statuses"`
+		Active     bool      `json:"active"`
+	}
+
+	WebhookListOutput struct {
+		Data []WebhookOutput `json:"data"`
+	}
+
+	WebhookDeliveryOutput struct {
+		ExternalID uuid.UUID `json:"externalId"`
+		CreatedAt  time.Time `json:"createdAt"`
+		EventID    uuid.UUID `json:"eventId"`
+		EventType  string    `json:"eventType"`
+		Attempt    int       `json:"attempt"`
+		Payload    string    `json:"payload"`
+		StatusCode int       `json:"statusCode,omitempty"`
+		Error      string    `json:"error,omitempty"`
+		Delivered  bool      `json:"delivered"`
+	}
+
+	WebhookDeliveryListOutput struct {
+		Data []WebhookDeliveryOutput `json:"data"`
+	}
+
+	SummaryExportOutput struct {
+		FileName    string
+		ContentType string
+		Content     []byte
+	}
+
+	APIKeyCreateInput struct {
+		Name string `json:"name"`
+		Role string `json:"role,omitempty"`
+	}
+
+	APIKeyOutput struct {
+		ExternalID uuid.UUID  `json:"externalId"`
+		CreatedAt  time.Time  `json:"createdAt"`
+		Name       string     `json:"name"`
+		Prefix     string     `json:"prefix"`
+		Role       string     `json:"role"`
+		RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+		Key        string     `json:"key,omitempty"`
+	}
+
+	APIKeyListOutput struct {
+		Data []APIKeyOutput `json:"data"`
+	}
+
+	WorkspaceCreateInput struct {
+		Name string `json:"name"`
+	}
+
+	WorkspaceOutput struct {
+		ExternalID uuid.UUID `json:"externalId"`
+		CreatedAt  time.Time `json:"createdAt"`
+		Name       string    `json:"name"`
+	}
+
+	WorkspaceListOutput struct {
+		Data []WorkspaceOutput `json:"data"`
+	}
+)
+
+type (
+	UsageRecordInput struct {
+		SummaryExternalID uuid.UUID
+		Operation         string
+		Model             string
+		Provider          string
+		PromptTokens      int
+		CompletionTokens  int
+		AudioSeconds      float64
+		ValidationError   string
+	}
+
+	UsageFilterInput struct {
+		From time.Time
+		To   time.Time
+	}
+
+	UsageTotalsOutput struct {
+		Requests         int64   `json:"requests"`
+		PromptTokens     int64   `json:"promptTokens"`
+		CompletionTokens int64   `json:"completionTokens"`
+		AudioSeconds     float64 `json:"audioSeconds"`
+		Cost             float64 `json:"cost"`
+		Rejected         int64   `json:"rejected"`
+	}
+
+	UsageDayOutput struct {
+		Day string `json:"day"`
+		UsageTotalsOutput
+	}
+
+	UsageUserOutput struct {
+		UserID uuid.UUID `json:"userId"`
+		UsageTotalsOutput
+	}
+
+	UsageReportOutput struct {
+		Total  UsageTotalsOutput `json:"total"`
+		ByDay  []UsageDayOutput  `json:"byDay"`
+		ByUser []UsageUserOutput `json:"byUser"`
+	}
+)
+
+func (f SummaryFilterInput) toRepositoryFilter() (repository.SummaryFilter, error) {
+	if f.Status != "" && !isKnownStatus(f.Status) {
+		return repository.SummaryFilter{}, application.InvalidSummaryFilter
+	}
+
+	if !f.CreatedFrom.IsZero() && !f.CreatedTo.IsZero() && !f.CreatedFrom.Before(f.CreatedTo) {
+		return repository.SummaryFilter{}, application.InvalidSummaryFilter
+	}
+
+	return repository.SummaryFilter{
+		Status:      f.Status,
+		CreatedFrom: f.CreatedFrom,
+		CreatedTo:   f.CreatedTo,
+	}, nil
+}
+
+func isKnownStatus(status string) bool {
+	for _, domain := range repository.StatusToString {
+		if domain.Status == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+type (
+	AudioOutput struct {
+		Content     io.ReadSeekCloser
+		ContentType string
+		Size        int64
+		ModTime     time.Time
+	}
+)
+
+type (
+	UploadCreateInput struct {
+		Length   int64
+		Metadata map[string]string
+	}
+
+	UploadChunkInput struct {
+		ID      uuid.UUID
+		Offset  int64
+		Content io.Reader
+	}
+
+	UploadOutput struct {
+		ID                uuid.UUID
+		Length            int64
+		Offset            int64
+		Metadata          map[string]string
+		SummaryExternalID uuid.UUID
+	}
+)
+
+type (
+	EventSubscriptionOutput struct {
+		Events <-chan events.Event
+		Close  func()
+	}
 )
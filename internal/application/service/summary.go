@@ -2,20 +2,46 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/audioformat"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
 	"github.com/diegofsousa/explicAI/internal/gateway/audiotranscript"
+	"github.com/diegofsousa/explicAI/internal/gateway/blobstore"
+	"github.com/diegofsousa/explicAI/internal/gateway/download"
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
 	"github.com/diegofsousa/explicAI/internal/gateway/repository"
 	"github.com/diegofsousa/explicAI/internal/gateway/summarize"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
+// audioHeaderSize is how much of the audio is read ahead to detect its format.
+const audioHeaderSize = 512
+
+// ResponseValidation sets how many times the model is asked to fix a response
+// that fails validation and the language expected in the resume, which is not
+// checked when empty. The full text keeps the language of the meeting.
+type ResponseValidation struct {
+	MaxRepairs int
+	Language   string
+}
+
 type SummaryUseCase interface {
-	CreateSummaryAndTriggerAIProccess(ctx context.Context, audio []byte) (*SummarySimpleOutput, error)
-	ListSummaries(ctx context.Context) (*SummaryListOutput, error)
+	CreateSummaryAndTriggerAIProccess(ctx context.Context, audio SummaryAudioInput) (*SummarySimpleOutput, error)
+	CreateSummaryFromURL(ctx context.Context, input SummaryFromURLInput) (*SummarySimpleOutput, error)
+	ListSummaries(ctx context.Context, filter SummaryFilterInput) (*SummaryListOutput, error)
 	GetSummaryByExternalID(ctx context.Context, externalID uuid.UUID) (*SummaryDetailedOutput, error)
 	DeleteSummaryByExternalID(ctx context.Context, externalID uuid.UUID) error
 }
@@ -24,33 +50,438 @@ type Summary struct {
 	audioTranscript audiotranscript.AudioTranscript
 	summarize       summarize.Summarize
 	repository      repository.Repository
+	publisher       events.Publisher
+	usage           UsageRecorder
+	blobs           blobstore.BlobStore
+	downloader      download.Downloader
+	maxDuration     time.Duration
+	validation      ResponseValidation
 }
 
 func NewSummary(
 	audioTranscript audiotranscript.AudioTranscript,
 	summarize summarize.Summarize,
 	repository repository.Repository,
+	publisher events.Publisher,
+	usage UsageRecorder,
+	blobs blobstore.BlobStore,
+	downloader download.Downloader,
+	maxDuration time.Duration,
+	validation ResponseValidation,
 ) *Summary {
 	return &Summary{
 		audioTranscript: audioTranscript,
 		summarize:       summarize,
 		repository:      repository,
+		publisher:       publisher,
+		usage:           usage,
+		blobs:           blobs,
+		downloader:      downloader,
+		maxDuration:     maxDuration,
+		validation:      validation,
 	}
 }
 
-func (s *Summary) CreateSummaryAndTriggerAIProccess(ctx context.Context, audio []byte) (*SummarySimpleOutput, error) {
-	r, err := s.repository.CreateSummary(ctx, repository.ReceivedFile)
+// CreateSummaryAndTriggerAIProccess checks the container and the duration
+// from the audio headers and streams the audio to the blob store, so the
+// pipeline reads it back from there instead of keeping it in memory.
+func (s *Summary) CreateSummaryAndTriggerAIProccess(ctx context.Context, audio SummaryAudioInput) (*SummarySimpleOutput, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, application.Unauthorized
+	}
+
+	format, metadata, err := inspectAudio(ctx, audio)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkDuration(metadata); err != nil {
+		return nil, err
+	}
+
+	hash, err := hashAudio(ctx, audio.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	if duplicate := findDuplicate(ctx, s.repository, principal.WorkspaceID, hash); duplicate != nil {
+		return duplicate, nil
+	}
+
+	stored, err := s.storeAudio(ctx, principal, audio.Content, audio.Size, format, audio.Filename)
+	if err != nil {
+		return nil, err
+	}
+
+	stored.Hash = hash
+	stored.Metadata = metadata
+
+	r, err := s.repository.CreateSummary(ctx, principal.WorkspaceID, repository.SummaryCreateInput{
+		Status:           repository.ReceivedFile,
+		AudioKey:         stored.Key,
+		AudioContentType: stored.ContentType,
+		AudioHash:        stored.Hash,
+		Audio:            toAudioMetadata(stored.Metadata),
+	})
 
 	if err != nil {
 		log.LogError(ctx, "failed to create summary in db", err)
+		if err := s.blobs.Delete(ctx, stored.Key); err != nil {
+			log.LogError(ctx, "failed to remove orphan audio", err)
+		}
 		return nil, application.InternalDatabaseError
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	s.publishStatusChanged(ctx, r.ExternalID, "", repository.ReceivedFile)
+
+	ctx, cancel := context.WithCancel(auth.WithPrincipal(context.Background(), principal))
+
+	go s.AISummaryProccess(ctx, cancel, *stored, r.ExternalID)
+
+	return toCreatedSummaryOutput(r, repository.ReceivedFile), nil
+}
+
+// CreateSummaryFromURL registers the summary right away and downloads the
+// audio in background, so failures are reported through the DOWNLOAD_FAILED
+// status. Once downloaded, the audio follows the same pipeline as an upload.
+func (s *Summary) CreateSummaryFromURL(ctx context.Context, input SummaryFromURLInput) (*SummarySimpleOutput, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, application.Unauthorized
+	}
+
+	parsed, err := url.Parse(strings.TrimSpace(input.URL))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, application.InvalidDownloadURL
+	}
+
+	r, err := s.repository.CreateSummary(ctx, principal.WorkspaceID, repository.SummaryCreateInput{
+		Status: repository.Downloading,
+	})
+
+	if err != nil {
+		log.LogError(ctx, "failed to create summary in db", err)
+		return nil, application.InternalDatabaseError
+	}
+
+	s.publishStatusChanged(ctx, r.ExternalID, "", repository.Downloading)
+
+	ctx, cancel := context.WithCancel(auth.WithPrincipal(context.Background(), principal))
+
+	go s.DownloadAndTriggerAIProccess(ctx, cancel, parsed.String(), r.ExternalID)
+
+	return toCreatedSummaryOutput(r, repository.Downloading), nil
+}
+
+func (s *Summary) DownloadAndTriggerAIProccess(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	url string,
+	externalID uuid.UUID,
+) {
+	principal, _ := auth.PrincipalFromContext(ctx)
+
+	log.LogInfo(ctx, "start audio download", zap.String("external_id", externalID.String()))
+	downloaded, err := s.downloader.Download(ctx, url)
+	if err != nil {
+		log.LogError(ctx, "failed to download audio", err, zap.String("external_id", externalID.String()))
+		s.registerDownloaded(ctx, externalID, repository.DownloadFailed, nil)
+		cancel()
+		return
+	}
+
+	defer downloaded.Content.Close()
+
+	format, metadata, err := inspectDownloadedAudio(ctx, downloaded)
+	if err == nil {
+		err = s.checkDuration(metadata)
+	}
+
+	if err != nil {
+		log.LogError(ctx, "failed to download audio", err, zap.String("external_id", externalID.String()))
+		s.registerDownloaded(ctx, externalID, repository.DownloadFailed, nil)
+		cancel()
+		return
+	}
+
+	hash, err := hashAudio(ctx, downloaded.Content)
+	if err != nil {
+		log.LogError(ctx, "failed to download audio", err, zap.String("external_id", externalID.String()))
+		s.registerDownloaded(ctx, externalID, repository.DownloadFailed, nil)
+		cancel()
+		return
+	}
+
+	// looked up before the download is registered with the same hash
+	duplicate := s.findSummarizedDuplicate(ctx, principal.WorkspaceID, hash)
+
+	stored, err := s.storeAudio(ctx, principal, downloaded.Content, downloaded.Size, format, downloaded.Filename)
+	if err != nil {
+		log.LogError(ctx, "failed to download audio", err, zap.String("external_id", externalID.String()))
+		s.registerDownloaded(ctx, externalID, repository.DownloadFailed, nil)
+		cancel()
+		return
+	}
+
+	stored.Hash = hash
+	stored.Metadata = metadata
+
+	if !s.registerDownloaded(ctx, externalID, repository.ReceivedFile, stored) {
+		if err := s.blobs.Delete(ctx, stored.Key); err != nil {
+			log.LogError(ctx, "failed to remove orphan audio", err)
+		}
+		cancel()
+		return
+	}
+
+	log.LogInfo(ctx, "successful audio download", zap.String("external_id", externalID.String()))
 
-	go s.AISummaryProccess(ctx, cancel, audio, r.ExternalID)
+	if duplicate != nil {
+		s.copySummarized(ctx, externalID, duplicate)
+		cancel()
+		return
+	}
 
-	progress := repository.StatusToString[repository.ReceivedFile].Percentage
+	s.AISummaryProccess(ctx, cancel, *stored, externalID)
+}
+
+// findSummarizedDuplicate returns the finished summary of the workspace made
+// from the same audio. A download already has its own summary, so only the
+// results of a finished one can be copied to it, and other duplicates are
+// processed again.
+func (s *Summary) findSummarizedDuplicate(ctx context.Context, workspaceID uuid.UUID, hash string) *repository.SummaryOutput {
+	summary, err := s.repository.GetSummaryByAudioHash(ctx, workspaceID, hash)
+	if err == application.SummaryNotFound {
+		return nil
+	}
+
+	if err != nil {
+		log.LogError(ctx, "failed to find duplicated audio", err)
+		return nil
+	}
+
+	if summary.Status != repository.StatusToString[repository.Summarized].Status {
+		return nil
+	}
+
+	return summary
+}
+
+// copySummarized fills a downloaded summary with the results of the one
+// already made from the same audio, without transcribing it again.
+func (s *Summary) copySummarized(ctx context.Context, externalID uuid.UUID, duplicate *repository.SummaryOutput) {
+	principal, _ := auth.PrincipalFromContext(ctx)
+
+	log.LogInfo(ctx, "audio already summarized",
+		zap.String("external_id", externalID.String()),
+		zap.String("duplicate_external_id", duplicate.ExternalID.String()))
+
+	if err := s.repository.UpdateSummarySummarized(ctx, principal.WorkspaceID,
+		repository.SummaryUpdateSummarizedInput{
+			ExternalID:   externalID,
+			Status:       repository.Summarized,
+			Title:        duplicate.Title.String,
+			Description:  duplicate.Description.String,
+			BriefResume:  duplicate.BriefResume.String,
+			MediumResume: duplicate.MediumResume.String,
+			FullText:     duplicate.FullText.String,
+		}); err != nil {
+		log.LogError(ctx, "failed to save in db", err)
+		return
+	}
+
+	s.publishStatusChanged(ctx, externalID, repository.StatusToString[repository.ReceivedFile].Status, repository.Summarized)
+}
+
+// inspectAudio detects the container from the magic bytes and reads the
+// stream metadata from its headers, leaving the content back at its start.
+// The metadata is informative, so a container that cannot be probed is still
+// sent to the transcription.
+func inspectAudio(ctx context.Context, audio SummaryAudioInput) (audioformat.Format, audioformat.Metadata, error) {
+	header := make([]byte, audioHeaderSize)
+	n, err := io.ReadFull(audio.Content, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		log.LogError(ctx, "fail to read audio", err)
+		return audioformat.Format{}, audioformat.Metadata{}, application.FailedReadFile
+	}
+
+	var metadata audioformat.Metadata
+
+	format, err := audioformat.Validate(audio.Filename, header[:n])
+	if err == nil {
+		var probeErr error
+		if metadata, probeErr = audioformat.Probe(audio.Content, audio.Size, format); probeErr != nil {
+			log.LogWarn(ctx, "fail to read audio metadata", zap.String("reason", probeErr.Error()))
+		}
+	}
+
+	if _, err := audio.Content.Seek(0, io.SeekStart); err != nil {
+		log.LogError(ctx, "fail to read audio", err)
+		return audioformat.Format{}, audioformat.Metadata{}, application.FailedReadFile
+	}
+
+	return format, metadata, err
+}
+
+// inspectDownloadedAudio is inspectAudio for downloads, whose server content
+// type is only a hint, so the container is detected from the content alone.
+func inspectDownloadedAudio(ctx context.Context, downloaded *download.DownloadOutput) (audioformat.Format, audioformat.Metadata, error) {
+	header := make([]byte, audioHeaderSize)
+	n, err := io.ReadFull(downloaded.Content, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return audioformat.Format{}, audioformat.Metadata{}, application.FailedReadFile
+	}
+
+	format, ok := audioformat.Detect(header[:n])
+	if !ok {
+		return audioformat.Format{}, audioformat.Metadata{}, application.UnsupportedAudioFormat
+	}
+
+	metadata, err := audioformat.Probe(downloaded.Content, downloaded.Size, format)
+	if err != nil {
+		log.LogWarn(ctx, "fail to read audio metadata", zap.String("reason", err.Error()))
+	}
+
+	if _, err := downloaded.Content.Seek(0, io.SeekStart); err != nil {
+		return audioformat.Format{}, audioformat.Metadata{}, application.FailedReadFile
+	}
+
+	return format, metadata, nil
+}
+
+// hashAudio identifies the audio content, leaving it back at its start.
+func hashAudio(ctx context.Context, audio io.ReadSeeker) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, audio); err != nil {
+		log.LogError(ctx, "fail to read audio", err)
+		return "", application.FailedReadFile
+	}
+
+	if _, err := audio.Seek(0, io.SeekStart); err != nil {
+		log.LogError(ctx, "fail to read audio", err)
+		return "", application.FailedReadFile
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// findDuplicate returns the summary of the workspace already made from the
+// same audio, so a repeated upload is not transcribed and paid again. Failed
+// summaries are processed again, and lookup errors do not block the upload.
+func findDuplicate(ctx context.Context, summaries repository.Repository, workspaceID uuid.UUID, hash string) *SummarySimpleOutput {
+	summary, err := summaries.GetSummaryByAudioHash(ctx, workspaceID, hash)
+	if err == application.SummaryNotFound {
+		return nil
+	}
+
+	if err != nil {
+		log.LogError(ctx, "failed to find duplicated audio", err)
+		return nil
+	}
+
+	if isFailedStatus(summary.Status) {
+		return nil
+	}
+
+	log.LogInfo(ctx, "audio already summarized", zap.String("external_id", summary.ExternalID.String()))
+
+	return &SummarySimpleOutput{
+		ExternalID:  summary.ExternalID,
+		Status:      summary.Status,
+		CreatedAt:   summary.CreatedAt,
+		UpdatedAt:   summary.UpdatedAt,
+		Progress:    int(summary.Progress.Int32),
+		Title:       summary.Title.String,
+		Description: summary.Description.String,
+		Duplicate:   true,
+	}
+}
+
+func isFailedStatus(status string) bool {
+	for _, failed := range []repository.Status{repository.TranscribedFailed, repository.SummarizedFailed, repository.DownloadFailed} {
+		if repository.StatusToString[failed].Status == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Summary) checkDuration(metadata audioformat.Metadata) error {
+	if s.maxDuration > 0 && metadata.Duration > s.maxDuration {
+		return errors.Wrapf(application.AudioTooLong, "audio lasts %s, longer than %s",
+			metadata.Duration.Round(time.Second), s.maxDuration)
+	}
+
+	return nil
+}
+
+func toAudioMetadata(metadata audioformat.Metadata) repository.AudioMetadata {
+	return repository.AudioMetadata{
+		DurationSeconds: metadata.Duration.Seconds(),
+		Codec:           metadata.Codec,
+		Bitrate:         metadata.Bitrate,
+		SampleRate:      metadata.SampleRate,
+		Channels:        metadata.Channels,
+	}
+}
+
+// storeAudio keeps the original audio so the meeting can be replayed or
+// reprocessed.
+func (s *Summary) storeAudio(
+	ctx context.Context,
+	principal auth.Principal,
+	audio io.Reader,
+	size int64,
+	format audioformat.Format,
+	filename string,
+) (*StoredAudio, error) {
+	stored := &StoredAudio{
+		Key:         fmt.Sprintf("audio/%s/%s", principal.WorkspaceID, uuid.New()),
+		Filename:    format.Filename(filename),
+		ContentType: format.ContentType,
+	}
+
+	if err := s.blobs.Put(ctx, stored.Key, audio, size, stored.ContentType); err != nil {
+		log.LogError(ctx, "failed to store audio", err)
+		return nil, application.StoreAudioFailed
+	}
+
+	return stored, nil
+}
+
+func (s *Summary) registerDownloaded(
+	ctx context.Context,
+	externalID uuid.UUID,
+	status repository.Status,
+	stored *StoredAudio,
+) bool {
+	principal, _ := auth.PrincipalFromContext(ctx)
+
+	input := repository.SummaryUpdateDownloadedInput{
+		ExternalID: externalID,
+		Status:     status,
+	}
+
+	if stored != nil {
+		input.AudioKey = stored.Key
+		input.AudioContentType = stored.ContentType
+		input.AudioHash = stored.Hash
+		input.Audio = toAudioMetadata(stored.Metadata)
+	}
+
+	if err := s.repository.UpdateSummaryDownloaded(ctx, principal.WorkspaceID, input); err != nil {
+		log.LogError(ctx, "failed to save in db", err)
+		return false
+	}
+
+	s.publishStatusChanged(ctx, externalID, repository.StatusToString[repository.Downloading].Status, status)
+	return true
+}
+
+func toCreatedSummaryOutput(r *repository.SummaryCreateOutput, status repository.Status) *SummarySimpleOutput {
+	progress := repository.StatusToString[status].Percentage
 
 	if r.Progress.Valid {
 		progress = int(r.Progress.Int32)
@@ -62,11 +493,21 @@ func (s *Summary) CreateSummaryAndTriggerAIProccess(ctx context.Context, audio [
 		CreatedAt:  r.CreatedAt,
 		UpdatedAt:  r.CreatedAt,
 		Progress:   progress,
-	}, nil
+	}
 }
 
-func (s *Summary) ListSummaries(ctx context.Context) (*SummaryListOutput, error) {
-	summaries, err := s.repository.GetSummaries(ctx)
+func (s *Summary) ListSummaries(ctx context.Context, filter SummaryFilterInput) (*SummaryListOutput, error) {
+	repositoryFilter, err := filter.toRepositoryFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := s.repository.GetSummaries(ctx, workspaceID, repositoryFilter)
 	if err != nil {
 		log.LogError(ctx, "error on list summaries", err)
 		return nil, application.UnexpectedErrorList
@@ -92,7 +533,12 @@ func (s *Summary) ListSummaries(ctx context.Context) (*SummaryListOutput, error)
 }
 
 func (s *Summary) GetSummaryByExternalID(ctx context.Context, externalID uuid.UUID) (*SummaryDetailedOutput, error) {
-	summary, err := s.repository.GetSummaryByExternalID(ctx, externalID)
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.repository.GetSummaryByExternalID(ctx, workspaceID, externalID)
 	if err == application.SummaryNotFound {
 		return nil, err
 	}
@@ -113,11 +559,44 @@ func (s *Summary) GetSummaryByExternalID(ctx context.Context, externalID uuid.UU
 		BriefResume:  summary.BriefResume.String,
 		MediumResume: summary.MediumResume.String,
 		FullText:     summary.FullText.String,
+		Audio:        toAudioMetadataOutput(summary),
 	}, nil
 }
 
+func toAudioMetadataOutput(summary *repository.SummaryOutput) *AudioMetadataOutput {
+	if !summary.AudioDuration.Valid && !summary.AudioCodec.Valid {
+		return nil
+	}
+
+	return &AudioMetadataOutput{
+		DurationSeconds: summary.AudioDuration.Float64,
+		Codec:           summary.AudioCodec.String,
+		Bitrate:         int(summary.AudioBitrate.Int32),
+		SampleRate:      int(summary.AudioSampleRate.Int32),
+		Channels:        int(summary.AudioChannels.Int32),
+	}
+}
+
+// DeleteSummaryByExternalID also removes the stored audio, which could not
+// be reached once the summary is gone. A failure to remove it is only logged,
+// as the summary is already deleted.
 func (s *Summary) DeleteSummaryByExternalID(ctx context.Context, externalID uuid.UUID) error {
-	err := s.repository.DeleteSummaryByExternalID(ctx, externalID)
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	summary, err := s.repository.GetSummaryByExternalID(ctx, workspaceID, externalID)
+	if err == application.SummaryNotFound {
+		return err
+	}
+
+	if err != nil {
+		log.LogError(ctx, "error on get summary", err)
+		return err
+	}
+
+	err = s.repository.DeleteSummaryByExternalID(ctx, workspaceID, externalID)
 
 	if err == application.SummaryNotFound {
 		return err
@@ -128,13 +607,19 @@ func (s *Summary) DeleteSummaryByExternalID(ctx context.Context, externalID uuid
 		return err
 	}
 
+	if summary.AudioKey.String != "" {
+		if err := s.blobs.Delete(ctx, summary.AudioKey.String); err != nil {
+			log.LogError(ctx, "failed to remove summary audio", err, zap.String("external_id", externalID.String()))
+		}
+	}
+
 	return nil
 }
 
 func (s *Summary) AISummaryProccess(
 	ctx context.Context,
 	cancel context.CancelFunc,
-	audio []byte,
+	audio StoredAudio,
 	externalID uuid.UUID,
 ) {
 	defer cancel()
@@ -160,11 +645,23 @@ func (s *Summary) AISummaryProccess(
 
 func (s *Summary) audioTranscribe(
 	ctx context.Context,
-	audio []byte,
+	audio StoredAudio,
 	externalID uuid.UUID,
 ) (*string, error) {
 	log.LogInfo(ctx, "start audio transcribe", zap.String("external_id", externalID.String()))
-	transcription, err := s.audioTranscript.Transcribe(ctx, audio)
+	content, err := s.blobs.Get(ctx, audio.Key, 0)
+	if err != nil {
+		log.LogError(ctx, "failed to read stored audio", err, zap.String("external_id", externalID.String()))
+		s.registerTranscribeFailed(ctx, externalID)
+		return nil, application.TranscriptFailed
+	}
+	defer content.Close()
+
+	transcription, err := s.audioTranscript.Transcribe(ctx, audiotranscript.TranscribeInput{
+		Audio:       content,
+		Filename:    audio.Filename,
+		ContentType: audio.ContentType,
+	})
 	if err != nil {
 		log.LogError(ctx, "failed to transcript text", err, zap.String("external_id", externalID.String()))
 		s.registerTranscribeFailed(ctx, externalID)
@@ -172,31 +669,49 @@ func (s *Summary) audioTranscribe(
 	}
 
 	s.registerTranscribeSuccess(ctx, externalID)
+	s.usage.RecordUsage(ctx, UsageRecordInput{
+		SummaryExternalID: externalID,
+		Operation:         UsageOperationTranscription,
+		Model:             transcription.Model,
+		Provider:          transcription.Provider,
+		AudioSeconds:      transcription.AudioSeconds,
+	})
 
 	log.LogInfo(ctx, "successful audio transcribe", zap.String("external_id", externalID.String()))
 
-	return transcription, err
+	return &transcription.Text, nil
 }
 
 func (s *Summary) registerTranscribeSuccess(ctx context.Context, externalID uuid.UUID) {
-	if err := s.repository.UpdateSummaryTranscribed(ctx,
+	principal, _ := auth.PrincipalFromContext(ctx)
+
+	if err := s.repository.UpdateSummaryTranscribed(ctx, principal.WorkspaceID,
 		repository.SummaryUpdateTranscribedInput{
 			ExternalID: externalID,
 			Status:     repository.Trancribed,
 		}); err != nil {
 		log.LogError(ctx, "failed to save in db", err)
+		return
 	}
+
+	s.publishStatusChanged(ctx, externalID, repository.StatusToString[repository.ReceivedFile].Status, repository.Trancribed)
 }
 
 func (s *Summary) registerTranscribeFailed(ctx context.Context, externalID uuid.UUID) {
+	principal, _ := auth.PrincipalFromContext(ctx)
+
 	if err := s.repository.UpdateSummaryTranscribed(
 		ctx,
+		principal.WorkspaceID,
 		repository.SummaryUpdateTranscribedInput{
 			ExternalID: externalID,
 			Status:     repository.TranscribedFailed,
 		}); err != nil {
 		log.LogError(ctx, "failed to save in db", err)
+		return
 	}
+
+	s.publishStatusChanged(ctx, externalID, repository.StatusToString[repository.ReceivedFile].Status, repository.TranscribedFailed)
 }
 
 func (s *Summary) resumeText(ctx context.Context,
@@ -205,15 +720,35 @@ func (s *Summary) resumeText(ctx context.Context,
 	response *summarize.ResumeOutput,
 ) error {
 	log.LogInfo(ctx, "start resume transcription", zap.String("external_id", externalID.String()))
-	result, err := s.summarize.Resume(ctx, transcription)
-	if err != nil {
-		log.LogError(ctx, "failed resume transcription", err, zap.String("external_id", externalID.String()))
-		return application.ResumeTextFailed
-	}
 
-	*response = *result
-	log.LogInfo(ctx, "successful resume transcription", zap.String("external_id", externalID.String()))
-	return nil
+	input := summarize.Input{Transcription: transcription}
+	for attempt := 1; ; attempt++ {
+		result, err := s.summarize.Resume(ctx, input)
+		if err != nil {
+			log.LogError(ctx, "failed resume transcription", err, zap.String("external_id", externalID.String()))
+			return application.ResumeTextFailed
+		}
+
+		text := strings.Join([]string{result.Title, result.Description, result.BriefResume, result.MediumResume}, "\n")
+		problems := validationProblems(
+			summarize.Validate(result),
+			summarize.ValidateLanguage(text, s.validation.Language),
+		)
+
+		s.recordTokenUsage(ctx, externalID, UsageOperationResume, result.Usage, problems)
+		if len(problems) == 0 {
+			*response = *result
+			log.LogInfo(ctx, "successful resume transcription", zap.String("external_id", externalID.String()))
+			return nil
+		}
+
+		if !s.canRepair(ctx, externalID, UsageOperationResume, attempt, problems) {
+			return application.ResumeTextFailed
+		}
+
+		previous, _ := json.Marshal(result)
+		input.Rejected = &summarize.Rejected{Response: string(previous), Problems: problems}
+	}
 }
 
 func (s *Summary) organizeText(ctx context.Context,
@@ -222,15 +757,82 @@ func (s *Summary) organizeText(ctx context.Context,
 	response *string,
 ) error {
 	log.LogInfo(ctx, "start full organize text", zap.String("external_id", externalID.String()))
-	result, err := s.summarize.FullTextOrganize(ctx, transcription)
-	if err != nil {
-		log.LogError(ctx, "failed full prganize text", err, zap.String("external_id", externalID.String()))
-		return application.ResumeTextFailed
+
+	input := summarize.Input{Transcription: transcription}
+	for attempt := 1; ; attempt++ {
+		result, err := s.summarize.FullTextOrganize(ctx, input)
+		if err != nil {
+			log.LogError(ctx, "failed full prganize text", err, zap.String("external_id", externalID.String()))
+			return application.ResumeTextFailed
+		}
+
+		problems := validationProblems(summarize.Validate(result))
+
+		s.recordTokenUsage(ctx, externalID, UsageOperationFullText, result.Usage, problems)
+		if len(problems) == 0 {
+			*response = result.Text
+			log.LogInfo(ctx, "successful full organize text", zap.String("external_id", externalID.String()))
+			return nil
+		}
+
+		if !s.canRepair(ctx, externalID, UsageOperationFullText, attempt, problems) {
+			return application.ResumeTextFailed
+		}
+
+		// the rejected text is as long as the transcription, so only the
+		// problems are sent back
+		input.Rejected = &summarize.Rejected{Problems: problems}
 	}
+}
 
-	*response = *result
-	log.LogInfo(ctx, "successful full organize text", zap.String("external_id", externalID.String()))
-	return nil
+// canRepair logs a response rejected by validation and tells whether the
+// model may still be asked to fix it.
+func (s *Summary) canRepair(ctx context.Context, externalID uuid.UUID, operation string, attempt int, problems []string) bool {
+	fields := []zap.Field{
+		zap.String("external_id", externalID.String()),
+		zap.String("operation", operation),
+		zap.Int("attempt", attempt),
+		zap.Strings("problems", problems),
+	}
+
+	if attempt > s.validation.MaxRepairs {
+		log.LogError(ctx, "response rejected by validation", &summarize.ValidationError{Problems: problems}, fields...)
+		return false
+	}
+
+	log.LogWarn(ctx, "response rejected by validation, asking the model to fix it", fields...)
+	return true
+}
+
+func validationProblems(errs ...error) []string {
+	var problems []string
+	for _, err := range errs {
+		var validationError *summarize.ValidationError
+		if errors.As(err, &validationError) {
+			problems = append(problems, validationError.Problems...)
+		}
+	}
+	return problems
+}
+
+// recordTokenUsage also records rejected responses, which are paid as well,
+// with their problems so validation failures can be monitored.
+func (s *Summary) recordTokenUsage(
+	ctx context.Context,
+	externalID uuid.UUID,
+	operation string,
+	usage summarize.TokenUsage,
+	problems []string,
+) {
+	s.usage.RecordUsage(ctx, UsageRecordInput{
+		SummaryExternalID: externalID,
+		Operation:         operation,
+		Model:             usage.Model,
+		Provider:          usage.Provider,
+		PromptTokens:      usage.PromptTokens,
+		CompletionTokens:  usage.CompletionTokens,
+		ValidationError:   strings.Join(problems, "; "),
+	})
 }
 
 func (s *Summary) registerSummarizedSuccess(
@@ -239,7 +841,9 @@ func (s *Summary) registerSummarizedSuccess(
 	resume summarize.ResumeOutput,
 	fulltext string,
 ) {
-	if err := s.repository.UpdateSummarySummarized(ctx,
+	principal, _ := auth.PrincipalFromContext(ctx)
+
+	if err := s.repository.UpdateSummarySummarized(ctx, principal.WorkspaceID,
 		repository.SummaryUpdateSummarizedInput{
 			ExternalID:   externalID,
 			Status:       repository.Summarized,
@@ -250,15 +854,45 @@ func (s *Summary) registerSummarizedSuccess(
 			FullText:     fulltext,
 		}); err != nil {
 		log.LogError(ctx, "failed to save in db", err)
+		return
 	}
+
+	s.publishStatusChanged(ctx, externalID, repository.StatusToString[repository.Trancribed].Status, repository.Summarized)
 }
 
 func (s *Summary) registerSummarizedFailed(ctx context.Context, externalID uuid.UUID) {
-	if err := s.repository.UpdateSummarySummarized(ctx,
+	principal, _ := auth.PrincipalFromContext(ctx)
+
+	if err := s.repository.UpdateSummarySummarized(ctx, principal.WorkspaceID,
 		repository.SummaryUpdateSummarizedInput{
 			ExternalID: externalID,
 			Status:     repository.SummarizedFailed,
 		}); err != nil {
 		log.LogError(ctx, "failed to save in db", err)
+		return
 	}
+
+	s.publishStatusChanged(ctx, externalID, repository.StatusToString[repository.Trancribed].Status, repository.SummarizedFailed)
+}
+
+func (s *Summary) publishStatusChanged(
+	ctx context.Context,
+	externalID uuid.UUID,
+	previousStatus string,
+	status repository.Status,
+) {
+	principal, _ := auth.PrincipalFromContext(ctx)
+
+	s.publisher.Publish(ctx, events.Event{
+		ID:         uuid.New(),
+		Type:       events.SummaryStatusChanged,
+		OccurredAt: time.Now(),
+		Summary: &events.SummaryData{
+			ExternalID:     externalID,
+			WorkspaceID:    principal.WorkspaceID,
+			Status:         repository.StatusToString[status].Status,
+			PreviousStatus: previousStatus,
+			Progress:       repository.StatusToString[status].Percentage,
+		},
+	})
 }
@@ -1,13 +1,23 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	"github.com/diegofsousa/explicAI/internal/gateway/audiotranscript"
+	"github.com/diegofsousa/explicAI/internal/gateway/download"
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
 	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
 	"github.com/diegofsousa/explicAI/internal/gateway/repository"
 	"github.com/diegofsousa/explicAI/internal/gateway/summarize"
@@ -27,6 +37,18 @@ var (
 	briefResume           = "brief resume"
 	mediumResume          = "medium resume"
 	fulltext              = "full text"
+	ownerIDStr            = "3f8a2c1e-7b4d-4e2a-9c6f-1d5e8b7a4c20"
+	ownerIDUUID           = uuid.MustParse(ownerIDStr)
+	workspaceIDStr        = "9b1d4f6a-2c3e-4a5b-8d7f-0e1a2b3c4d5e"
+	workspaceIDUUID       = uuid.MustParse(workspaceIDStr)
+	audioKey              = "audio/" + workspaceIDStr + "/meeting"
+	storedAudio           = StoredAudio{Key: audioKey, Filename: "meeting.mp3", ContentType: "audio/mpeg"}
+	mp3Header             = []byte("ID3\x04\x00\x00\x00\x00\x00\x00")
+	principal             = auth.Principal{KeyID: ownerIDUUID, WorkspaceID: workspaceIDUUID, Name: "test", Role: auth.RoleEditor}
+	summaryCreateInput    = mock.MatchedBy(func(input repository.SummaryCreateInput) bool {
+		return input.Status == repository.ReceivedFile &&
+			strings.HasPrefix(input.AudioKey, "audio/"+workspaceIDStr+"/")
+	})
 )
 
 type (
@@ -37,6 +59,10 @@ type (
 		audioTranscript *gatewaymocks.AudioTranscript
 		summarize       *gatewaymocks.Summarize
 		repository      *gatewaymocks.Repository
+		publisher       *gatewaymocks.Publisher
+		usage           *Usage
+		blobs           *gatewaymocks.BlobStore
+		downloader      *gatewaymocks.Downloader
 	}
 )
 
@@ -45,7 +71,44 @@ func TestSummaryTestSuite(t *testing.T) {
 }
 
 func (s *SummaryTestSuite) SetupTest() {
-	s.ctx = context.Background()
+	s.ctx = auth.WithPrincipal(context.Background(), principal)
+	s.publisher = new(gatewaymocks.Publisher)
+	s.publisher.EXPECT().Publish(mock.Anything, mock.Anything).Maybe()
+
+	usageRepository := new(gatewaymocks.UsageRepository)
+	usageRepository.EXPECT().CreateUsage(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.usage = NewUsage(usageRepository, nil)
+
+	s.blobs = new(gatewaymocks.BlobStore)
+	s.blobs.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.blobs.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.blobs.EXPECT().
+		Get(mock.Anything, mock.Anything, int64(0)).
+		RunAndReturn(func(context.Context, string, int64) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("audio")), nil
+		}).
+		Maybe()
+
+	s.downloader = new(gatewaymocks.Downloader)
+}
+
+// newSummaryRepository lets uploads look for a summary of the same audio,
+// finding none.
+func newSummaryRepository() *gatewaymocks.Repository {
+	summaries := new(gatewaymocks.Repository)
+	summaries.EXPECT().
+		GetSummaryByAudioHash(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, application.SummaryNotFound).
+		Maybe()
+	return summaries
+}
+
+// wavAudio builds a mono 8-bit recording at 100 Hz, one byte per sample.
+func wavAudio(seconds int) []byte {
+	header := []byte("RIFF\x00\x00\x00\x00WAVEfmt \x10\x00\x00\x00\x01\x00\x01\x00" +
+		"\x64\x00\x00\x00\x64\x00\x00\x00\x01\x00\x08\x00data\x00\x00\x00\x00")
+	binary.LittleEndian.PutUint32(header[40:], uint32(seconds*100))
+	return append(header, make([]byte, seconds*100)...)
 }
 
 func (s *SummaryTestSuite) TearDownTest() {
@@ -54,9 +117,9 @@ func (s *SummaryTestSuite) TearDownTest() {
 
 func (s *SummaryTestSuite) TestSummaryCreate() {
 	s.Run("successful create summary", func() {
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
 		s.repository.EXPECT().
-			CreateSummary(mock.Anything, repository.ReceivedFile).
+			CreateSummary(mock.Anything, workspaceIDUUID, summaryCreateInput).
 			Return(&repository.SummaryCreateOutput{
 				ExternalID: summaryExternalIDUUID,
 				Status:     repository.StatusToString[repository.ReceivedFile].Status,
@@ -70,7 +133,7 @@ func (s *SummaryTestSuite) TestSummaryCreate() {
 		s.audioTranscript = new(gatewaymocks.AudioTranscript)
 		s.audioTranscript.EXPECT().
 			Transcribe(mock.Anything, mock.Anything).
-			Return(&textTranscribed, nil)
+			Return(&audiotranscript.TranscribeOutput{Text: textTranscribed}, nil)
 
 		ustInput := repository.SummaryUpdateTranscribedInput{
 			ExternalID: summaryExternalIDUUID,
@@ -78,12 +141,12 @@ func (s *SummaryTestSuite) TestSummaryCreate() {
 		}
 
 		s.repository.EXPECT().
-			UpdateSummaryTranscribed(mock.Anything, ustInput).
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, ustInput).
 			Return(nil)
 
 		s.summarize = new(gatewaymocks.Summarize)
 		s.summarize.EXPECT().
-			Resume(mock.Anything, textTranscribed).
+			Resume(mock.Anything, summarize.Input{Transcription: textTranscribed}).
 			Return(&summarize.ResumeOutput{
 				Title:        title,
 				Description:  description,
@@ -92,8 +155,8 @@ func (s *SummaryTestSuite) TestSummaryCreate() {
 			}, nil)
 
 		s.summarize.EXPECT().
-			FullTextOrganize(mock.Anything, textTranscribed).
-			Return(&fulltext, nil)
+			FullTextOrganize(mock.Anything, summarize.Input{Transcription: textTranscribed}).
+			Return(&summarize.FullTextOutput{Text: fulltext}, nil)
 
 		susInput := repository.SummaryUpdateSummarizedInput{
 			ExternalID:   summaryExternalIDUUID,
@@ -106,50 +169,429 @@ func (s *SummaryTestSuite) TestSummaryCreate() {
 		}
 
 		s.repository.EXPECT().
-			UpdateSummarySummarized(mock.Anything, susInput).
+			UpdateSummarySummarized(mock.Anything, workspaceIDUUID, susInput).
 			Return(nil)
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
-		output, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, []byte{})
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		output, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, SummaryAudioInput{Content: bytes.NewReader(mp3Header)})
 		s.Require().NoError(err)
 		s.Equal("RECEIVED_FILE", output.Status)
 		s.Equal(createdAt, output.CreatedAt)
 	})
 
+	s.Run("create summary without principal", func() {
+		s.repository = newSummaryRepository()
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.CreateSummaryAndTriggerAIProccess(context.Background(), SummaryAudioInput{Content: bytes.NewReader(mp3Header)})
+		s.Require().ErrorIs(err, application.Unauthorized)
+		s.repository.AssertNotCalled(s.T(), "CreateSummary", mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	s.Run("fail db create summary", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, summaryCreateInput).
+			Return(nil, errors.New("some error"))
+
+		blobs := new(gatewaymocks.BlobStore)
+		blobs.EXPECT().
+			Put(mock.Anything, mock.Anything, mock.Anything, int64(0), mock.Anything).
+			Return(nil)
+		blobs.EXPECT().
+			Delete(mock.Anything, mock.MatchedBy(func(key string) bool { return strings.HasPrefix(key, "audio/"+workspaceIDStr+"/") })).
+			Return(nil)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, SummaryAudioInput{Content: bytes.NewReader(mp3Header)})
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+		blobs.AssertExpectations(s.T())
+	})
+
+	s.Run("store the streamed audio", func() {
+		audio := append([]byte("ID3"), bytes.Repeat([]byte{0}, 600)...)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, summaryCreateInput).
+			Return(nil, errors.New("some error"))
+
+		var stored []byte
+		blobs := new(gatewaymocks.BlobStore)
+		blobs.EXPECT().
+			Put(mock.Anything, mock.Anything, mock.Anything, int64(len(audio)), "audio/mpeg").
+			RunAndReturn(func(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+				stored, _ = io.ReadAll(content)
+				return nil
+			})
+		blobs.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, SummaryAudioInput{Content: bytes.NewReader(audio), Size: int64(len(audio))})
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+		s.Equal(audio, stored)
+	})
+
+	s.Run("reject audio with unsupported content", func() {
+		s.repository = newSummaryRepository()
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, SummaryAudioInput{Content: strings.NewReader("plain text"), Filename: "meeting.mp3"})
+		s.Require().ErrorIs(err, application.UnsupportedAudioFormat)
+		s.repository.AssertNotCalled(s.T(), "CreateSummary", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("reject audio with extension of another format", func() {
+		s.repository = newSummaryRepository()
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, SummaryAudioInput{Content: bytes.NewReader(mp3Header), Filename: "meeting.wav"})
+		s.Require().ErrorIs(err, application.AudioFormatMismatch)
+		s.Equal("meeting.wav has mp3 content: audio content does not match the file extension", err.Error())
+	})
+
+	s.Run("store the audio metadata", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, mock.MatchedBy(func(input repository.SummaryCreateInput) bool {
+				return input.Audio == repository.AudioMetadata{DurationSeconds: 2, Codec: "pcm", Bitrate: 800, SampleRate: 100, Channels: 1}
+			})).
+			Return(nil, errors.New("some error"))
+
+		audio := wavAudio(2)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, SummaryAudioInput{Content: bytes.NewReader(audio), Size: int64(len(audio)), Filename: "meeting.wav"})
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+		s.repository.AssertExpectations(s.T())
+	})
+
+	s.Run("reject audio longer than the maximum duration", func() {
+		s.repository = newSummaryRepository()
+
+		blobs := new(gatewaymocks.BlobStore)
+		audio := wavAudio(2)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, time.Second, ResponseValidation{})
+		_, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, SummaryAudioInput{Content: bytes.NewReader(audio), Size: int64(len(audio))})
+		s.Require().ErrorIs(err, application.AudioTooLong)
+		s.Equal("audio lasts 2s, longer than 1s: audio exceeds the maximum duration", err.Error())
+		blobs.AssertNotCalled(s.T(), "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("return the summary of a duplicated audio", func() {
+		hash := sha256.Sum256(mp3Header)
+
 		s.repository = new(gatewaymocks.Repository)
 		s.repository.EXPECT().
-			CreateSummary(mock.Anything, repository.ReceivedFile).
+			GetSummaryByAudioHash(mock.Anything, workspaceIDUUID, hex.EncodeToString(hash[:])).
+			Return(&repository.SummaryOutput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     repository.StatusToString[repository.Summarized].Status,
+				CreatedAt:  createdAt,
+				UpdatedAt:  createdAt,
+				Progress:   sql.NullInt32{Int32: 100, Valid: true},
+				Title:      sql.NullString{String: title, Valid: true},
+			}, nil)
+
+		blobs := new(gatewaymocks.BlobStore)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
+		output, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, SummaryAudioInput{Content: bytes.NewReader(mp3Header)})
+		s.Require().NoError(err)
+		s.Equal(summaryExternalIDUUID, output.ExternalID)
+		s.Equal("SUMMARIZED", output.Status)
+		s.Equal(title, output.Title)
+		s.True(output.Duplicate)
+		s.repository.AssertNotCalled(s.T(), "CreateSummary", mock.Anything, mock.Anything, mock.Anything)
+		blobs.AssertNotCalled(s.T(), "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("process again a duplicate of a failed summary", func() {
+		hash := sha256.Sum256(mp3Header)
+
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByAudioHash(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(&repository.SummaryOutput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     repository.StatusToString[repository.TranscribedFailed].Status,
+			}, nil)
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, mock.MatchedBy(func(input repository.SummaryCreateInput) bool {
+				return input.AudioHash == hex.EncodeToString(hash[:])
+			})).
 			Return(nil, errors.New("some error"))
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
-		_, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, []byte{})
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, SummaryAudioInput{Content: bytes.NewReader(mp3Header)})
 		s.Require().ErrorIs(err, application.InternalDatabaseError)
+		s.repository.AssertExpectations(s.T())
+	})
+
+	s.Run("fail store audio", func() {
+		s.repository = newSummaryRepository()
+
+		blobs := new(gatewaymocks.BlobStore)
+		blobs.EXPECT().
+			Put(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(errors.New("some error"))
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.CreateSummaryAndTriggerAIProccess(s.ctx, SummaryAudioInput{Content: bytes.NewReader(mp3Header)})
+		s.Require().ErrorIs(err, application.StoreAudioFailed)
+		s.repository.AssertNotCalled(s.T(), "CreateSummary", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func (s *SummaryTestSuite) TestSummaryCreateFromURL() {
+	s.Run("successful create summary from url", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, repository.SummaryCreateInput{
+				Status: repository.Downloading,
+			}).
+			Return(&repository.SummaryCreateOutput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     repository.StatusToString[repository.Downloading].Status,
+				CreatedAt:  createdAt,
+			}, nil)
+		s.repository.EXPECT().
+			UpdateSummaryDownloaded(mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).
+			Maybe()
+
+		s.downloader.EXPECT().
+			Download(mock.Anything, "https://example.com/meeting.mp3").
+			Return(nil, errors.New("some error")).
+			Maybe()
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		output, err := service.CreateSummaryFromURL(s.ctx, SummaryFromURLInput{URL: " https://example.com/meeting.mp3 "})
+		s.Require().NoError(err)
+		s.Equal("DOWNLOADING", output.Status)
+		s.Equal(0, output.Progress)
+	})
+
+	s.Run("invalid url", func() {
+		s.repository = newSummaryRepository()
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		for _, url := range []string{"", "ftp://example.com/a.mp3", "file:///etc/passwd", "https://"} {
+			_, err := service.CreateSummaryFromURL(s.ctx, SummaryFromURLInput{URL: url})
+			s.Require().ErrorIs(err, application.InvalidDownloadURL, url)
+		}
+		s.repository.AssertNotCalled(s.T(), "CreateSummary", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("fail download", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.downloader = new(gatewaymocks.Downloader)
+		s.downloader.EXPECT().
+			Download(mock.Anything, "https://example.com/meeting.mp3").
+			Return(nil, errors.New("some error"))
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryDownloaded(mock.Anything, workspaceIDUUID, repository.SummaryUpdateDownloadedInput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     repository.DownloadFailed,
+			}).
+			Return(nil)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		service.DownloadAndTriggerAIProccess(ctx, cancel, "https://example.com/meeting.mp3", summaryExternalIDUUID)
+		s.repository.AssertExpectations(s.T())
+		s.Require().ErrorIs(ctx.Err(), context.Canceled)
+	})
+
+	s.Run("fail download of unsupported content", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.downloader = new(gatewaymocks.Downloader)
+		s.downloader.EXPECT().
+			Download(mock.Anything, "https://example.com/meeting.mp3").
+			Return(&download.DownloadOutput{Content: readSeekNopCloser{bytes.NewReader([]byte("<html></html>"))}, Size: 13, ContentType: "audio/mpeg", Filename: "meeting.mp3"}, nil)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryDownloaded(mock.Anything, workspaceIDUUID, repository.SummaryUpdateDownloadedInput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     repository.DownloadFailed,
+			}).
+			Return(nil)
+
+		blobs := new(gatewaymocks.BlobStore)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
+		service.DownloadAndTriggerAIProccess(ctx, cancel, "https://example.com/meeting.mp3", summaryExternalIDUUID)
+		s.repository.AssertExpectations(s.T())
+		blobs.AssertNotCalled(s.T(), "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("fail download of audio longer than the maximum duration", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.downloader = new(gatewaymocks.Downloader)
+		s.downloader.EXPECT().
+			Download(mock.Anything, "https://example.com/meeting.wav").
+			Return(&download.DownloadOutput{Content: readSeekNopCloser{bytes.NewReader(wavAudio(2))}, Size: int64(len(wavAudio(2))), ContentType: "audio/wav", Filename: "meeting.wav"}, nil)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryDownloaded(mock.Anything, workspaceIDUUID, repository.SummaryUpdateDownloadedInput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     repository.DownloadFailed,
+			}).
+			Return(nil)
+
+		blobs := new(gatewaymocks.BlobStore)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, time.Second, ResponseValidation{})
+		service.DownloadAndTriggerAIProccess(ctx, cancel, "https://example.com/meeting.wav", summaryExternalIDUUID)
+		s.repository.AssertExpectations(s.T())
+		blobs.AssertNotCalled(s.T(), "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("remove the stored audio when the download is not registered", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.downloader = new(gatewaymocks.Downloader)
+		s.downloader.EXPECT().
+			Download(mock.Anything, "https://example.com/meeting.mp3").
+			Return(&download.DownloadOutput{Content: readSeekNopCloser{bytes.NewReader(mp3Header)}, Size: int64(len(mp3Header)), ContentType: "audio/mpeg", Filename: "meeting.mp3"}, nil)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryDownloaded(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(errors.New("some error"))
+
+		var storedKey string
+		blobs := new(gatewaymocks.BlobStore)
+		blobs.EXPECT().
+			Put(mock.Anything, mock.Anything, mock.Anything, mock.Anything, "audio/mpeg").
+			Run(func(ctx context.Context, key string, content io.Reader, size int64, contentType string) {
+				storedKey = key
+			}).
+			Return(nil)
+		blobs.EXPECT().
+			Delete(mock.Anything, mock.MatchedBy(func(key string) bool { return key == storedKey })).
+			Return(nil)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
+		service.DownloadAndTriggerAIProccess(ctx, cancel, "https://example.com/meeting.mp3", summaryExternalIDUUID)
+		blobs.AssertExpectations(s.T())
+		s.Require().ErrorIs(ctx.Err(), context.Canceled)
+	})
+
+	s.Run("successful download triggers ai proccess", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.downloader = new(gatewaymocks.Downloader)
+		s.downloader.EXPECT().
+			Download(mock.Anything, "https://example.com/meeting.mp3").
+			Return(&download.DownloadOutput{Content: readSeekNopCloser{bytes.NewReader(mp3Header)}, Size: int64(len(mp3Header)), ContentType: "application/octet-stream", Filename: "meeting"}, nil)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryDownloaded(mock.Anything, workspaceIDUUID, mock.MatchedBy(func(input repository.SummaryUpdateDownloadedInput) bool {
+				return input.ExternalID == summaryExternalIDUUID &&
+					input.Status == repository.ReceivedFile &&
+					input.AudioContentType == "audio/mpeg" &&
+					strings.HasPrefix(input.AudioKey, "audio/"+workspaceIDStr+"/")
+			})).
+			Return(nil)
+
+		s.audioTranscript = new(gatewaymocks.AudioTranscript)
+		s.audioTranscript.EXPECT().
+			Transcribe(mock.Anything, mock.MatchedBy(func(input audiotranscript.TranscribeInput) bool {
+				return input.Filename == "audio.mp3" && input.ContentType == "audio/mpeg"
+			})).
+			Return(nil, errors.New("some error"))
+
+		s.repository.EXPECT().
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, repository.SummaryUpdateTranscribedInput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     repository.TranscribedFailed,
+			}).
+			Return(nil)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		service.DownloadAndTriggerAIProccess(ctx, cancel, "https://example.com/meeting.mp3", summaryExternalIDUUID)
+		s.repository.AssertExpectations(s.T())
+		s.audioTranscript.AssertExpectations(s.T())
+	})
+
+	s.Run("successful download of a summarized audio copies its summary", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.downloader = new(gatewaymocks.Downloader)
+		s.downloader.EXPECT().
+			Download(mock.Anything, "https://example.com/meeting.mp3").
+			Return(&download.DownloadOutput{Content: readSeekNopCloser{bytes.NewReader(mp3Header)}, Size: int64(len(mp3Header)), ContentType: "audio/mpeg", Filename: "meeting.mp3"}, nil)
+
+		s.repository = new(gatewaymocks.Repository)
+		s.repository.EXPECT().
+			GetSummaryByAudioHash(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(&repository.SummaryOutput{
+				ExternalID:   uuid.New(),
+				Status:       "SUMMARIZED",
+				Title:        sql.NullString{String: "title", Valid: true},
+				Description:  sql.NullString{String: "desc", Valid: true},
+				BriefResume:  sql.NullString{String: "brief", Valid: true},
+				MediumResume: sql.NullString{String: "medium", Valid: true},
+				FullText:     sql.NullString{String: "full", Valid: true},
+			}, nil)
+		s.repository.EXPECT().
+			UpdateSummaryDownloaded(mock.Anything, workspaceIDUUID, mock.MatchedBy(func(input repository.SummaryUpdateDownloadedInput) bool {
+				return input.ExternalID == summaryExternalIDUUID && input.Status == repository.ReceivedFile && input.AudioHash != ""
+			})).
+			Return(nil)
+		s.repository.EXPECT().
+			UpdateSummarySummarized(mock.Anything, workspaceIDUUID, repository.SummaryUpdateSummarizedInput{
+				ExternalID:   summaryExternalIDUUID,
+				Status:       repository.Summarized,
+				Title:        "title",
+				Description:  "desc",
+				BriefResume:  "brief",
+				MediumResume: "medium",
+				FullText:     "full",
+			}).
+			Return(nil)
+
+		s.audioTranscript = new(gatewaymocks.AudioTranscript)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		service.DownloadAndTriggerAIProccess(ctx, cancel, "https://example.com/meeting.mp3", summaryExternalIDUUID)
+		s.repository.AssertExpectations(s.T())
+		s.audioTranscript.AssertNotCalled(s.T(), "Transcribe", mock.Anything, mock.Anything)
+		s.Require().ErrorIs(ctx.Err(), context.Canceled)
 	})
 }
 
 func (s *SummaryTestSuite) TestAIProccessSumary() {
 	s.Run("successful proccess summary", func() {
-		ctx, cancel := context.WithCancel(context.Background())
+		ctx, cancel := context.WithCancel(s.ctx)
 
 		s.audioTranscript = new(gatewaymocks.AudioTranscript)
 		s.audioTranscript.EXPECT().
 			Transcribe(mock.Anything, mock.Anything).
-			Return(&textTranscribed, nil)
+			Return(&audiotranscript.TranscribeOutput{Text: textTranscribed}, nil)
 
 		ustInput := repository.SummaryUpdateTranscribedInput{
 			ExternalID: summaryExternalIDUUID,
 			Status:     repository.Trancribed,
 		}
 
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
 		s.repository.EXPECT().
-			UpdateSummaryTranscribed(mock.Anything, ustInput).
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, ustInput).
 			Return(nil)
 
 		s.summarize = new(gatewaymocks.Summarize)
 		s.summarize.EXPECT().
-			Resume(mock.Anything, textTranscribed).
+			Resume(mock.Anything, summarize.Input{Transcription: textTranscribed}).
 			Return(&summarize.ResumeOutput{
 				Title:        title,
 				Description:  description,
@@ -158,8 +600,8 @@ func (s *SummaryTestSuite) TestAIProccessSumary() {
 			}, nil)
 
 		s.summarize.EXPECT().
-			FullTextOrganize(mock.Anything, textTranscribed).
-			Return(&fulltext, nil)
+			FullTextOrganize(mock.Anything, summarize.Input{Transcription: textTranscribed}).
+			Return(&summarize.FullTextOutput{Text: fulltext}, nil)
 
 		susInput := repository.SummaryUpdateSummarizedInput{
 			ExternalID:   summaryExternalIDUUID,
@@ -172,39 +614,39 @@ func (s *SummaryTestSuite) TestAIProccessSumary() {
 		}
 
 		s.repository.EXPECT().
-			UpdateSummarySummarized(mock.Anything, susInput).
+			UpdateSummarySummarized(mock.Anything, workspaceIDUUID, susInput).
 			Return(nil)
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
-		service.AISummaryProccess(ctx, cancel, []byte{}, summaryExternalIDUUID)
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		service.AISummaryProccess(ctx, cancel, storedAudio, summaryExternalIDUUID)
 		s.audioTranscript.AssertCalled(s.T(), "Transcribe", mock.Anything, mock.Anything)
-		s.repository.AssertCalled(s.T(), "UpdateSummaryTranscribed", mock.Anything, ustInput)
-		s.summarize.AssertCalled(s.T(), "Resume", mock.Anything, textTranscribed)
-		s.summarize.AssertCalled(s.T(), "FullTextOrganize", mock.Anything, textTranscribed)
-		s.repository.AssertCalled(s.T(), "UpdateSummarySummarized", mock.Anything, susInput)
+		s.repository.AssertCalled(s.T(), "UpdateSummaryTranscribed", mock.Anything, workspaceIDUUID, ustInput)
+		s.summarize.AssertCalled(s.T(), "Resume", mock.Anything, summarize.Input{Transcription: textTranscribed})
+		s.summarize.AssertCalled(s.T(), "FullTextOrganize", mock.Anything, summarize.Input{Transcription: textTranscribed})
+		s.repository.AssertCalled(s.T(), "UpdateSummarySummarized", mock.Anything, workspaceIDUUID, susInput)
 	})
 
 	s.Run("fail full text organize summary", func() {
-		ctx, cancel := context.WithCancel(context.Background())
+		ctx, cancel := context.WithCancel(s.ctx)
 
 		s.audioTranscript = new(gatewaymocks.AudioTranscript)
 		s.audioTranscript.EXPECT().
 			Transcribe(mock.Anything, mock.Anything).
-			Return(&textTranscribed, nil)
+			Return(&audiotranscript.TranscribeOutput{Text: textTranscribed}, nil)
 
 		ustInput := repository.SummaryUpdateTranscribedInput{
 			ExternalID: summaryExternalIDUUID,
 			Status:     repository.Trancribed,
 		}
 
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
 		s.repository.EXPECT().
-			UpdateSummaryTranscribed(mock.Anything, ustInput).
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, ustInput).
 			Return(nil)
 
 		s.summarize = new(gatewaymocks.Summarize)
 		s.summarize.EXPECT().
-			Resume(mock.Anything, textTranscribed).
+			Resume(mock.Anything, summarize.Input{Transcription: textTranscribed}).
 			Return(&summarize.ResumeOutput{
 				Title:        title,
 				Description:  description,
@@ -213,8 +655,8 @@ func (s *SummaryTestSuite) TestAIProccessSumary() {
 			}, nil)
 
 		s.summarize.EXPECT().
-			FullTextOrganize(mock.Anything, textTranscribed).
-			Return(&fulltext, errors.New("some error"))
+			FullTextOrganize(mock.Anything, summarize.Input{Transcription: textTranscribed}).
+			Return(nil, errors.New("some error"))
 
 		susInput := repository.SummaryUpdateSummarizedInput{
 			ExternalID: summaryExternalIDUUID,
@@ -222,44 +664,44 @@ func (s *SummaryTestSuite) TestAIProccessSumary() {
 		}
 
 		s.repository.EXPECT().
-			UpdateSummarySummarized(mock.Anything, susInput).
+			UpdateSummarySummarized(mock.Anything, workspaceIDUUID, susInput).
 			Return(nil)
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
-		service.AISummaryProccess(ctx, cancel, []byte{}, summaryExternalIDUUID)
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		service.AISummaryProccess(ctx, cancel, storedAudio, summaryExternalIDUUID)
 		s.audioTranscript.AssertCalled(s.T(), "Transcribe", mock.Anything, mock.Anything)
-		s.repository.AssertCalled(s.T(), "UpdateSummaryTranscribed", mock.Anything, ustInput)
-		s.summarize.AssertCalled(s.T(), "Resume", mock.Anything, textTranscribed)
-		s.summarize.AssertCalled(s.T(), "FullTextOrganize", mock.Anything, textTranscribed)
-		s.repository.AssertCalled(s.T(), "UpdateSummarySummarized", mock.Anything, susInput)
+		s.repository.AssertCalled(s.T(), "UpdateSummaryTranscribed", mock.Anything, workspaceIDUUID, ustInput)
+		s.summarize.AssertCalled(s.T(), "Resume", mock.Anything, summarize.Input{Transcription: textTranscribed})
+		s.summarize.AssertCalled(s.T(), "FullTextOrganize", mock.Anything, summarize.Input{Transcription: textTranscribed})
+		s.repository.AssertCalled(s.T(), "UpdateSummarySummarized", mock.Anything, workspaceIDUUID, susInput)
 	})
 
 	s.Run("fail resume summary", func() {
-		ctx, cancel := context.WithCancel(context.Background())
+		ctx, cancel := context.WithCancel(s.ctx)
 
 		s.audioTranscript = new(gatewaymocks.AudioTranscript)
 		s.audioTranscript.EXPECT().
 			Transcribe(mock.Anything, mock.Anything).
-			Return(&textTranscribed, nil)
+			Return(&audiotranscript.TranscribeOutput{Text: textTranscribed}, nil)
 
 		ustInput := repository.SummaryUpdateTranscribedInput{
 			ExternalID: summaryExternalIDUUID,
 			Status:     repository.Trancribed,
 		}
 
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
 		s.repository.EXPECT().
-			UpdateSummaryTranscribed(mock.Anything, ustInput).
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, ustInput).
 			Return(nil)
 
 		s.summarize = new(gatewaymocks.Summarize)
 		s.summarize.EXPECT().
-			Resume(mock.Anything, textTranscribed).
+			Resume(mock.Anything, summarize.Input{Transcription: textTranscribed}).
 			Return(nil, errors.New("some error"))
 
 		s.summarize.EXPECT().
-			FullTextOrganize(mock.Anything, textTranscribed).
-			Return(&fulltext, nil)
+			FullTextOrganize(mock.Anything, summarize.Input{Transcription: textTranscribed}).
+			Return(&summarize.FullTextOutput{Text: fulltext}, nil)
 
 		susInput := repository.SummaryUpdateSummarizedInput{
 			ExternalID: summaryExternalIDUUID,
@@ -267,20 +709,20 @@ func (s *SummaryTestSuite) TestAIProccessSumary() {
 		}
 
 		s.repository.EXPECT().
-			UpdateSummarySummarized(mock.Anything, susInput).
+			UpdateSummarySummarized(mock.Anything, workspaceIDUUID, susInput).
 			Return(nil)
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
-		service.AISummaryProccess(ctx, cancel, []byte{}, summaryExternalIDUUID)
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		service.AISummaryProccess(ctx, cancel, storedAudio, summaryExternalIDUUID)
 		s.audioTranscript.AssertCalled(s.T(), "Transcribe", mock.Anything, mock.Anything)
-		s.repository.AssertCalled(s.T(), "UpdateSummaryTranscribed", mock.Anything, ustInput)
-		s.summarize.AssertCalled(s.T(), "Resume", mock.Anything, textTranscribed)
-		s.summarize.AssertCalled(s.T(), "FullTextOrganize", mock.Anything, textTranscribed)
-		s.repository.AssertCalled(s.T(), "UpdateSummarySummarized", mock.Anything, susInput)
+		s.repository.AssertCalled(s.T(), "UpdateSummaryTranscribed", mock.Anything, workspaceIDUUID, ustInput)
+		s.summarize.AssertCalled(s.T(), "Resume", mock.Anything, summarize.Input{Transcription: textTranscribed})
+		s.summarize.AssertCalled(s.T(), "FullTextOrganize", mock.Anything, summarize.Input{Transcription: textTranscribed})
+		s.repository.AssertCalled(s.T(), "UpdateSummarySummarized", mock.Anything, workspaceIDUUID, susInput)
 	})
 
 	s.Run("fail audio transcribe", func() {
-		ctx, cancel := context.WithCancel(context.Background())
+		ctx, cancel := context.WithCancel(s.ctx)
 
 		s.audioTranscript = new(gatewaymocks.AudioTranscript)
 		s.audioTranscript.EXPECT().
@@ -292,15 +734,252 @@ func (s *SummaryTestSuite) TestAIProccessSumary() {
 			Status:     repository.TranscribedFailed,
 		}
 
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
 		s.repository.EXPECT().
-			UpdateSummaryTranscribed(mock.Anything, ustInput).
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, ustInput).
 			Return(nil)
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
-		service.AISummaryProccess(ctx, cancel, []byte{}, summaryExternalIDUUID)
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		service.AISummaryProccess(ctx, cancel, storedAudio, summaryExternalIDUUID)
 		s.audioTranscript.AssertCalled(s.T(), "Transcribe", mock.Anything, mock.Anything)
-		s.repository.AssertCalled(s.T(), "UpdateSummaryTranscribed", mock.Anything, ustInput)
+		s.repository.AssertCalled(s.T(), "UpdateSummaryTranscribed", mock.Anything, workspaceIDUUID, ustInput)
+	})
+}
+
+func (s *SummaryTestSuite) TestAIProccessSummaryEvents() {
+	s.Run("publish status changes on successful proccess summary", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.audioTranscript = new(gatewaymocks.AudioTranscript)
+		s.audioTranscript.EXPECT().
+			Transcribe(mock.Anything, mock.Anything).
+			Return(&audiotranscript.TranscribeOutput{Text: textTranscribed}, nil)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(nil)
+		s.repository.EXPECT().
+			UpdateSummarySummarized(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(nil)
+
+		s.summarize = new(gatewaymocks.Summarize)
+		s.summarize.EXPECT().
+			Resume(mock.Anything, summarize.Input{Transcription: textTranscribed}).
+			Return(&summarize.ResumeOutput{Title: title, Description: description, BriefResume: briefResume, MediumResume: mediumResume}, nil)
+		s.summarize.EXPECT().
+			FullTextOrganize(mock.Anything, summarize.Input{Transcription: textTranscribed}).
+			Return(&summarize.FullTextOutput{Text: fulltext}, nil)
+
+		var published []events.Event
+		publisher := new(gatewaymocks.Publisher)
+		publisher.EXPECT().
+			Publish(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, event events.Event) {
+				published = append(published, event)
+			})
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		service.AISummaryProccess(ctx, cancel, storedAudio, summaryExternalIDUUID)
+
+		s.Require().Len(published, 2)
+		s.Equal(events.SummaryStatusChanged, published[0].Type)
+		s.Equal(summaryExternalIDUUID, published[0].Summary.ExternalID)
+		s.Equal("RECEIVED_FILE", published[0].Summary.PreviousStatus)
+		s.Equal("TRANSCRIBED", published[0].Summary.Status)
+		s.Equal(66, published[0].Summary.Progress)
+		s.Equal("TRANSCRIBED", published[1].Summary.PreviousStatus)
+		s.Equal("SUMMARIZED", published[1].Summary.Status)
+		s.Equal(100, published[1].Summary.Progress)
+		s.Equal(workspaceIDUUID, published[1].Summary.WorkspaceID)
+	})
+
+	s.Run("publish failed status on audio transcribe failure", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.audioTranscript = new(gatewaymocks.AudioTranscript)
+		s.audioTranscript.EXPECT().
+			Transcribe(mock.Anything, mock.Anything).
+			Return(nil, errors.New("some error"))
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(nil)
+
+		var published []events.Event
+		publisher := new(gatewaymocks.Publisher)
+		publisher.EXPECT().
+			Publish(mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, event events.Event) {
+				published = append(published, event)
+			})
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		service.AISummaryProccess(ctx, cancel, storedAudio, summaryExternalIDUUID)
+
+		s.Require().Len(published, 1)
+		s.Equal("TRANSCRIBED_FAILED", published[0].Summary.Status)
+	})
+
+	s.Run("do not publish when status is not saved", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.audioTranscript = new(gatewaymocks.AudioTranscript)
+		s.audioTranscript.EXPECT().
+			Transcribe(mock.Anything, mock.Anything).
+			Return(nil, errors.New("some error"))
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(errors.New("some error"))
+
+		publisher := new(gatewaymocks.Publisher)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		service.AISummaryProccess(ctx, cancel, storedAudio, summaryExternalIDUUID)
+
+		publisher.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *SummaryTestSuite) TestAIProccessSummaryRepair() {
+	validation := ResponseValidation{MaxRepairs: 1, Language: "pt"}
+	longTitle := strings.Repeat("t", 61)
+	englishText := "The meeting was about the budget and the goals, but there was no agreement on the deadline."
+
+	s.Run("ask the model to fix a rejected resume", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.audioTranscript = new(gatewaymocks.AudioTranscript)
+		s.audioTranscript.EXPECT().
+			Transcribe(mock.Anything, mock.Anything).
+			Return(&audiotranscript.TranscribeOutput{Text: textTranscribed}, nil)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(nil)
+		s.repository.EXPECT().
+			UpdateSummarySummarized(mock.Anything, workspaceIDUUID, mock.MatchedBy(func(input repository.SummaryUpdateSummarizedInput) bool {
+				return input.Status == repository.Summarized && input.Title == title
+			})).
+			Return(nil)
+
+		s.summarize = new(gatewaymocks.Summarize)
+		s.summarize.EXPECT().
+			Resume(mock.Anything, summarize.Input{Transcription: textTranscribed}).
+			Return(&summarize.ResumeOutput{Title: longTitle, Description: description, BriefResume: briefResume, MediumResume: mediumResume}, nil).
+			Once()
+		s.summarize.EXPECT().
+			Resume(mock.Anything, mock.MatchedBy(func(input summarize.Input) bool {
+				return input.Rejected != nil &&
+					strings.Contains(input.Rejected.Response, longTitle) &&
+					input.Rejected.Problems[0] == "title has 61 characters, more than 60"
+			})).
+			Return(&summarize.ResumeOutput{Title: title, Description: description, BriefResume: briefResume, MediumResume: mediumResume}, nil).
+			Once()
+		s.summarize.EXPECT().
+			FullTextOrganize(mock.Anything, summarize.Input{Transcription: textTranscribed}).
+			Return(&summarize.FullTextOutput{Text: fulltext}, nil)
+
+		var rejected []string
+		usageRepository := new(gatewaymocks.UsageRepository)
+		usageRepository.EXPECT().
+			CreateUsage(mock.Anything, workspaceIDUUID, mock.Anything).
+			Run(func(ctx context.Context, workspaceID uuid.UUID, input repository.UsageCreateInput) {
+				if input.ValidationError != "" {
+					rejected = append(rejected, input.Operation+": "+input.ValidationError)
+				}
+			}).
+			Return(nil)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, NewUsage(usageRepository, nil), s.blobs, s.downloader, 0, validation)
+		service.AISummaryProccess(ctx, cancel, storedAudio, summaryExternalIDUUID)
+
+		s.summarize.AssertNumberOfCalls(s.T(), "Resume", 2)
+		usageRepository.AssertNumberOfCalls(s.T(), "CreateUsage", 4)
+		s.Equal([]string{"resume: title has 61 characters, more than 60"}, rejected)
+		s.repository.AssertExpectations(s.T())
+	})
+
+	s.Run("fail when the repairs are exhausted", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.audioTranscript = new(gatewaymocks.AudioTranscript)
+		s.audioTranscript.EXPECT().
+			Transcribe(mock.Anything, mock.Anything).
+			Return(&audiotranscript.TranscribeOutput{Text: textTranscribed}, nil)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(nil)
+		s.repository.EXPECT().
+			UpdateSummarySummarized(mock.Anything, workspaceIDUUID, repository.SummaryUpdateSummarizedInput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     repository.SummarizedFailed,
+			}).
+			Return(nil)
+
+		englishResume := &summarize.ResumeOutput{Title: title, Description: description, BriefResume: englishText, MediumResume: englishText}
+
+		s.summarize = new(gatewaymocks.Summarize)
+		s.summarize.EXPECT().
+			Resume(mock.Anything, summarize.Input{Transcription: textTranscribed}).
+			Return(englishResume, nil).
+			Once()
+		s.summarize.EXPECT().
+			Resume(mock.Anything, mock.MatchedBy(func(input summarize.Input) bool {
+				return input.Rejected != nil && input.Rejected.Problems[0] == "text is in en, expected pt"
+			})).
+			Return(englishResume, nil).
+			Once()
+		s.summarize.EXPECT().
+			FullTextOrganize(mock.Anything, summarize.Input{Transcription: textTranscribed}).
+			Return(&summarize.FullTextOutput{Text: englishText}, nil)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, validation)
+		service.AISummaryProccess(ctx, cancel, storedAudio, summaryExternalIDUUID)
+
+		s.summarize.AssertNumberOfCalls(s.T(), "Resume", 2)
+		s.summarize.AssertNumberOfCalls(s.T(), "FullTextOrganize", 1)
+		s.repository.AssertExpectations(s.T())
+	})
+
+	s.Run("accept an english recording with the default validation", func() {
+		ctx, cancel := context.WithCancel(s.ctx)
+
+		s.audioTranscript = new(gatewaymocks.AudioTranscript)
+		s.audioTranscript.EXPECT().
+			Transcribe(mock.Anything, mock.Anything).
+			Return(&audiotranscript.TranscribeOutput{Text: englishText}, nil)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			UpdateSummaryTranscribed(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(nil)
+		s.repository.EXPECT().
+			UpdateSummarySummarized(mock.Anything, workspaceIDUUID, mock.MatchedBy(func(input repository.SummaryUpdateSummarizedInput) bool {
+				return input.Status == repository.Summarized && input.FullText == englishText
+			})).
+			Return(nil)
+
+		s.summarize = new(gatewaymocks.Summarize)
+		s.summarize.EXPECT().
+			Resume(mock.Anything, summarize.Input{Transcription: englishText}).
+			Return(&summarize.ResumeOutput{Title: title, Description: description, BriefResume: englishText, MediumResume: englishText}, nil)
+		s.summarize.EXPECT().
+			FullTextOrganize(mock.Anything, summarize.Input{Transcription: englishText}).
+			Return(&summarize.FullTextOutput{Text: englishText}, nil)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{MaxRepairs: 2})
+		service.AISummaryProccess(ctx, cancel, storedAudio, summaryExternalIDUUID)
+
+		s.summarize.AssertNumberOfCalls(s.T(), "Resume", 1)
+		s.summarize.AssertNumberOfCalls(s.T(), "FullTextOrganize", 1)
+		s.repository.AssertExpectations(s.T())
 	})
 }
 
@@ -309,8 +988,8 @@ func (s *SummaryTestSuite) TestListSummaries() {
 		summaryExternalID2Str := "7748608c-e9fc-4dfa-a083-6f4014457b8a"
 		summaryExternalID2UUID := uuid.MustParse(summaryExternalID2Str)
 
-		s.repository = new(gatewaymocks.Repository)
-		s.repository.EXPECT().GetSummaries(mock.Anything).
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().GetSummaries(mock.Anything, workspaceIDUUID, repository.SummaryFilter{}).
 			Return([]repository.SummaryOutput{
 				{
 					ExternalID: summaryExternalIDUUID,
@@ -349,8 +1028,8 @@ func (s *SummaryTestSuite) TestListSummaries() {
 				},
 			}, nil)
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
-		output, err := service.ListSummaries(s.ctx)
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		output, err := service.ListSummaries(s.ctx, SummaryFilterInput{})
 		s.Require().NoError(err)
 		s.Equal(summaryExternalIDUUID, output.Data[0].ExternalID)
 		s.Equal("SUMMARIZED", output.Data[0].Status)
@@ -365,35 +1044,78 @@ func (s *SummaryTestSuite) TestListSummaries() {
 	})
 
 	s.Run("fail list summaries", func() {
-		s.repository = new(gatewaymocks.Repository)
-		s.repository.EXPECT().GetSummaries(mock.Anything).
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().GetSummaries(mock.Anything, workspaceIDUUID, repository.SummaryFilter{}).
 			Return(nil, errors.New("some error"))
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
-		_, err := service.ListSummaries(s.ctx)
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.ListSummaries(s.ctx, SummaryFilterInput{})
 		s.Require().ErrorIs(err, application.UnexpectedErrorList)
 	})
+
+	s.Run("successful list summaries with filter", func() {
+		createdTo := createdAt.Add(24 * time.Hour)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().GetSummaries(mock.Anything, workspaceIDUUID, repository.SummaryFilter{
+			Status:      "SUMMARIZED",
+			CreatedFrom: createdAt,
+			CreatedTo:   createdTo,
+		}).Return([]repository.SummaryOutput{}, nil)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		output, err := service.ListSummaries(s.ctx, SummaryFilterInput{
+			Status:      "SUMMARIZED",
+			CreatedFrom: createdAt,
+			CreatedTo:   createdTo,
+		})
+		s.Require().NoError(err)
+		s.Empty(output.Data)
+	})
+
+	s.Run("invalid status filter", func() {
+		s.repository = newSummaryRepository()
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.ListSummaries(s.ctx, SummaryFilterInput{Status: "UNKNOWN"})
+		s.Require().ErrorIs(err, application.InvalidSummaryFilter)
+	})
+
+	s.Run("invalid date range filter", func() {
+		s.repository = newSummaryRepository()
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		_, err := service.ListSummaries(s.ctx, SummaryFilterInput{
+			CreatedFrom: createdAt,
+			CreatedTo:   createdAt.Add(-time.Hour),
+		})
+		s.Require().ErrorIs(err, application.InvalidSummaryFilter)
+	})
 }
 
 func (s *SummaryTestSuite) TestGetSummaryByExternalID() {
 	s.Run("successful get summary by external id", func() {
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
 		s.repository.EXPECT().
-			GetSummaryByExternalID(mock.Anything, summaryExternalIDUUID).
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
 			Return(&repository.SummaryOutput{
-				ExternalID:   summaryExternalIDUUID,
-				Status:       repository.StatusToString[repository.Summarized].Status,
-				CreatedAt:    createdAt,
-				UpdatedAt:    createdAt,
-				Progress:     sql.NullInt32{Int32: 100, Valid: true},
-				Title:        sql.NullString{String: title, Valid: true},
-				Description:  sql.NullString{String: description, Valid: true},
-				BriefResume:  sql.NullString{String: briefResume, Valid: true},
-				MediumResume: sql.NullString{String: mediumResume, Valid: true},
-				FullText:     sql.NullString{String: fulltext, Valid: true},
+				ExternalID:    summaryExternalIDUUID,
+				Status:        repository.StatusToString[repository.Summarized].Status,
+				CreatedAt:     createdAt,
+				UpdatedAt:     createdAt,
+				Progress:      sql.NullInt32{Int32: 100, Valid: true},
+				Title:         sql.NullString{String: title, Valid: true},
+				Description:   sql.NullString{String: description, Valid: true},
+				BriefResume:   sql.NullString{String: briefResume, Valid: true},
+				MediumResume:  sql.NullString{String: mediumResume, Valid: true},
+				FullText:      sql.NullString{String: fulltext, Valid: true},
+				AudioDuration: sql.NullFloat64{Float64: 93.5, Valid: true},
+				AudioCodec:    sql.NullString{String: "mp3", Valid: true},
+				AudioBitrate:  sql.NullInt32{Int32: 128000, Valid: true},
+				AudioChannels: sql.NullInt32{Int32: 2, Valid: true},
 			}, nil)
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
 		output, err := service.GetSummaryByExternalID(s.ctx, summaryExternalIDUUID)
 		s.Require().NoError(err)
 		s.Equal(summaryExternalIDUUID, output.ExternalID)
@@ -403,59 +1125,119 @@ func (s *SummaryTestSuite) TestGetSummaryByExternalID() {
 		s.Equal(briefResume, output.BriefResume)
 		s.Equal(mediumResume, output.MediumResume)
 		s.Equal(fulltext, output.FullText)
+		s.Equal(&AudioMetadataOutput{DurationSeconds: 93.5, Codec: "mp3", Bitrate: 128000, Channels: 2}, output.Audio)
 	})
 
 	s.Run("error getting summary", func() {
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
 		s.repository.EXPECT().
-			GetSummaryByExternalID(mock.Anything, summaryExternalIDUUID).
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
 			Return(nil, errors.New("some error"))
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
 		_, err := service.GetSummaryByExternalID(s.ctx, summaryExternalIDUUID)
 		s.Require().Error(err)
 	})
 
 	s.Run("get summary not found", func() {
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
 		s.repository.EXPECT().
-			GetSummaryByExternalID(mock.Anything, summaryExternalIDUUID).
+			GetSummaryByExternalID(mock.Anything, workspaceIDUUID, summaryExternalIDUUID).
 			Return(nil, application.SummaryNotFound)
 
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
 		_, err := service.GetSummaryByExternalID(s.ctx, summaryExternalIDUUID)
 		s.Require().ErrorIs(err, application.SummaryNotFound)
 	})
 }
 
 func (s *SummaryTestSuite) TestDeleteSummaryByExternalID() {
+	stored := &repository.SummaryOutput{
+		ExternalID: summaryExternalIDUUID,
+		AudioKey:   sql.NullString{String: audioKey, Valid: true},
+	}
+
 	s.Run("successful delete summary by external id", func() {
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
 		s.repository.EXPECT().
-			DeleteSummaryByExternalID(s.ctx, summaryExternalIDUUID).
+			GetSummaryByExternalID(s.ctx, workspaceIDUUID, summaryExternalIDUUID).
+			Return(stored, nil)
+		s.repository.EXPECT().
+			DeleteSummaryByExternalID(s.ctx, workspaceIDUUID, summaryExternalIDUUID).
 			Return(nil)
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
+
+		blobs := new(gatewaymocks.BlobStore)
+		blobs.EXPECT().Delete(s.ctx, audioKey).Return(nil).Once()
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
+		err := service.DeleteSummaryByExternalID(s.ctx, summaryExternalIDUUID)
+		s.Require().NoError(err)
+		blobs.AssertExpectations(s.T())
+	})
+
+	s.Run("delete summary without stored audio", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			GetSummaryByExternalID(s.ctx, workspaceIDUUID, summaryExternalIDUUID).
+			Return(&repository.SummaryOutput{ExternalID: summaryExternalIDUUID}, nil)
+		s.repository.EXPECT().
+			DeleteSummaryByExternalID(s.ctx, workspaceIDUUID, summaryExternalIDUUID).
+			Return(nil)
+
+		blobs := new(gatewaymocks.BlobStore)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
+		err := service.DeleteSummaryByExternalID(s.ctx, summaryExternalIDUUID)
+		s.Require().NoError(err)
+		blobs.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+	})
+
+	s.Run("keep the summary deleted when the audio removal fails", func() {
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			GetSummaryByExternalID(s.ctx, workspaceIDUUID, summaryExternalIDUUID).
+			Return(stored, nil)
+		s.repository.EXPECT().
+			DeleteSummaryByExternalID(s.ctx, workspaceIDUUID, summaryExternalIDUUID).
+			Return(nil)
+
+		blobs := new(gatewaymocks.BlobStore)
+		blobs.EXPECT().Delete(s.ctx, audioKey).Return(errors.New("some error"))
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
 		err := service.DeleteSummaryByExternalID(s.ctx, summaryExternalIDUUID)
 		s.Require().NoError(err)
 	})
 
 	s.Run("delete summary not found", func() {
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
 		s.repository.EXPECT().
-			DeleteSummaryByExternalID(s.ctx, summaryExternalIDUUID).
-			Return(application.SummaryNotFound)
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
+			GetSummaryByExternalID(s.ctx, workspaceIDUUID, summaryExternalIDUUID).
+			Return(nil, application.SummaryNotFound)
+
+		blobs := new(gatewaymocks.BlobStore)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
 		err := service.DeleteSummaryByExternalID(s.ctx, summaryExternalIDUUID)
 		s.Require().ErrorIs(err, application.SummaryNotFound)
+		s.repository.AssertNotCalled(s.T(), "DeleteSummaryByExternalID", mock.Anything, mock.Anything, mock.Anything)
+		blobs.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
 	})
 
 	s.Run("error deleting summary", func() {
-		s.repository = new(gatewaymocks.Repository)
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			GetSummaryByExternalID(s.ctx, workspaceIDUUID, summaryExternalIDUUID).
+			Return(stored, nil)
 		s.repository.EXPECT().
-			DeleteSummaryByExternalID(s.ctx, summaryExternalIDUUID).
+			DeleteSummaryByExternalID(s.ctx, workspaceIDUUID, summaryExternalIDUUID).
 			Return(errors.New("some error"))
-		service := NewSummary(s.audioTranscript, s.summarize, s.repository)
+
+		blobs := new(gatewaymocks.BlobStore)
+
+		service := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, blobs, s.downloader, 0, ResponseValidation{})
 		err := service.DeleteSummaryByExternalID(s.ctx, summaryExternalIDUUID)
 		s.Require().Error(err)
+		blobs.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
 	})
 }
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	"github.com/diegofsousa/explicAI/internal/gateway/identity"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type TokenUseCase interface {
+	Authenticate(ctx context.Context, token string) (*auth.Principal, error)
+}
+
+// TokenClaims names the claims holding the workspace, role and display name
+// of the user in tokens issued by the identity provider.
+type TokenClaims struct {
+	Workspace string
+	Role      string
+	Name      string
+}
+
+type Token struct {
+	verifier identity.TokenVerifier
+	claims   TokenClaims
+}
+
+func NewToken(verifier identity.TokenVerifier, claims TokenClaims) *Token {
+	return &Token{
+		verifier: verifier,
+		claims:   claims,
+	}
+}
+
+func (t *Token) Authenticate(ctx context.Context, token string) (*auth.Principal, error) {
+	claims, err := t.verifier.Verify(ctx, token)
+	if err != nil {
+		log.LogWarn(ctx, "rejected bearer token", zap.String("reason", err.Error()))
+		return nil, application.Unauthorized
+	}
+
+	if claims.Subject == "" {
+		return nil, application.Unauthorized
+	}
+
+	value, _ := claims.Values[t.claims.Workspace].(string)
+	workspaceID, err := uuid.Parse(value)
+	if err != nil {
+		log.LogWarn(ctx, "bearer token without a valid workspace", zap.String("subject", claims.Subject))
+		return nil, application.Forbidden
+	}
+
+	name, _ := claims.Values[t.claims.Name].(string)
+	if name == "" {
+		name = claims.Subject
+	}
+
+	return &auth.Principal{
+		KeyID:       userID(claims),
+		WorkspaceID: workspaceID,
+		Name:        name,
+		Role:        roleFromClaim(claims.Values[t.claims.Role]),
+	}, nil
+}
+
+// userID keeps uuid subjects as they are and derives a stable id for any
+// other subject, scoped by the issuer.
+func userID(claims *identity.Claims) uuid.UUID {
+	if id, err := uuid.Parse(claims.Subject); err == nil {
+		return id
+	}
+
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(claims.Issuer+"#"+claims.Subject))
+}
+
+// roleFromClaim accepts a single role or a list of roles, keeping the
+// highest known one. Users without a known role are viewers.
+func roleFromClaim(value any) auth.Role {
+	var values []any
+	switch claim := value.(type) {
+	case string:
+		values = []any{claim}
+	case []any:
+		values = claim
+	}
+
+	role := auth.RoleViewer
+	for _, value := range values {
+		name, _ := value.(string)
+		if parsed, ok := auth.ParseRole(name); ok && parsed.Allows(role) {
+			role = parsed
+		}
+	}
+
+	return role
+}
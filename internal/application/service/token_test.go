@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	"github.com/diegofsousa/explicAI/internal/gateway/identity"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+var tokenClaims = TokenClaims{Workspace: "workspace_id", Role: "roles", Name: "name"}
+
+type (
+	TokenTestSuite struct {
+		suite.Suite
+
+		ctx      context.Context
+		verifier *gatewaymocks.TokenVerifier
+	}
+)
+
+func TestTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenTestSuite))
+}
+
+func (s *TokenTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *TokenTestSuite) TestAuthenticate() {
+	s.Run("successful map claims to principal", func() {
+		s.verifier = new(gatewaymocks.TokenVerifier)
+		s.verifier.EXPECT().
+			Verify(mock.Anything, "token").
+			Return(&identity.Claims{
+				Issuer:  "https://idp.example.com",
+				Subject: ownerIDStr,
+				Values: map[string]any{
+					"workspace_id": workspaceIDStr,
+					"roles":        []any{"viewer", "editor", "unknown"},
+					"name":         "Ana",
+				},
+			}, nil)
+
+		service := NewToken(s.verifier, tokenClaims)
+		output, err := service.Authenticate(s.ctx, "token")
+		s.Require().NoError(err)
+		s.Equal(auth.Principal{
+			KeyID:       ownerIDUUID,
+			WorkspaceID: workspaceIDUUID,
+			Name:        "Ana",
+			Role:        auth.RoleEditor,
+		}, *output)
+	})
+
+	s.Run("derive stable user id from non uuid subject", func() {
+		s.verifier = new(gatewaymocks.TokenVerifier)
+		s.verifier.EXPECT().
+			Verify(mock.Anything, "token").
+			Return(&identity.Claims{
+				Issuer:  "https://idp.example.com",
+				Subject: "auth0|123",
+				Values:  map[string]any{"workspace_id": workspaceIDStr},
+			}, nil)
+
+		service := NewToken(s.verifier, tokenClaims)
+		first, err := service.Authenticate(s.ctx, "token")
+		s.Require().NoError(err)
+		second, err := service.Authenticate(s.ctx, "token")
+		s.Require().NoError(err)
+
+		s.NotEqual(uuid.Nil, first.KeyID)
+		s.Equal(first.KeyID, second.KeyID)
+		s.Equal("auth0|123", first.Name)
+		s.Equal(auth.RoleViewer, first.Role)
+	})
+
+	s.Run("reject token failing verification", func() {
+		s.verifier = new(gatewaymocks.TokenVerifier)
+		s.verifier.EXPECT().
+			Verify(mock.Anything, "token").
+			Return(nil, errors.New("token is expired"))
+
+		service := NewToken(s.verifier, tokenClaims)
+		_, err := service.Authenticate(s.ctx, "token")
+		s.Require().ErrorIs(err, application.Unauthorized)
+	})
+
+	s.Run("reject token without workspace", func() {
+		s.verifier = new(gatewaymocks.TokenVerifier)
+		s.verifier.EXPECT().
+			Verify(mock.Anything, "token").
+			Return(&identity.Claims{Subject: "user", Values: map[string]any{"workspace_id": "acme"}}, nil)
+
+		service := NewToken(s.verifier, tokenClaims)
+		_, err := service.Authenticate(s.ctx, "token")
+		s.Require().ErrorIs(err, application.Forbidden)
+	})
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	"github.com/diegofsousa/explicAI/internal/gateway/upload"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type UploadUseCase interface {
+	CreateUpload(ctx context.Context, input UploadCreateInput) (*UploadOutput, error)
+	GetUpload(ctx context.Context, id uuid.UUID) (*UploadOutput, error)
+	WriteUploadChunk(ctx context.Context, input UploadChunkInput) (*UploadOutput, error)
+	DeleteUpload(ctx context.Context, id uuid.UUID) error
+}
+
+// Upload receives an audio in chunks, so an interrupted upload can resume
+// from the last received byte. The complete audio is handed to the summary
+// pipeline.
+type Upload struct {
+	store      upload.UploadStore
+	summary    SummaryUseCase
+	maxSize    int64
+	now        func() time.Time
+	completing sync.Mutex
+}
+
+func NewUpload(store upload.UploadStore, summary SummaryUseCase, maxSize int64) *Upload {
+	return &Upload{
+		store:   store,
+		summary: summary,
+		maxSize: maxSize,
+		now:     time.Now,
+	}
+}
+
+func (u *Upload) CreateUpload(ctx context.Context, input UploadCreateInput) (*UploadOutput, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, application.Unauthorized
+	}
+
+	if input.Length <= 0 {
+		return nil, application.InvalidUpload
+	}
+
+	if u.maxSize > 0 && input.Length > u.maxSize {
+		return nil, application.UploadTooLarge
+	}
+
+	info := upload.UploadInfo{
+		ID:          uuid.New(),
+		WorkspaceID: principal.WorkspaceID,
+		OwnerID:     principal.KeyID,
+		Length:      input.Length,
+		Metadata:    input.Metadata,
+		CreatedAt:   u.now(),
+	}
+
+	if err := u.store.CreateUpload(ctx, info); err != nil {
+		log.LogError(ctx, "failed to create upload", err)
+		return nil, application.StoreAudioFailed
+	}
+
+	return toUploadOutput(&info), nil
+}
+
+func (u *Upload) GetUpload(ctx context.Context, id uuid.UUID) (*UploadOutput, error) {
+	info, err := u.getUpload(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUploadOutput(info), nil
+}
+
+// WriteUploadChunk appends the chunk at the given offset. Once the last byte
+// arrives, the audio follows the same pipeline as a single request upload.
+// When that fails the chunks are kept, and an empty chunk at the final
+// offset retries it.
+func (u *Upload) WriteUploadChunk(ctx context.Context, input UploadChunkInput) (*UploadOutput, error) {
+	info, err := u.getUpload(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Offset != input.Offset {
+		return nil, application.UploadOffsetMismatch
+	}
+
+	if info.SummaryExternalID != uuid.Nil {
+		return toUploadOutput(info), nil
+	}
+
+	offset, err := u.store.WriteChunk(ctx, info.ID, input.Offset, io.LimitReader(input.Content, info.Length-input.Offset))
+	if err == application.UploadOffsetMismatch {
+		return nil, err
+	}
+
+	if err != nil {
+		log.LogError(ctx, "failed to write upload chunk", err, zap.String("upload_id", info.ID.String()))
+		return nil, application.FailedReadFile
+	}
+
+	info.Offset = offset
+	if info.Offset < info.Length {
+		return toUploadOutput(info), nil
+	}
+
+	return u.complete(ctx, info.ID)
+}
+
+func (u *Upload) DeleteUpload(ctx context.Context, id uuid.UUID) error {
+	info, err := u.getUpload(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err = u.store.DeleteUpload(ctx, info.ID); err != nil {
+		log.LogError(ctx, "failed to delete upload", err, zap.String("upload_id", info.ID.String()))
+		return application.StoreAudioFailed
+	}
+
+	return nil
+}
+
+func (u *Upload) complete(ctx context.Context, id uuid.UUID) (*UploadOutput, error) {
+	u.completing.Lock()
+	defer u.completing.Unlock()
+
+	// a concurrent request may have completed the upload meanwhile
+	info, err := u.getUpload(ctx, id)
+	if err != nil || info.SummaryExternalID != uuid.Nil {
+		return toUploadOutput(info), err
+	}
+
+	content, err := u.store.ReadUpload(ctx, id)
+	if err != nil {
+		log.LogError(ctx, "failed to read upload", err, zap.String("upload_id", id.String()))
+		return nil, application.FailedReadFile
+	}
+	defer content.Close()
+
+	summary, err := u.summary.CreateSummaryAndTriggerAIProccess(ctx, SummaryAudioInput{
+		Content:  content,
+		Size:     info.Length,
+		Filename: info.Metadata["filename"],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info.SummaryExternalID = summary.ExternalID
+	if err = u.store.UpdateUpload(ctx, *info); err != nil {
+		log.LogError(ctx, "failed to update upload", err, zap.String("upload_id", id.String()))
+	}
+
+	if err = u.store.DeleteUploadContent(ctx, id); err != nil {
+		log.LogError(ctx, "failed to remove upload content", err, zap.String("upload_id", id.String()))
+	}
+
+	return toUploadOutput(info), nil
+}
+
+func (u *Upload) getUpload(ctx context.Context, id uuid.UUID) (*upload.UploadInfo, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, application.Unauthorized
+	}
+
+	info, err := u.store.GetUpload(ctx, id)
+	if err == application.UploadNotFound {
+		return nil, err
+	}
+
+	if err != nil {
+		log.LogError(ctx, "failed to get upload", err, zap.String("upload_id", id.String()))
+		return nil, application.StoreAudioFailed
+	}
+
+	if info.WorkspaceID != principal.WorkspaceID {
+		return nil, application.UploadNotFound
+	}
+
+	return info, nil
+}
+
+func toUploadOutput(info *upload.UploadInfo) *UploadOutput {
+	if info == nil {
+		return nil
+	}
+
+	return &UploadOutput{
+		ID:                info.ID,
+		Length:            info.Length,
+		Offset:            info.Offset,
+		Metadata:          info.Metadata,
+		SummaryExternalID: info.SummaryExternalID,
+	}
+}
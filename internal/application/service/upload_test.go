@@ -0,0 +1,172 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/gateway/upload"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type readSeekNopCloser struct {
+	io.ReadSeeker
+}
+
+func (readSeekNopCloser) Close() error { return nil }
+
+func (s *SummaryTestSuite) TestUpload() {
+	uploadID := uuid.New()
+	partial := func() *upload.UploadInfo {
+		return &upload.UploadInfo{ID: uploadID, WorkspaceID: workspaceIDUUID, OwnerID: ownerIDUUID, Length: 10, Offset: 4}
+	}
+
+	newUpload := func(store *gatewaymocks.UploadStore) *Upload {
+		summary := NewSummary(s.audioTranscript, s.summarize, s.repository, s.publisher, s.usage, s.blobs, s.downloader, 0, ResponseValidation{})
+		return NewUpload(store, summary, 100)
+	}
+
+	s.Run("successful create upload", func() {
+		store := new(gatewaymocks.UploadStore)
+		store.EXPECT().
+			CreateUpload(mock.Anything, mock.MatchedBy(func(info upload.UploadInfo) bool {
+				return info.ID != uuid.Nil && info.WorkspaceID == workspaceIDUUID && info.OwnerID == ownerIDUUID && info.Length == 10
+			})).
+			Return(nil)
+
+		output, err := newUpload(store).CreateUpload(s.ctx, UploadCreateInput{Length: 10})
+		s.Require().NoError(err)
+		s.Equal(int64(10), output.Length)
+		s.Equal(int64(0), output.Offset)
+	})
+
+	s.Run("reject invalid length", func() {
+		store := new(gatewaymocks.UploadStore)
+
+		_, err := newUpload(store).CreateUpload(s.ctx, UploadCreateInput{Length: 0})
+		s.Require().ErrorIs(err, application.InvalidUpload)
+
+		_, err = newUpload(store).CreateUpload(s.ctx, UploadCreateInput{Length: 101})
+		s.Require().ErrorIs(err, application.UploadTooLarge)
+
+		viewerCtx := auth.WithPrincipal(s.ctx, auth.Principal{WorkspaceID: workspaceIDUUID, Role: auth.RoleViewer})
+		_, err = NewUploadAuthorization(newUpload(store)).CreateUpload(viewerCtx, UploadCreateInput{Length: 10})
+		s.Require().ErrorIs(err, application.Forbidden)
+
+		store.AssertNotCalled(s.T(), "CreateUpload", mock.Anything, mock.Anything)
+	})
+
+	s.Run("upload of another workspace is not found", func() {
+		store := new(gatewaymocks.UploadStore)
+		store.EXPECT().
+			GetUpload(mock.Anything, uploadID).
+			Return(&upload.UploadInfo{ID: uploadID, WorkspaceID: uuid.New(), Length: 10}, nil)
+
+		_, err := newUpload(store).GetUpload(s.ctx, uploadID)
+		s.Require().ErrorIs(err, application.UploadNotFound)
+	})
+
+	s.Run("write partial chunk", func() {
+		store := new(gatewaymocks.UploadStore)
+		store.EXPECT().GetUpload(mock.Anything, uploadID).Return(partial(), nil)
+		store.EXPECT().
+			WriteChunk(mock.Anything, uploadID, int64(4), mock.Anything).
+			Return(int64(6), nil)
+
+		output, err := newUpload(store).WriteUploadChunk(s.ctx, UploadChunkInput{ID: uploadID, Offset: 4, Content: strings.NewReader("45")})
+		s.Require().NoError(err)
+		s.Equal(int64(6), output.Offset)
+		s.Equal(uuid.Nil, output.SummaryExternalID)
+	})
+
+	s.Run("reject chunk at wrong offset", func() {
+		store := new(gatewaymocks.UploadStore)
+		store.EXPECT().GetUpload(mock.Anything, uploadID).Return(partial(), nil)
+
+		_, err := newUpload(store).WriteUploadChunk(s.ctx, UploadChunkInput{ID: uploadID, Offset: 2, Content: strings.NewReader("23")})
+		s.Require().ErrorIs(err, application.UploadOffsetMismatch)
+		store.AssertNotCalled(s.T(), "WriteChunk", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("last chunk creates the summary", func() {
+		store := new(gatewaymocks.UploadStore)
+		store.EXPECT().GetUpload(mock.Anything, uploadID).Return(partial(), nil).Once()
+		store.EXPECT().
+			WriteChunk(mock.Anything, uploadID, int64(4), mock.Anything).
+			Return(int64(10), nil)
+		store.EXPECT().
+			GetUpload(mock.Anything, uploadID).
+			Return(&upload.UploadInfo{ID: uploadID, WorkspaceID: workspaceIDUUID, Length: 10, Offset: 10}, nil).
+			Once()
+		store.EXPECT().
+			ReadUpload(mock.Anything, uploadID).
+			Return(readSeekNopCloser{bytes.NewReader([]byte("ID3-456789"))}, nil)
+		store.EXPECT().
+			UpdateUpload(mock.Anything, mock.MatchedBy(func(info upload.UploadInfo) bool {
+				return info.SummaryExternalID == summaryExternalIDUUID
+			})).
+			Return(nil)
+		store.EXPECT().DeleteUploadContent(mock.Anything, uploadID).Return(nil)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, summaryCreateInput).
+			Return(&repository.SummaryCreateOutput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     repository.StatusToString[repository.ReceivedFile].Status,
+				CreatedAt:  createdAt,
+			}, nil)
+		s.repository.EXPECT().
+			UpdateSummaryTranscribed(mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).
+			Maybe()
+
+		s.audioTranscript = new(gatewaymocks.AudioTranscript)
+		s.audioTranscript.EXPECT().
+			Transcribe(mock.Anything, mock.Anything).
+			Return(nil, errors.New("some error")).
+			Maybe()
+
+		output, err := newUpload(store).WriteUploadChunk(s.ctx, UploadChunkInput{ID: uploadID, Offset: 4, Content: strings.NewReader("456789")})
+		s.Require().NoError(err)
+		s.Equal(int64(10), output.Offset)
+		s.Equal(summaryExternalIDUUID, output.SummaryExternalID)
+		store.AssertExpectations(s.T())
+	})
+
+	s.Run("failed summary keeps the upload content", func() {
+		complete := &upload.UploadInfo{ID: uploadID, WorkspaceID: workspaceIDUUID, Length: 10, Offset: 10}
+
+		store := new(gatewaymocks.UploadStore)
+		store.EXPECT().GetUpload(mock.Anything, uploadID).Return(complete, nil)
+		store.EXPECT().
+			WriteChunk(mock.Anything, uploadID, int64(10), mock.Anything).
+			Return(int64(10), nil)
+		store.EXPECT().
+			ReadUpload(mock.Anything, uploadID).
+			Return(readSeekNopCloser{bytes.NewReader([]byte("ID3-456789"))}, nil)
+
+		s.repository = newSummaryRepository()
+		s.repository.EXPECT().
+			CreateSummary(mock.Anything, workspaceIDUUID, summaryCreateInput).
+			Return(nil, errors.New("some error"))
+
+		_, err := newUpload(store).WriteUploadChunk(s.ctx, UploadChunkInput{ID: uploadID, Offset: 10, Content: strings.NewReader("")})
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+		store.AssertNotCalled(s.T(), "DeleteUploadContent", mock.Anything, mock.Anything)
+	})
+
+	s.Run("successful delete upload", func() {
+		store := new(gatewaymocks.UploadStore)
+		store.EXPECT().GetUpload(mock.Anything, uploadID).Return(partial(), nil)
+		store.EXPECT().DeleteUpload(mock.Anything, uploadID).Return(nil)
+
+		s.Require().NoError(newUpload(store).DeleteUpload(s.ctx, uploadID))
+	})
+}
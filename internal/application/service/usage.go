@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	UsageOperationTranscription = "transcription"
+	UsageOperationResume        = "resume"
+	UsageOperationFullText      = "fulltext"
+)
+
+type UsageUseCase interface {
+	GetUsage(ctx context.Context, filter UsageFilterInput) (*UsageReportOutput, error)
+}
+
+type UsageRecorder interface {
+	RecordUsage(ctx context.Context, input UsageRecordInput)
+}
+
+// ModelPrice holds the provider prices of a model in USD: input and output
+// per million tokens and minute per minute of transcribed audio.
+type ModelPrice struct {
+	Input  float64 `json:"input"`
+	Output float64 `json:"output"`
+	Minute float64 `json:"minute"`
+}
+
+type Usage struct {
+	repository repository.UsageRepository
+	prices     map[string]ModelPrice
+}
+
+func NewUsage(repository repository.UsageRepository, prices map[string]ModelPrice) *Usage {
+	return &Usage{
+		repository: repository,
+		prices:     prices,
+	}
+}
+
+// RecordUsage stores the usage of a single AI call. Failures are only logged
+// so accounting never breaks the summary pipeline.
+func (u *Usage) RecordUsage(ctx context.Context, input UsageRecordInput) {
+	principal, _ := auth.PrincipalFromContext(ctx)
+
+	if err := u.repository.CreateUsage(ctx, principal.WorkspaceID, repository.UsageCreateInput{
+		SummaryExternalID: input.SummaryExternalID,
+		UserID:            principal.KeyID,
+		Operation:         input.Operation,
+		Model:             input.Model,
+		Provider:          input.Provider,
+		PromptTokens:      input.PromptTokens,
+		CompletionTokens:  input.CompletionTokens,
+		AudioSeconds:      input.AudioSeconds,
+		Cost:              u.cost(ctx, input),
+		ValidationError:   input.ValidationError,
+	}); err != nil {
+		log.LogError(ctx, "failed to save usage in db", err, zap.String("external_id", input.SummaryExternalID.String()))
+	}
+}
+
+func (u *Usage) GetUsage(ctx context.Context, filter UsageFilterInput) (*UsageReportOutput, error) {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, application.InvalidUsageFilter
+	}
+
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := u.repository.GetUsage(ctx, workspaceID, repository.UsageFilter{
+		From: filter.From,
+		To:   filter.To,
+	})
+	if err != nil {
+		log.LogError(ctx, "error on get usage", err)
+		return nil, application.InternalDatabaseError
+	}
+
+	output := UsageReportOutput{
+		ByDay:  []UsageDayOutput{},
+		ByUser: []UsageUserOutput{},
+	}
+
+	days := map[string]*UsageTotalsOutput{}
+	users := map[uuid.UUID]*UsageTotalsOutput{}
+
+	for _, row := range rows {
+		day := row.Day.Format("2006-01-02")
+		if _, ok := days[day]; !ok {
+			days[day] = &UsageTotalsOutput{}
+		}
+
+		if _, ok := users[row.UserID]; !ok {
+			users[row.UserID] = &UsageTotalsOutput{}
+		}
+
+		days[day].add(row)
+		users[row.UserID].add(row)
+		output.Total.add(row)
+	}
+
+	for day, totals := range days {
+		output.ByDay = append(output.ByDay, UsageDayOutput{Day: day, UsageTotalsOutput: *totals})
+	}
+
+	for userID, totals := range users {
+		output.ByUser = append(output.ByUser, UsageUserOutput{UserID: userID, UsageTotalsOutput: *totals})
+	}
+
+	sort.Slice(output.ByDay, func(i, j int) bool { return output.ByDay[i].Day < output.ByDay[j].Day })
+	sort.Slice(output.ByUser, func(i, j int) bool { return output.ByUser[i].Cost > output.ByUser[j].Cost })
+
+	return &output, nil
+}
+
+func (u *Usage) cost(ctx context.Context, input UsageRecordInput) float64 {
+	price, ok := u.prices[input.Model]
+	if !ok {
+		log.LogWarn(ctx, "no price configured for model", zap.String("model", input.Model))
+		return 0
+	}
+
+	return float64(input.PromptTokens)*price.Input/1_000_000 +
+		float64(input.CompletionTokens)*price.Output/1_000_000 +
+		input.AudioSeconds/60*price.Minute
+}
+
+func (t *UsageTotalsOutput) add(row repository.UsageAggregateOutput) {
+	t.Requests += row.Requests
+	t.PromptTokens += row.PromptTokens
+	t.CompletionTokens += row.CompletionTokens
+	t.AudioSeconds += row.AudioSeconds
+	t.Cost += row.Cost
+	t.Rejected += row.Rejected
+}
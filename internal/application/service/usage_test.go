@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+var prices = map[string]ModelPrice{
+	"gpt-4o":    {Input: 2.5, Output: 10},
+	"whisper-1": {Minute: 0.006},
+}
+
+type (
+	UsageTestSuite struct {
+		suite.Suite
+
+		ctx        context.Context
+		repository *gatewaymocks.UsageRepository
+	}
+)
+
+func TestUsageTestSuite(t *testing.T) {
+	suite.Run(t, new(UsageTestSuite))
+}
+
+func (s *UsageTestSuite) SetupTest() {
+	admin := principal
+	admin.Role = auth.RoleAdmin
+	s.ctx = auth.WithPrincipal(context.Background(), admin)
+}
+
+func (s *UsageTestSuite) TestRecordUsage() {
+	s.Run("record token usage with cost", func() {
+		s.repository = new(gatewaymocks.UsageRepository)
+		s.repository.EXPECT().
+			CreateUsage(mock.Anything, workspaceIDUUID, mock.MatchedBy(func(input repository.UsageCreateInput) bool {
+				return input.UserID == ownerIDUUID &&
+					input.Operation == UsageOperationResume &&
+					input.Provider == "chatgpt" &&
+					input.PromptTokens == 1000 &&
+					input.CompletionTokens == 500 &&
+					input.ValidationError == "title is empty" &&
+					input.Cost > 0.0074 && input.Cost < 0.0076
+			})).
+			Return(nil)
+
+		NewUsage(s.repository, prices).RecordUsage(s.ctx, UsageRecordInput{
+			SummaryExternalID: summaryExternalIDUUID,
+			Operation:         UsageOperationResume,
+			Model:             "gpt-4o",
+			Provider:          "chatgpt",
+			PromptTokens:      1000,
+			CompletionTokens:  500,
+			ValidationError:   "title is empty",
+		})
+		s.repository.AssertExpectations(s.T())
+	})
+
+	s.Run("record audio usage with cost per minute", func() {
+		s.repository = new(gatewaymocks.UsageRepository)
+		s.repository.EXPECT().
+			CreateUsage(mock.Anything, workspaceIDUUID, mock.MatchedBy(func(input repository.UsageCreateInput) bool {
+				return input.AudioSeconds == 90 && input.Cost > 0.0089 && input.Cost < 0.0091
+			})).
+			Return(nil)
+
+		NewUsage(s.repository, prices).RecordUsage(s.ctx, UsageRecordInput{
+			SummaryExternalID: summaryExternalIDUUID,
+			Operation:         UsageOperationTranscription,
+			Model:             "whisper-1",
+			AudioSeconds:      90,
+		})
+		s.repository.AssertExpectations(s.T())
+	})
+
+	s.Run("unknown model is recorded without cost", func() {
+		s.repository = new(gatewaymocks.UsageRepository)
+		s.repository.EXPECT().
+			CreateUsage(mock.Anything, workspaceIDUUID, mock.MatchedBy(func(input repository.UsageCreateInput) bool {
+				return input.Model == "unknown" && input.Cost == 0
+			})).
+			Return(errors.New("some error"))
+
+		NewUsage(s.repository, prices).RecordUsage(s.ctx, UsageRecordInput{
+			SummaryExternalID: summaryExternalIDUUID,
+			Operation:         UsageOperationFullText,
+			Model:             "unknown",
+			PromptTokens:      10,
+		})
+		s.repository.AssertExpectations(s.T())
+	})
+}
+
+func (s *UsageTestSuite) TestGetUsage() {
+	day, _ := time.Parse(time.DateOnly, "2025-01-25")
+	otherUser := uuid.New()
+
+	s.Run("successful get usage grouped by day and user", func() {
+		s.repository = new(gatewaymocks.UsageRepository)
+		s.repository.EXPECT().
+			GetUsage(mock.Anything, workspaceIDUUID, repository.UsageFilter{}).
+			Return([]repository.UsageAggregateOutput{
+				{Day: day, UserID: ownerIDUUID, Requests: 3, PromptTokens: 100, CompletionTokens: 50, AudioSeconds: 60, Cost: 1, Rejected: 1},
+				{Day: day, UserID: otherUser, Requests: 1, PromptTokens: 10, Cost: 2},
+				{Day: day.AddDate(0, 0, 1), UserID: ownerIDUUID, Requests: 2, CompletionTokens: 5, Cost: 0.5},
+			}, nil)
+
+		output, err := NewUsage(s.repository, prices).GetUsage(s.ctx, UsageFilterInput{})
+		s.Require().NoError(err)
+		s.Equal(int64(6), output.Total.Requests)
+		s.Equal(int64(110), output.Total.PromptTokens)
+		s.Equal(int64(55), output.Total.CompletionTokens)
+		s.InDelta(3.5, output.Total.Cost, 0.0001)
+		s.Equal(int64(1), output.Total.Rejected)
+
+		s.Require().Len(output.ByDay, 2)
+		s.Equal("2025-01-25", output.ByDay[0].Day)
+		s.InDelta(3, output.ByDay[0].Cost, 0.0001)
+
+		s.Require().Len(output.ByUser, 2)
+		s.Equal(otherUser, output.ByUser[0].UserID)
+		s.Equal(ownerIDUUID, output.ByUser[1].UserID)
+		s.Equal(int64(5), output.ByUser[1].Requests)
+	})
+
+	s.Run("invalid period", func() {
+		_, err := NewUsage(new(gatewaymocks.UsageRepository), prices).GetUsage(s.ctx, UsageFilterInput{
+			From: day,
+			To:   day,
+		})
+		s.Require().ErrorIs(err, application.InvalidUsageFilter)
+	})
+
+	s.Run("forbidden for editors", func() {
+		ctx := auth.WithPrincipal(context.Background(), principal)
+		_, err := NewUsageAuthorization(NewUsage(new(gatewaymocks.UsageRepository), prices)).GetUsage(ctx, UsageFilterInput{})
+		s.Require().ErrorIs(err, application.Forbidden)
+	})
+}
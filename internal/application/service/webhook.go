@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+)
+
+const webhookSecretPrefix = "whsec_"
+
+type WebhookUseCase interface {
+	CreateWebhook(ctx context.Context, input WebhookCreateInput) (*WebhookOutput, error)
+	UpdateWebhook(ctx context.Context, externalID uuid.UUID, input WebhookUpdateInput) (*WebhookOutput, error)
+	ListWebhooks(ctx context.Context) (*WebhookListOutput, error)
+	GetWebhookByExternalID(ctx context.Context, externalID uuid.UUID) (*WebhookOutput, error)
+	DeleteWebhookByExternalID(ctx context.Context, externalID uuid.UUID) error
+	ListWebhookDeliveries(ctx context.Context, externalID uuid.UUID) (*WebhookDeliveryListOutput, error)
+}
+
+type Webhook struct {
+	repository repository.WebhookRepository
+}
+
+func NewWebhook(repository repository.WebhookRepository) *Webhook {
+	return &Webhook{
+		repository: repository,
+	}
+}
+
+func (w *Webhook) CreateWebhook(ctx context.Context, input WebhookCreateInput) (*WebhookOutput, error) {
+	if !isValidWebhookURL(input.URL) || !areKnownStatuses(input.Statuses) {
+		return nil, application.InvalidWebhook
+	}
+
+	secret := input.Secret
+	if secret == "" {
+		generated, err := generateWebhookSecret()
+		if err != nil {
+			log.LogError(ctx, "failed to generate webhook secret", err)
+			return nil, err
+		}
+		secret = generated
+	}
+
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := w.repository.CreateWebhook(ctx, workspaceID, repository.WebhookCreateInput{
+		URL:      input.URL,
+		Secret:   secret,
+		Statuses: input.Statuses,
+	})
+	if err != nil {
+		log.LogError(ctx, "failed to create webhook in db", err)
+		return nil, application.InternalDatabaseError
+	}
+
+	output := toWebhookOutput(*webhook)
+	output.Secret = webhook.Secret
+
+	return &output, nil
+}
+
+func (w *Webhook) UpdateWebhook(ctx context.Context, externalID uuid.UUID, input WebhookUpdateInput) (*WebhookOutput, error) {
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := w.repository.GetWebhookByExternalID(ctx, workspaceID, externalID)
+	if err == application.WebhookNotFound {
+		return nil, err
+	}
+
+	if err != nil {
+		log.LogError(ctx, "error on get webhook", err)
+		return nil, err
+	}
+
+	update := repository.WebhookUpdateInput{
+		ExternalID: externalID,
+		URL:        current.URL,
+		Statuses:   current.Statuses,
+		Active:     current.Active,
+	}
+
+	if input.URL != nil {
+		update.URL = *input.URL
+	}
+
+	if input.Statuses != nil {
+		update.Statuses = *input.Statuses
+	}
+
+	if input.Active != nil {
+		update.Active = *input.Active
+	}
+
+	if !isValidWebhookURL(update.URL) || !areKnownStatuses(update.Statuses) {
+		return nil, application.InvalidWebhook
+	}
+
+	webhook, err := w.repository.UpdateWebhook(ctx, workspaceID, update)
+	if err == application.WebhookNotFound {
+		return nil, err
+	}
+
+	if err != nil {
+		log.LogError(ctx, "error on update webhook", err)
+		return nil, err
+	}
+
+	output := toWebhookOutput(*webhook)
+	return &output, nil
+}
+
+func (w *Webhook) ListWebhooks(ctx context.Context) (*WebhookListOutput, error) {
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := w.repository.GetWebhooks(ctx, workspaceID)
+	if err != nil {
+		log.LogError(ctx, "error on list webhooks", err)
+		return nil, err
+	}
+
+	webhooksOutput := []WebhookOutput{}
+	for _, webhook := range webhooks {
+		webhooksOutput = append(webhooksOutput, toWebhookOutput(webhook))
+	}
+
+	return &WebhookListOutput{
+		Data: webhooksOutput,
+	}, nil
+}
+
+func (w *Webhook) GetWebhookByExternalID(ctx context.Context, externalID uuid.UUID) (*WebhookOutput, error) {
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := w.repository.GetWebhookByExternalID(ctx, workspaceID, externalID)
+	if err == application.WebhookNotFound {
+		return nil, err
+	}
+
+	if err != nil {
+		log.LogError(ctx, "error on get webhook", err)
+		return nil, err
+	}
+
+	output := toWebhookOutput(*webhook)
+	return &output, nil
+}
+
+func (w *Webhook) DeleteWebhookByExternalID(ctx context.Context, externalID uuid.UUID) error {
+	workspaceID, err := workspaceFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = w.repository.DeleteWebhookByExternalID(ctx, workspaceID, externalID)
+	if err == application.WebhookNotFound {
+		return err
+	}
+
+	if err != nil {
+		log.LogError(ctx, "error on delete webhook", err)
+		return err
+	}
+
+	return nil
+}
+
+func (w *Webhook) ListWebhookDeliveries(ctx context.Context, externalID uuid.UUID) (*WebhookDeliveryListOutput, error) {
+	if _, err := w.GetWebhookByExternalID(ctx, externalID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := w.repository.GetWebhookDeliveries(ctx, externalID)
+	if err != nil {
+		log.LogError(ctx, "error on list webhook deliveries", err)
+		return nil, err
+	}
+
+	deliveriesOutput := []WebhookDeliveryOutput{}
+	for _, delivery := range deliveries {
+		deliveriesOutput = append(deliveriesOutput, WebhookDeliveryOutput{
+			ExternalID: delivery.ExternalID,
+			CreatedAt:  delivery.CreatedAt,
+			EventID:    delivery.EventID,
+			EventType:  delivery.EventType,
+			Attempt:    delivery.Attempt,
+			Payload:    delivery.Payload,
+			StatusCode: int(delivery.StatusCode.Int32),
+			Error:      delivery.Error.String,
+			Delivered:  delivery.Delivered,
+		})
+	}
+
+	return &WebhookDeliveryListOutput{
+		Data: deliveriesOutput,
+	}, nil
+}
+
+func toWebhookOutput(webhook repository.WebhookOutput) WebhookOutput {
+	return WebhookOutput{
+		ExternalID: webhook.ExternalID,
+		CreatedAt:  webhook.CreatedAt,
+		UpdatedAt:  webhook.UpdatedAt,
+		URL:        webhook.URL,
+		Statuses:   webhook.Statuses,
+		Active:     webhook.Active,
+	}
+}
+
+func isValidWebhookURL(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+func areKnownStatuses(statuses []string) bool {
+	for _, status := range statuses {
+		if !isKnownStatus(status) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return webhookSecretPrefix + hex.EncodeToString(secret), nil
+}
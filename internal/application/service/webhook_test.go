@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/auth"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	webhookExternalIDStr  = "0b6c7d8e-35e3-4b8b-9d3c-1b4c0d2a9f10"
+	webhookExternalIDUUID = uuid.MustParse(webhookExternalIDStr)
+	webhookURL            = "https://hooks.example.com/explicai"
+	webhookSecret         = "whsec_test"
+)
+
+type (
+	WebhookTestSuite struct {
+		suite.Suite
+
+		ctx        context.Context
+		repository *gatewaymocks.WebhookRepository
+		sender     *gatewaymocks.Sender
+	}
+)
+
+func TestWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}
+
+func (s *WebhookTestSuite) SetupTest() {
+	s.ctx = auth.WithPrincipal(context.Background(), principal)
+}
+
+func (s *WebhookTestSuite) TestCreateWebhook() {
+	s.Run("successful create webhook with generated secret", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			CreateWebhook(mock.Anything, workspaceIDUUID, mock.MatchedBy(func(input repository.WebhookCreateInput) bool {
+				return input.URL == webhookURL &&
+					strings.HasPrefix(input.Secret, webhookSecretPrefix) &&
+					len(input.Secret) == len(webhookSecretPrefix)+64 &&
+					len(input.Statuses) == 1 && input.Statuses[0] == "SUMMARIZED"
+			})).
+			RunAndReturn(func(ctx context.Context, workspaceID uuid.UUID, input repository.WebhookCreateInput) (*repository.WebhookOutput, error) {
+				return &repository.WebhookOutput{
+					ExternalID: webhookExternalIDUUID,
+					CreatedAt:  createdAt,
+					UpdatedAt:  createdAt,
+					URL:        input.URL,
+					Secret:     input.Secret,
+					Statuses:   input.Statuses,
+					Active:     true,
+				}, nil
+			})
+
+		service := NewWebhook(s.repository)
+		output, err := service.CreateWebhook(s.ctx, WebhookCreateInput{
+			URL:      webhookURL,
+			Statuses: []string{"SUMMARIZED"},
+		})
+		s.Require().NoError(err)
+		s.Equal(webhookExternalIDUUID, output.ExternalID)
+		s.True(strings.HasPrefix(output.Secret, webhookSecretPrefix))
+		s.True(output.Active)
+	})
+
+	s.Run("successful create webhook with informed secret", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			CreateWebhook(mock.Anything, workspaceIDUUID, repository.WebhookCreateInput{URL: webhookURL, Secret: webhookSecret}).
+			Return(&repository.WebhookOutput{
+				ExternalID: webhookExternalIDUUID,
+				URL:        webhookURL,
+				Secret:     webhookSecret,
+				Active:     true,
+			}, nil)
+
+		service := NewWebhook(s.repository)
+		output, err := service.CreateWebhook(s.ctx, WebhookCreateInput{URL: webhookURL, Secret: webhookSecret})
+		s.Require().NoError(err)
+		s.Equal(webhookSecret, output.Secret)
+	})
+
+	s.Run("invalid webhook url", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+
+		service := NewWebhook(s.repository)
+		_, err := service.CreateWebhook(s.ctx, WebhookCreateInput{URL: "ftp://hooks.example.com"})
+		s.Require().ErrorIs(err, application.InvalidWebhook)
+
+		_, err = service.CreateWebhook(s.ctx, WebhookCreateInput{URL: "/relative"})
+		s.Require().ErrorIs(err, application.InvalidWebhook)
+	})
+
+	s.Run("invalid webhook status", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+
+		service := NewWebhook(s.repository)
+		_, err := service.CreateWebhook(s.ctx, WebhookCreateInput{URL: webhookURL, Statuses: []string{"DONE"}})
+		s.Require().ErrorIs(err, application.InvalidWebhook)
+	})
+
+	s.Run("fail db create webhook", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			CreateWebhook(mock.Anything, workspaceIDUUID, mock.Anything).
+			Return(nil, errors.New("some error"))
+
+		service := NewWebhook(s.repository)
+		_, err := service.CreateWebhook(s.ctx, WebhookCreateInput{URL: webhookURL})
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+	})
+}
+
+func (s *WebhookTestSuite) TestUpdateWebhook() {
+	s.Run("successful update webhook keeping omitted fields", func() {
+		active := false
+
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetWebhookByExternalID(mock.Anything, workspaceIDUUID, webhookExternalIDUUID).
+			Return(&repository.WebhookOutput{
+				ExternalID: webhookExternalIDUUID,
+				URL:        webhookURL,
+				Secret:     webhookSecret,
+				Statuses:   []string{"SUMMARIZED"},
+				Active:     true,
+			}, nil)
+		s.repository.EXPECT().
+			UpdateWebhook(mock.Anything, workspaceIDUUID, repository.WebhookUpdateInput{
+				ExternalID: webhookExternalIDUUID,
+				URL:        webhookURL,
+				Statuses:   []string{"SUMMARIZED"},
+				Active:     false,
+			}).
+			Return(&repository.WebhookOutput{
+				ExternalID: webhookExternalIDUUID,
+				URL:        webhookURL,
+				Secret:     webhookSecret,
+				Statuses:   []string{"SUMMARIZED"},
+				Active:     false,
+			}, nil)
+
+		service := NewWebhook(s.repository)
+		output, err := service.UpdateWebhook(s.ctx, webhookExternalIDUUID, WebhookUpdateInput{Active: &active})
+		s.Require().NoError(err)
+		s.False(output.Active)
+		s.Empty(output.Secret)
+	})
+
+	s.Run("invalid webhook url on update", func() {
+		invalidURL := "not a url"
+
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetWebhookByExternalID(mock.Anything, workspaceIDUUID, webhookExternalIDUUID).
+			Return(&repository.WebhookOutput{ExternalID: webhookExternalIDUUID, URL: webhookURL}, nil)
+
+		service := NewWebhook(s.repository)
+		_, err := service.UpdateWebhook(s.ctx, webhookExternalIDUUID, WebhookUpdateInput{URL: &invalidURL})
+		s.Require().ErrorIs(err, application.InvalidWebhook)
+	})
+
+	s.Run("update webhook not found", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetWebhookByExternalID(mock.Anything, workspaceIDUUID, webhookExternalIDUUID).
+			Return(nil, application.WebhookNotFound)
+
+		service := NewWebhook(s.repository)
+		_, err := service.UpdateWebhook(s.ctx, webhookExternalIDUUID, WebhookUpdateInput{})
+		s.Require().ErrorIs(err, application.WebhookNotFound)
+	})
+}
+
+func (s *WebhookTestSuite) TestListWebhooks() {
+	s.Run("successful list webhooks hiding secrets", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetWebhooks(mock.Anything, workspaceIDUUID).
+			Return([]repository.WebhookOutput{
+				{ExternalID: webhookExternalIDUUID, URL: webhookURL, Secret: webhookSecret, Active: true},
+			}, nil)
+
+		service := NewWebhook(s.repository)
+		output, err := service.ListWebhooks(s.ctx)
+		s.Require().NoError(err)
+		s.Len(output.Data, 1)
+		s.Equal(webhookURL, output.Data[0].URL)
+		s.Empty(output.Data[0].Secret)
+	})
+
+	s.Run("fail list webhooks", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetWebhooks(mock.Anything, workspaceIDUUID).
+			Return(nil, errors.New("some error"))
+
+		service := NewWebhook(s.repository)
+		_, err := service.ListWebhooks(s.ctx)
+		s.Require().Error(err)
+	})
+}
+
+func (s *WebhookTestSuite) TestDeleteWebhook() {
+	s.Run("successful delete webhook", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			DeleteWebhookByExternalID(mock.Anything, workspaceIDUUID, webhookExternalIDUUID).
+			Return(nil)
+
+		service := NewWebhook(s.repository)
+		s.Require().NoError(service.DeleteWebhookByExternalID(s.ctx, webhookExternalIDUUID))
+	})
+
+	s.Run("delete webhook not found", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			DeleteWebhookByExternalID(mock.Anything, workspaceIDUUID, webhookExternalIDUUID).
+			Return(application.WebhookNotFound)
+
+		service := NewWebhook(s.repository)
+		err := service.DeleteWebhookByExternalID(s.ctx, webhookExternalIDUUID)
+		s.Require().ErrorIs(err, application.WebhookNotFound)
+	})
+}
+
+func (s *WebhookTestSuite) TestWebhookRoles() {
+	viewer := principal
+	viewer.Role = auth.RoleViewer
+	viewerCtx := auth.WithPrincipal(context.Background(), viewer)
+
+	s.Run("viewer can not manage webhooks or read deliveries", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		service := NewWebhookAuthorization(NewWebhook(s.repository))
+
+		_, err := service.CreateWebhook(viewerCtx, WebhookCreateInput{URL: webhookURL})
+		s.ErrorIs(err, application.Forbidden)
+
+		url := "https://attacker.example.com"
+		_, err = service.UpdateWebhook(viewerCtx, webhookExternalIDUUID, WebhookUpdateInput{URL: &url})
+		s.ErrorIs(err, application.Forbidden)
+
+		err = service.DeleteWebhookByExternalID(viewerCtx, webhookExternalIDUUID)
+		s.ErrorIs(err, application.Forbidden)
+
+		_, err = service.ListWebhookDeliveries(viewerCtx, webhookExternalIDUUID)
+		s.ErrorIs(err, application.Forbidden)
+
+		s.repository.AssertNotCalled(s.T(), "CreateWebhook", mock.Anything, mock.Anything, mock.Anything)
+		s.repository.AssertNotCalled(s.T(), "UpdateWebhook", mock.Anything, mock.Anything, mock.Anything)
+		s.repository.AssertNotCalled(s.T(), "DeleteWebhookByExternalID", mock.Anything, mock.Anything, mock.Anything)
+		s.repository.AssertNotCalled(s.T(), "GetWebhookDeliveries", mock.Anything, mock.Anything)
+	})
+
+	s.Run("viewer can list webhooks", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetWebhooks(mock.Anything, workspaceIDUUID).
+			Return([]repository.WebhookOutput{}, nil)
+
+		output, err := NewWebhookAuthorization(NewWebhook(s.repository)).ListWebhooks(viewerCtx)
+		s.Require().NoError(err)
+		s.Empty(output.Data)
+	})
+}
+
+func (s *WebhookTestSuite) TestListWebhookDeliveries() {
+	s.Run("successful list webhook deliveries", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetWebhookByExternalID(mock.Anything, workspaceIDUUID, webhookExternalIDUUID).
+			Return(&repository.WebhookOutput{ExternalID: webhookExternalIDUUID}, nil)
+		s.repository.EXPECT().
+			GetWebhookDeliveries(mock.Anything, webhookExternalIDUUID).
+			Return([]repository.WebhookDeliveryOutput{
+				{
+					EventType:  "summary.status_changed",
+					Attempt:    2,
+					StatusCode: sql.NullInt32{Int32: 500, Valid: true},
+					Error:      sql.NullString{String: "unexpected status Internal Server Error", Valid: true},
+				},
+			}, nil)
+
+		service := NewWebhook(s.repository)
+		output, err := service.ListWebhookDeliveries(s.ctx, webhookExternalIDUUID)
+		s.Require().NoError(err)
+		s.Len(output.Data, 1)
+		s.Equal(2, output.Data[0].Attempt)
+		s.Equal(500, output.Data[0].StatusCode)
+		s.False(output.Data[0].Delivered)
+	})
+
+	s.Run("list deliveries of unknown webhook", func() {
+		s.repository = new(gatewaymocks.WebhookRepository)
+		s.repository.EXPECT().
+			GetWebhookByExternalID(mock.Anything, workspaceIDUUID, webhookExternalIDUUID).
+			Return(nil, application.WebhookNotFound)
+
+		service := NewWebhook(s.repository)
+		_, err := service.ListWebhookDeliveries(s.ctx, webhookExternalIDUUID)
+		s.Require().ErrorIs(err, application.WebhookNotFound)
+	})
+}
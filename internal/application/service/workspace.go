@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+)
+
+const workspaceNameLength = 255
+
+type WorkspaceUseCase interface {
+	CreateWorkspace(ctx context.Context, input WorkspaceCreateInput) (*WorkspaceOutput, error)
+	ListWorkspaces(ctx context.Context) (*WorkspaceListOutput, error)
+	GetWorkspace(ctx context.Context, externalID uuid.UUID) (*WorkspaceOutput, error)
+}
+
+type Workspace struct {
+	repository repository.WorkspaceRepository
+}
+
+func NewWorkspace(repository repository.WorkspaceRepository) *Workspace {
+	return &Workspace{
+		repository: repository,
+	}
+}
+
+func (w *Workspace) CreateWorkspace(ctx context.Context, input WorkspaceCreateInput) (*WorkspaceOutput, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || len(name) > workspaceNameLength {
+		return nil, application.InvalidWorkspace
+	}
+
+	created, err := w.repository.CreateWorkspace(ctx, name)
+	if err != nil {
+		log.LogError(ctx, "failed to create workspace in db", err)
+		return nil, application.InternalDatabaseError
+	}
+
+	output := toWorkspaceOutput(*created)
+	return &output, nil
+}
+
+func (w *Workspace) ListWorkspaces(ctx context.Context) (*WorkspaceListOutput, error) {
+	workspaces, err := w.repository.GetWorkspaces(ctx)
+	if err != nil {
+		log.LogError(ctx, "error on list workspaces", err)
+		return nil, err
+	}
+
+	output := WorkspaceListOutput{Data: []WorkspaceOutput{}}
+	for _, workspace := range workspaces {
+		output.Data = append(output.Data, toWorkspaceOutput(workspace))
+	}
+
+	return &output, nil
+}
+
+func (w *Workspace) GetWorkspace(ctx context.Context, externalID uuid.UUID) (*WorkspaceOutput, error) {
+	workspace, err := w.repository.GetWorkspaceByExternalID(ctx, externalID)
+	if err == application.WorkspaceNotFound {
+		return nil, err
+	}
+
+	if err != nil {
+		log.LogError(ctx, "error on get workspace", err)
+		return nil, err
+	}
+
+	output := toWorkspaceOutput(*workspace)
+	return &output, nil
+}
+
+func toWorkspaceOutput(workspace repository.WorkspaceOutput) WorkspaceOutput {
+	return WorkspaceOutput{
+		ExternalID: workspace.ExternalID,
+		CreatedAt:  workspace.CreatedAt,
+		Name:       workspace.Name,
+	}
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	WorkspaceTestSuite struct {
+		suite.Suite
+
+		ctx        context.Context
+		repository *gatewaymocks.WorkspaceRepository
+	}
+)
+
+func TestWorkspaceTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkspaceTestSuite))
+}
+
+func (s *WorkspaceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *WorkspaceTestSuite) TestCreateWorkspace() {
+	s.Run("successful create workspace", func() {
+		s.repository = new(gatewaymocks.WorkspaceRepository)
+		s.repository.EXPECT().
+			CreateWorkspace(mock.Anything, "acme").
+			Return(&repository.WorkspaceOutput{ExternalID: workspaceIDUUID, CreatedAt: createdAt, Name: "acme"}, nil)
+
+		service := NewWorkspace(s.repository)
+		output, err := service.CreateWorkspace(s.ctx, WorkspaceCreateInput{Name: " acme "})
+		s.Require().NoError(err)
+		s.Equal(workspaceIDUUID, output.ExternalID)
+		s.Equal("acme", output.Name)
+	})
+
+	s.Run("invalid workspace name", func() {
+		service := NewWorkspace(new(gatewaymocks.WorkspaceRepository))
+		_, err := service.CreateWorkspace(s.ctx, WorkspaceCreateInput{Name: " "})
+		s.Require().ErrorIs(err, application.InvalidWorkspace)
+	})
+
+	s.Run("fail db create workspace", func() {
+		s.repository = new(gatewaymocks.WorkspaceRepository)
+		s.repository.EXPECT().
+			CreateWorkspace(mock.Anything, "acme").
+			Return(nil, errors.New("some error"))
+
+		service := NewWorkspace(s.repository)
+		_, err := service.CreateWorkspace(s.ctx, WorkspaceCreateInput{Name: "acme"})
+		s.Require().ErrorIs(err, application.InternalDatabaseError)
+	})
+}
+
+func (s *WorkspaceTestSuite) TestGetWorkspace() {
+	s.Run("successful list workspaces", func() {
+		s.repository = new(gatewaymocks.WorkspaceRepository)
+		s.repository.EXPECT().
+			GetWorkspaces(mock.Anything).
+			Return([]repository.WorkspaceOutput{{ExternalID: workspaceIDUUID, Name: "acme"}}, nil)
+
+		service := NewWorkspace(s.repository)
+		output, err := service.ListWorkspaces(s.ctx)
+		s.Require().NoError(err)
+		s.Len(output.Data, 1)
+	})
+
+	s.Run("workspace not found", func() {
+		s.repository = new(gatewaymocks.WorkspaceRepository)
+		s.repository.EXPECT().
+			GetWorkspaceByExternalID(mock.Anything, workspaceIDUUID).
+			Return(nil, application.WorkspaceNotFound)
+
+		service := NewWorkspace(s.repository)
+		_, err := service.GetWorkspace(s.ctx, workspaceIDUUID)
+		s.Require().ErrorIs(err, application.WorkspaceNotFound)
+	})
+}
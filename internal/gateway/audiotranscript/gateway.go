@@ -3,5 +3,5 @@ package audiotranscript
 import "context"
 
 type AudioTranscript interface {
-	Transcribe(ctx context.Context, audio []byte) (*string, error)
+	Transcribe(ctx context.Context, input TranscribeInput) (*TranscribeOutput, error)
 }
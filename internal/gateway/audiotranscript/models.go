@@ -0,0 +1,16 @@
+package audiotranscript
+
+import "io"
+
+type TranscribeInput struct {
+	Audio       io.Reader
+	Filename    string
+	ContentType string
+}
+
+type TranscribeOutput struct {
+	Text         string
+	Model        string
+	Provider     string
+	AudioSeconds float64
+}
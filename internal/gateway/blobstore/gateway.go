@@ -0,0 +1,13 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+)
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	Size(ctx context.Context, key string) (int64, error)
+	Get(ctx context.Context, key string, offset int64) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,7 @@
+package document
+
+import "context"
+
+type Renderer interface {
+	Render(ctx context.Context, format Format, input SummaryDocument) ([]byte, error)
+}
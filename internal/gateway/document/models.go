@@ -0,0 +1,44 @@
+package document
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Format string
+
+const (
+	Markdown  Format = "md"
+	HTML      Format = "html"
+	PlainText Format = "txt"
+	JSON      Format = "json"
+)
+
+type FormatDomain struct {
+	ContentType string
+	Extension   string
+}
+
+var (
+	Formats = map[Format]FormatDomain{
+		Markdown:  {"text/markdown; charset=utf-8", ".md"},
+		HTML:      {"text/html; charset=utf-8", ".html"},
+		PlainText: {"text/plain; charset=utf-8", ".txt"},
+		JSON:      {"application/json; charset=utf-8", ".json"},
+	}
+)
+
+type (
+	SummaryDocument struct {
+		ExternalID   uuid.UUID
+		Status       string
+		CreatedAt    time.Time
+		UpdatedAt    time.Time
+		Title        string
+		Description  string
+		BriefResume  string
+		MediumResume string
+		FullText     string
+	}
+)
@@ -0,0 +1,7 @@
+package download
+
+import "context"
+
+type Downloader interface {
+	Download(ctx context.Context, url string) (*DownloadOutput, error)
+}
@@ -0,0 +1,12 @@
+package download
+
+import "io"
+
+// DownloadOutput keeps the downloaded file out of memory. The caller must
+// close Content, which releases the file.
+type DownloadOutput struct {
+	Content     io.ReadSeekCloser
+	Size        int64
+	ContentType string
+	Filename    string
+}
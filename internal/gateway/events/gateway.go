@@ -0,0 +1,11 @@
+package events
+
+import "context"
+
+type Publisher interface {
+	Publish(ctx context.Context, event Event)
+}
+
+type Subscriber interface {
+	Subscribe(ctx context.Context) (<-chan Event, func())
+}
@@ -0,0 +1,43 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	SummaryStatusChanged  EventType = "summary.status_changed"
+	SummaryStatusSnapshot EventType = "summary.status_snapshot"
+	BudgetWarning         EventType = "budget.warning"
+)
+
+type (
+	Event struct {
+		ID         uuid.UUID    `json:"id"`
+		Type       EventType    `json:"type"`
+		OccurredAt time.Time    `json:"occurredAt"`
+		Summary    *SummaryData `json:"summary,omitempty"`
+		Budget     *BudgetData  `json:"budget,omitempty"`
+	}
+
+	SummaryData struct {
+		ExternalID     uuid.UUID `json:"externalId"`
+		WorkspaceID    uuid.UUID `json:"-"`
+		Status         string    `json:"status"`
+		PreviousStatus string    `json:"previousStatus,omitempty"`
+		Progress       int       `json:"progress"`
+	}
+
+	BudgetData struct {
+		WorkspaceID       uuid.UUID `json:"-"`
+		UserID            uuid.UUID `json:"userId"`
+		Period            string    `json:"period"`
+		AudioMinutesUsed  float64   `json:"audioMinutesUsed"`
+		AudioMinutesLimit float64   `json:"audioMinutesLimit,omitempty"`
+		TokensUsed        int64     `json:"tokensUsed"`
+		TokensLimit       int64     `json:"tokensLimit,omitempty"`
+	}
+)
@@ -0,0 +1,7 @@
+package identity
+
+import "context"
+
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (*Claims, error)
+}
@@ -0,0 +1,9 @@
+package identity
+
+type (
+	Claims struct {
+		Issuer  string
+		Subject string
+		Values  map[string]any
+	}
+)
@@ -0,0 +1,265 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package gatewaymocks
+
+import (
+	context "context"
+
+	repository "github.com/diegofsousa/explicAI/internal/gateway/repository"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// APIKeyRepository is an autogenerated mock type for the APIKeyRepository type
+type APIKeyRepository struct {
+	mock.Mock
+}
+
+type APIKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *APIKeyRepository) EXPECT() *APIKeyRepository_Expecter {
+	return &APIKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, workspaceID, input
+func (_m *APIKeyRepository) CreateAPIKey(ctx context.Context, workspaceID uuid.UUID, input repository.APIKeyCreateInput) (*repository.APIKeyOutput, error) {
+	ret := _m.Called(ctx, workspaceID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *repository.APIKeyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.APIKeyCreateInput) (*repository.APIKeyOutput, error)); ok {
+		return rf(ctx, workspaceID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.APIKeyCreateInput) *repository.APIKeyOutput); ok {
+		r0 = rf(ctx, workspaceID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.APIKeyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.APIKeyCreateInput) error); ok {
+		r1 = rf(ctx, workspaceID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepository_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type APIKeyRepository_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - input repository.APIKeyCreateInput
+func (_e *APIKeyRepository_Expecter) CreateAPIKey(ctx interface{}, workspaceID interface{}, input interface{}) *APIKeyRepository_CreateAPIKey_Call {
+	return &APIKeyRepository_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", ctx, workspaceID, input)}
+}
+
+func (_c *APIKeyRepository_CreateAPIKey_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, input repository.APIKeyCreateInput)) *APIKeyRepository_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.APIKeyCreateInput))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepository_CreateAPIKey_Call) Return(_a0 *repository.APIKeyOutput, _a1 error) *APIKeyRepository_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepository_CreateAPIKey_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.APIKeyCreateInput) (*repository.APIKeyOutput, error)) *APIKeyRepository_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKeys provides a mock function with given fields: ctx, workspaceID
+func (_m *APIKeyRepository) GetAPIKeys(ctx context.Context, workspaceID uuid.UUID) ([]repository.APIKeyOutput, error) {
+	ret := _m.Called(ctx, workspaceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeys")
+	}
+
+	var r0 []repository.APIKeyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]repository.APIKeyOutput, error)); ok {
+		return rf(ctx, workspaceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []repository.APIKeyOutput); ok {
+		r0 = rf(ctx, workspaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.APIKeyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, workspaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepository_GetAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKeys'
+type APIKeyRepository_GetAPIKeys_Call struct {
+	*mock.Call
+}
+
+// GetAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+func (_e *APIKeyRepository_Expecter) GetAPIKeys(ctx interface{}, workspaceID interface{}) *APIKeyRepository_GetAPIKeys_Call {
+	return &APIKeyRepository_GetAPIKeys_Call{Call: _e.mock.On("GetAPIKeys", ctx, workspaceID)}
+}
+
+func (_c *APIKeyRepository_GetAPIKeys_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID)) *APIKeyRepository_GetAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepository_GetAPIKeys_Call) Return(_a0 []repository.APIKeyOutput, _a1 error) *APIKeyRepository_GetAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepository_GetAPIKeys_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]repository.APIKeyOutput, error)) *APIKeyRepository_GetAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveAPIKeyByHash provides a mock function with given fields: ctx, keyHash
+func (_m *APIKeyRepository) GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*repository.APIKeyOutput, error) {
+	ret := _m.Called(ctx, keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveAPIKeyByHash")
+	}
+
+	var r0 *repository.APIKeyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*repository.APIKeyOutput, error)); ok {
+		return rf(ctx, keyHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *repository.APIKeyOutput); ok {
+		r0 = rf(ctx, keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.APIKeyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepository_GetActiveAPIKeyByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveAPIKeyByHash'
+type APIKeyRepository_GetActiveAPIKeyByHash_Call struct {
+	*mock.Call
+}
+
+// GetActiveAPIKeyByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyHash string
+func (_e *APIKeyRepository_Expecter) GetActiveAPIKeyByHash(ctx interface{}, keyHash interface{}) *APIKeyRepository_GetActiveAPIKeyByHash_Call {
+	return &APIKeyRepository_GetActiveAPIKeyByHash_Call{Call: _e.mock.On("GetActiveAPIKeyByHash", ctx, keyHash)}
+}
+
+func (_c *APIKeyRepository_GetActiveAPIKeyByHash_Call) Run(run func(ctx context.Context, keyHash string)) *APIKeyRepository_GetActiveAPIKeyByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepository_GetActiveAPIKeyByHash_Call) Return(_a0 *repository.APIKeyOutput, _a1 error) *APIKeyRepository_GetActiveAPIKeyByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepository_GetActiveAPIKeyByHash_Call) RunAndReturn(run func(context.Context, string) (*repository.APIKeyOutput, error)) *APIKeyRepository_GetActiveAPIKeyByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKeyByExternalID provides a mock function with given fields: ctx, workspaceID, externalID
+func (_m *APIKeyRepository) RevokeAPIKeyByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) error {
+	ret := _m.Called(ctx, workspaceID, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKeyByExternalID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, workspaceID, externalID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// APIKeyRepository_RevokeAPIKeyByExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKeyByExternalID'
+type APIKeyRepository_RevokeAPIKeyByExternalID_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKeyByExternalID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - externalID uuid.UUID
+func (_e *APIKeyRepository_Expecter) RevokeAPIKeyByExternalID(ctx interface{}, workspaceID interface{}, externalID interface{}) *APIKeyRepository_RevokeAPIKeyByExternalID_Call {
+	return &APIKeyRepository_RevokeAPIKeyByExternalID_Call{Call: _e.mock.On("RevokeAPIKeyByExternalID", ctx, workspaceID, externalID)}
+}
+
+func (_c *APIKeyRepository_RevokeAPIKeyByExternalID_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID)) *APIKeyRepository_RevokeAPIKeyByExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepository_RevokeAPIKeyByExternalID_Call) Return(_a0 error) *APIKeyRepository_RevokeAPIKeyByExternalID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *APIKeyRepository_RevokeAPIKeyByExternalID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *APIKeyRepository_RevokeAPIKeyByExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAPIKeyRepository creates a new instance of APIKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyRepository {
+	mock := &APIKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
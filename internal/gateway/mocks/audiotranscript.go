@@ -5,6 +5,8 @@ package gatewaymocks
 import (
 	context "context"
 
+	audiotranscript "github.com/diegofsousa/explicAI/internal/gateway/audiotranscript"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,29 +23,29 @@ func (_m *AudioTranscript) EXPECT() *AudioTranscript_Expecter {
 	return &AudioTranscript_Expecter{mock: &_m.Mock}
 }
 
-// Transcribe provides a mock function with given fields: ctx, audio
-func (_m *AudioTranscript) Transcribe(ctx context.Context, audio []byte) (*string, error) {
-	ret := _m.Called(ctx, audio)
+// Transcribe provides a mock function with given fields: ctx, input
+func (_m *AudioTranscript) Transcribe(ctx context.Context, input audiotranscript.TranscribeInput) (*audiotranscript.TranscribeOutput, error) {
+	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Transcribe")
 	}
 
-	var r0 *string
+	var r0 *audiotranscript.TranscribeOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []byte) (*string, error)); ok {
-		return rf(ctx, audio)
+	if rf, ok := ret.Get(0).(func(context.Context, audiotranscript.TranscribeInput) (*audiotranscript.TranscribeOutput, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []byte) *string); ok {
-		r0 = rf(ctx, audio)
+	if rf, ok := ret.Get(0).(func(context.Context, audiotranscript.TranscribeInput) *audiotranscript.TranscribeOutput); ok {
+		r0 = rf(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*string)
+			r0 = ret.Get(0).(*audiotranscript.TranscribeOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
-		r1 = rf(ctx, audio)
+	if rf, ok := ret.Get(1).(func(context.Context, audiotranscript.TranscribeInput) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,24 +60,24 @@ type AudioTranscript_Transcribe_Call struct {
 
 // Transcribe is a helper method to define mock.On call
 //   - ctx context.Context
-//   - audio []byte
-func (_e *AudioTranscript_Expecter) Transcribe(ctx interface{}, audio interface{}) *AudioTranscript_Transcribe_Call {
-	return &AudioTranscript_Transcribe_Call{Call: _e.mock.On("Transcribe", ctx, audio)}
+//   - input audiotranscript.TranscribeInput
+func (_e *AudioTranscript_Expecter) Transcribe(ctx interface{}, input interface{}) *AudioTranscript_Transcribe_Call {
+	return &AudioTranscript_Transcribe_Call{Call: _e.mock.On("Transcribe", ctx, input)}
 }
 
-func (_c *AudioTranscript_Transcribe_Call) Run(run func(ctx context.Context, audio []byte)) *AudioTranscript_Transcribe_Call {
+func (_c *AudioTranscript_Transcribe_Call) Run(run func(ctx context.Context, input audiotranscript.TranscribeInput)) *AudioTranscript_Transcribe_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]byte))
+		run(args[0].(context.Context), args[1].(audiotranscript.TranscribeInput))
 	})
 	return _c
 }
 
-func (_c *AudioTranscript_Transcribe_Call) Return(_a0 *string, _a1 error) *AudioTranscript_Transcribe_Call {
+func (_c *AudioTranscript_Transcribe_Call) Return(_a0 *audiotranscript.TranscribeOutput, _a1 error) *AudioTranscript_Transcribe_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AudioTranscript_Transcribe_Call) RunAndReturn(run func(context.Context, []byte) (*string, error)) *AudioTranscript_Transcribe_Call {
+func (_c *AudioTranscript_Transcribe_Call) RunAndReturn(run func(context.Context, audiotranscript.TranscribeInput) (*audiotranscript.TranscribeOutput, error)) *AudioTranscript_Transcribe_Call {
 	_c.Call.Return(run)
 	return _c
 }
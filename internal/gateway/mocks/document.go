@@ -0,0 +1,97 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package gatewaymocks
+
+import (
+	context "context"
+
+	document "github.com/diegofsousa/explicAI/internal/gateway/document"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Renderer is an autogenerated mock type for the Renderer type
+type Renderer struct {
+	mock.Mock
+}
+
+type Renderer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Renderer) EXPECT() *Renderer_Expecter {
+	return &Renderer_Expecter{mock: &_m.Mock}
+}
+
+// Render provides a mock function with given fields: ctx, format, input
+func (_m *Renderer) Render(ctx context.Context, format document.Format, input document.SummaryDocument) ([]byte, error) {
+	ret := _m.Called(ctx, format, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Render")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, document.Format, document.SummaryDocument) ([]byte, error)); ok {
+		return rf(ctx, format, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, document.Format, document.SummaryDocument) []byte); ok {
+		r0 = rf(ctx, format, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, document.Format, document.SummaryDocument) error); ok {
+		r1 = rf(ctx, format, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Renderer_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type Renderer_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - ctx context.Context
+//   - format document.Format
+//   - input document.SummaryDocument
+func (_e *Renderer_Expecter) Render(ctx interface{}, format interface{}, input interface{}) *Renderer_Render_Call {
+	return &Renderer_Render_Call{Call: _e.mock.On("Render", ctx, format, input)}
+}
+
+func (_c *Renderer_Render_Call) Run(run func(ctx context.Context, format document.Format, input document.SummaryDocument)) *Renderer_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(document.Format), args[2].(document.SummaryDocument))
+	})
+	return _c
+}
+
+func (_c *Renderer_Render_Call) Return(_a0 []byte, _a1 error) *Renderer_Render_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Renderer_Render_Call) RunAndReturn(run func(context.Context, document.Format, document.SummaryDocument) ([]byte, error)) *Renderer_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRenderer creates a new instance of Renderer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRenderer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Renderer {
+	mock := &Renderer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
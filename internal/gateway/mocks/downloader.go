@@ -0,0 +1,96 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package gatewaymocks
+
+import (
+	context "context"
+
+	download "github.com/diegofsousa/explicAI/internal/gateway/download"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Downloader is an autogenerated mock type for the Downloader type
+type Downloader struct {
+	mock.Mock
+}
+
+type Downloader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Downloader) EXPECT() *Downloader_Expecter {
+	return &Downloader_Expecter{mock: &_m.Mock}
+}
+
+// Download provides a mock function with given fields: ctx, url
+func (_m *Downloader) Download(ctx context.Context, url string) (*download.DownloadOutput, error) {
+	ret := _m.Called(ctx, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Download")
+	}
+
+	var r0 *download.DownloadOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*download.DownloadOutput, error)); ok {
+		return rf(ctx, url)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *download.DownloadOutput); ok {
+		r0 = rf(ctx, url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*download.DownloadOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Downloader_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
+type Downloader_Download_Call struct {
+	*mock.Call
+}
+
+// Download is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+func (_e *Downloader_Expecter) Download(ctx interface{}, url interface{}) *Downloader_Download_Call {
+	return &Downloader_Download_Call{Call: _e.mock.On("Download", ctx, url)}
+}
+
+func (_c *Downloader_Download_Call) Run(run func(ctx context.Context, url string)) *Downloader_Download_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Downloader_Download_Call) Return(_a0 *download.DownloadOutput, _a1 error) *Downloader_Download_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Downloader_Download_Call) RunAndReturn(run func(context.Context, string) (*download.DownloadOutput, error)) *Downloader_Download_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDownloader creates a new instance of Downloader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDownloader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Downloader {
+	mock := &Downloader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
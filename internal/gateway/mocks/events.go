@@ -0,0 +1,71 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package gatewaymocks
+
+import (
+	context "context"
+
+	events "github.com/diegofsousa/explicAI/internal/gateway/events"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Publisher is an autogenerated mock type for the Publisher type
+type Publisher struct {
+	mock.Mock
+}
+
+type Publisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Publisher) EXPECT() *Publisher_Expecter {
+	return &Publisher_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: ctx, event
+func (_m *Publisher) Publish(ctx context.Context, event events.Event) {
+	_m.Called(ctx, event)
+}
+
+// Publisher_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type Publisher_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event events.Event
+func (_e *Publisher_Expecter) Publish(ctx interface{}, event interface{}) *Publisher_Publish_Call {
+	return &Publisher_Publish_Call{Call: _e.mock.On("Publish", ctx, event)}
+}
+
+func (_c *Publisher_Publish_Call) Run(run func(ctx context.Context, event events.Event)) *Publisher_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(events.Event))
+	})
+	return _c
+}
+
+func (_c *Publisher_Publish_Call) Return() *Publisher_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Publisher_Publish_Call) RunAndReturn(run func(context.Context, events.Event)) *Publisher_Publish_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewPublisher creates a new instance of Publisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Publisher {
+	mock := &Publisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
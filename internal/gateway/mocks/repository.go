@@ -24,9 +24,9 @@ func (_m *Repository) EXPECT() *Repository_Expecter {
 	return &Repository_Expecter{mock: &_m.Mock}
 }
 
-// CreateSummary provides a mock function with given fields: ctx, status
-func (_m *Repository) CreateSummary(ctx context.Context, status repository.Status) (*repository.SummaryCreateOutput, error) {
-	ret := _m.Called(ctx, status)
+// CreateSummary provides a mock function with given fields: ctx, workspaceID, input
+func (_m *Repository) CreateSummary(ctx context.Context, workspaceID uuid.UUID, input repository.SummaryCreateInput) (*repository.SummaryCreateOutput, error) {
+	ret := _m.Called(ctx, workspaceID, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateSummary")
@@ -34,19 +34,19 @@ func (_m *Repository) CreateSummary(ctx context.Context, status repository.Statu
 
 	var r0 *repository.SummaryCreateOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.Status) (*repository.SummaryCreateOutput, error)); ok {
-		return rf(ctx, status)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.SummaryCreateInput) (*repository.SummaryCreateOutput, error)); ok {
+		return rf(ctx, workspaceID, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, repository.Status) *repository.SummaryCreateOutput); ok {
-		r0 = rf(ctx, status)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.SummaryCreateInput) *repository.SummaryCreateOutput); ok {
+		r0 = rf(ctx, workspaceID, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*repository.SummaryCreateOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, repository.Status) error); ok {
-		r1 = rf(ctx, status)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.SummaryCreateInput) error); ok {
+		r1 = rf(ctx, workspaceID, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,14 +61,15 @@ type Repository_CreateSummary_Call struct {
 
 // CreateSummary is a helper method to define mock.On call
 //   - ctx context.Context
-//   - status repository.Status
-func (_e *Repository_Expecter) CreateSummary(ctx interface{}, status interface{}) *Repository_CreateSummary_Call {
-	return &Repository_CreateSummary_Call{Call: _e.mock.On("CreateSummary", ctx, status)}
+//   - workspaceID uuid.UUID
+//   - input repository.SummaryCreateInput
+func (_e *Repository_Expecter) CreateSummary(ctx interface{}, workspaceID interface{}, input interface{}) *Repository_CreateSummary_Call {
+	return &Repository_CreateSummary_Call{Call: _e.mock.On("CreateSummary", ctx, workspaceID, input)}
 }
 
-func (_c *Repository_CreateSummary_Call) Run(run func(ctx context.Context, status repository.Status)) *Repository_CreateSummary_Call {
+func (_c *Repository_CreateSummary_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, input repository.SummaryCreateInput)) *Repository_CreateSummary_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.Status))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.SummaryCreateInput))
 	})
 	return _c
 }
@@ -78,22 +79,22 @@ func (_c *Repository_CreateSummary_Call) Return(_a0 *repository.SummaryCreateOut
 	return _c
 }
 
-func (_c *Repository_CreateSummary_Call) RunAndReturn(run func(context.Context, repository.Status) (*repository.SummaryCreateOutput, error)) *Repository_CreateSummary_Call {
+func (_c *Repository_CreateSummary_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.SummaryCreateInput) (*repository.SummaryCreateOutput, error)) *Repository_CreateSummary_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteSummaryByExternalID provides a mock function with given fields: ctx, externalID
-func (_m *Repository) DeleteSummaryByExternalID(ctx context.Context, externalID uuid.UUID) error {
-	ret := _m.Called(ctx, externalID)
+// DeleteSummaryByExternalID provides a mock function with given fields: ctx, workspaceID, externalID
+func (_m *Repository) DeleteSummaryByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) error {
+	ret := _m.Called(ctx, workspaceID, externalID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteSummaryByExternalID")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, externalID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, workspaceID, externalID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -108,14 +109,15 @@ type Repository_DeleteSummaryByExternalID_Call struct {
 
 // DeleteSummaryByExternalID is a helper method to define mock.On call
 //   - ctx context.Context
+//   - workspaceID uuid.UUID
 //   - externalID uuid.UUID
-func (_e *Repository_Expecter) DeleteSummaryByExternalID(ctx interface{}, externalID interface{}) *Repository_DeleteSummaryByExternalID_Call {
-	return &Repository_DeleteSummaryByExternalID_Call{Call: _e.mock.On("DeleteSummaryByExternalID", ctx, externalID)}
+func (_e *Repository_Expecter) DeleteSummaryByExternalID(ctx interface{}, workspaceID interface{}, externalID interface{}) *Repository_DeleteSummaryByExternalID_Call {
+	return &Repository_DeleteSummaryByExternalID_Call{Call: _e.mock.On("DeleteSummaryByExternalID", ctx, workspaceID, externalID)}
 }
 
-func (_c *Repository_DeleteSummaryByExternalID_Call) Run(run func(ctx context.Context, externalID uuid.UUID)) *Repository_DeleteSummaryByExternalID_Call {
+func (_c *Repository_DeleteSummaryByExternalID_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID)) *Repository_DeleteSummaryByExternalID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
@@ -125,14 +127,14 @@ func (_c *Repository_DeleteSummaryByExternalID_Call) Return(_a0 error) *Reposito
 	return _c
 }
 
-func (_c *Repository_DeleteSummaryByExternalID_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *Repository_DeleteSummaryByExternalID_Call {
+func (_c *Repository_DeleteSummaryByExternalID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *Repository_DeleteSummaryByExternalID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSummaries provides a mock function with given fields: ctx
-func (_m *Repository) GetSummaries(ctx context.Context) ([]repository.SummaryOutput, error) {
-	ret := _m.Called(ctx)
+// GetSummaries provides a mock function with given fields: ctx, workspaceID, filter
+func (_m *Repository) GetSummaries(ctx context.Context, workspaceID uuid.UUID, filter repository.SummaryFilter) ([]repository.SummaryOutput, error) {
+	ret := _m.Called(ctx, workspaceID, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetSummaries")
@@ -140,19 +142,19 @@ func (_m *Repository) GetSummaries(ctx context.Context) ([]repository.SummaryOut
 
 	var r0 []repository.SummaryOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.SummaryOutput, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.SummaryFilter) ([]repository.SummaryOutput, error)); ok {
+		return rf(ctx, workspaceID, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []repository.SummaryOutput); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.SummaryFilter) []repository.SummaryOutput); ok {
+		r0 = rf(ctx, workspaceID, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]repository.SummaryOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.SummaryFilter) error); ok {
+		r1 = rf(ctx, workspaceID, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -167,13 +169,15 @@ type Repository_GetSummaries_Call struct {
 
 // GetSummaries is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *Repository_Expecter) GetSummaries(ctx interface{}) *Repository_GetSummaries_Call {
-	return &Repository_GetSummaries_Call{Call: _e.mock.On("GetSummaries", ctx)}
+//   - workspaceID uuid.UUID
+//   - filter repository.SummaryFilter
+func (_e *Repository_Expecter) GetSummaries(ctx interface{}, workspaceID interface{}, filter interface{}) *Repository_GetSummaries_Call {
+	return &Repository_GetSummaries_Call{Call: _e.mock.On("GetSummaries", ctx, workspaceID, filter)}
 }
 
-func (_c *Repository_GetSummaries_Call) Run(run func(ctx context.Context)) *Repository_GetSummaries_Call {
+func (_c *Repository_GetSummaries_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, filter repository.SummaryFilter)) *Repository_GetSummaries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.SummaryFilter))
 	})
 	return _c
 }
@@ -183,14 +187,74 @@ func (_c *Repository_GetSummaries_Call) Return(_a0 []repository.SummaryOutput, _
 	return _c
 }
 
-func (_c *Repository_GetSummaries_Call) RunAndReturn(run func(context.Context) ([]repository.SummaryOutput, error)) *Repository_GetSummaries_Call {
+func (_c *Repository_GetSummaries_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.SummaryFilter) ([]repository.SummaryOutput, error)) *Repository_GetSummaries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSummaryByExternalID provides a mock function with given fields: ctx, externalID
-func (_m *Repository) GetSummaryByExternalID(ctx context.Context, externalID uuid.UUID) (*repository.SummaryOutput, error) {
-	ret := _m.Called(ctx, externalID)
+// GetSummaryByAudioHash provides a mock function with given fields: ctx, workspaceID, audioHash
+func (_m *Repository) GetSummaryByAudioHash(ctx context.Context, workspaceID uuid.UUID, audioHash string) (*repository.SummaryOutput, error) {
+	ret := _m.Called(ctx, workspaceID, audioHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSummaryByAudioHash")
+	}
+
+	var r0 *repository.SummaryOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*repository.SummaryOutput, error)); ok {
+		return rf(ctx, workspaceID, audioHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *repository.SummaryOutput); ok {
+		r0 = rf(ctx, workspaceID, audioHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.SummaryOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, workspaceID, audioHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_GetSummaryByAudioHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSummaryByAudioHash'
+type Repository_GetSummaryByAudioHash_Call struct {
+	*mock.Call
+}
+
+// GetSummaryByAudioHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - audioHash string
+func (_e *Repository_Expecter) GetSummaryByAudioHash(ctx interface{}, workspaceID interface{}, audioHash interface{}) *Repository_GetSummaryByAudioHash_Call {
+	return &Repository_GetSummaryByAudioHash_Call{Call: _e.mock.On("GetSummaryByAudioHash", ctx, workspaceID, audioHash)}
+}
+
+func (_c *Repository_GetSummaryByAudioHash_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, audioHash string)) *Repository_GetSummaryByAudioHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_GetSummaryByAudioHash_Call) Return(_a0 *repository.SummaryOutput, _a1 error) *Repository_GetSummaryByAudioHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_GetSummaryByAudioHash_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*repository.SummaryOutput, error)) *Repository_GetSummaryByAudioHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSummaryByExternalID provides a mock function with given fields: ctx, workspaceID, externalID
+func (_m *Repository) GetSummaryByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) (*repository.SummaryOutput, error) {
+	ret := _m.Called(ctx, workspaceID, externalID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetSummaryByExternalID")
@@ -198,19 +262,19 @@ func (_m *Repository) GetSummaryByExternalID(ctx context.Context, externalID uui
 
 	var r0 *repository.SummaryOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*repository.SummaryOutput, error)); ok {
-		return rf(ctx, externalID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*repository.SummaryOutput, error)); ok {
+		return rf(ctx, workspaceID, externalID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *repository.SummaryOutput); ok {
-		r0 = rf(ctx, externalID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *repository.SummaryOutput); ok {
+		r0 = rf(ctx, workspaceID, externalID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*repository.SummaryOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, externalID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, workspaceID, externalID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -225,14 +289,15 @@ type Repository_GetSummaryByExternalID_Call struct {
 
 // GetSummaryByExternalID is a helper method to define mock.On call
 //   - ctx context.Context
+//   - workspaceID uuid.UUID
 //   - externalID uuid.UUID
-func (_e *Repository_Expecter) GetSummaryByExternalID(ctx interface{}, externalID interface{}) *Repository_GetSummaryByExternalID_Call {
-	return &Repository_GetSummaryByExternalID_Call{Call: _e.mock.On("GetSummaryByExternalID", ctx, externalID)}
+func (_e *Repository_Expecter) GetSummaryByExternalID(ctx interface{}, workspaceID interface{}, externalID interface{}) *Repository_GetSummaryByExternalID_Call {
+	return &Repository_GetSummaryByExternalID_Call{Call: _e.mock.On("GetSummaryByExternalID", ctx, workspaceID, externalID)}
 }
 
-func (_c *Repository_GetSummaryByExternalID_Call) Run(run func(ctx context.Context, externalID uuid.UUID)) *Repository_GetSummaryByExternalID_Call {
+func (_c *Repository_GetSummaryByExternalID_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID)) *Repository_GetSummaryByExternalID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
@@ -242,22 +307,119 @@ func (_c *Repository_GetSummaryByExternalID_Call) Return(_a0 *repository.Summary
 	return _c
 }
 
-func (_c *Repository_GetSummaryByExternalID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*repository.SummaryOutput, error)) *Repository_GetSummaryByExternalID_Call {
+func (_c *Repository_GetSummaryByExternalID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*repository.SummaryOutput, error)) *Repository_GetSummaryByExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamSummaries provides a mock function with given fields: ctx, workspaceID, filter, fn
+func (_m *Repository) StreamSummaries(ctx context.Context, workspaceID uuid.UUID, filter repository.SummaryFilter, fn func(repository.SummaryOutput) error) error {
+	ret := _m.Called(ctx, workspaceID, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamSummaries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.SummaryFilter, func(repository.SummaryOutput) error) error); ok {
+		r0 = rf(ctx, workspaceID, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_StreamSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamSummaries'
+type Repository_StreamSummaries_Call struct {
+	*mock.Call
+}
+
+// StreamSummaries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - filter repository.SummaryFilter
+//   - fn func(repository.SummaryOutput) error
+func (_e *Repository_Expecter) StreamSummaries(ctx interface{}, workspaceID interface{}, filter interface{}, fn interface{}) *Repository_StreamSummaries_Call {
+	return &Repository_StreamSummaries_Call{Call: _e.mock.On("StreamSummaries", ctx, workspaceID, filter, fn)}
+}
+
+func (_c *Repository_StreamSummaries_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, filter repository.SummaryFilter, fn func(repository.SummaryOutput) error)) *Repository_StreamSummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.SummaryFilter), args[3].(func(repository.SummaryOutput) error))
+	})
+	return _c
+}
+
+func (_c *Repository_StreamSummaries_Call) Return(_a0 error) *Repository_StreamSummaries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_StreamSummaries_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.SummaryFilter, func(repository.SummaryOutput) error) error) *Repository_StreamSummaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSummaryDownloaded provides a mock function with given fields: ctx, workspaceID, input
+func (_m *Repository) UpdateSummaryDownloaded(ctx context.Context, workspaceID uuid.UUID, input repository.SummaryUpdateDownloadedInput) error {
+	ret := _m.Called(ctx, workspaceID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSummaryDownloaded")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.SummaryUpdateDownloadedInput) error); ok {
+		r0 = rf(ctx, workspaceID, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_UpdateSummaryDownloaded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSummaryDownloaded'
+type Repository_UpdateSummaryDownloaded_Call struct {
+	*mock.Call
+}
+
+// UpdateSummaryDownloaded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - input repository.SummaryUpdateDownloadedInput
+func (_e *Repository_Expecter) UpdateSummaryDownloaded(ctx interface{}, workspaceID interface{}, input interface{}) *Repository_UpdateSummaryDownloaded_Call {
+	return &Repository_UpdateSummaryDownloaded_Call{Call: _e.mock.On("UpdateSummaryDownloaded", ctx, workspaceID, input)}
+}
+
+func (_c *Repository_UpdateSummaryDownloaded_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, input repository.SummaryUpdateDownloadedInput)) *Repository_UpdateSummaryDownloaded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.SummaryUpdateDownloadedInput))
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateSummaryDownloaded_Call) Return(_a0 error) *Repository_UpdateSummaryDownloaded_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_UpdateSummaryDownloaded_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.SummaryUpdateDownloadedInput) error) *Repository_UpdateSummaryDownloaded_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateSummarySummarized provides a mock function with given fields: ctx, input
-func (_m *Repository) UpdateSummarySummarized(ctx context.Context, input repository.SummaryUpdateSummarizedInput) error {
-	ret := _m.Called(ctx, input)
+// UpdateSummarySummarized provides a mock function with given fields: ctx, workspaceID, input
+func (_m *Repository) UpdateSummarySummarized(ctx context.Context, workspaceID uuid.UUID, input repository.SummaryUpdateSummarizedInput) error {
+	ret := _m.Called(ctx, workspaceID, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateSummarySummarized")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.SummaryUpdateSummarizedInput) error); ok {
-		r0 = rf(ctx, input)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.SummaryUpdateSummarizedInput) error); ok {
+		r0 = rf(ctx, workspaceID, input)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -272,14 +434,15 @@ type Repository_UpdateSummarySummarized_Call struct {
 
 // UpdateSummarySummarized is a helper method to define mock.On call
 //   - ctx context.Context
+//   - workspaceID uuid.UUID
 //   - input repository.SummaryUpdateSummarizedInput
-func (_e *Repository_Expecter) UpdateSummarySummarized(ctx interface{}, input interface{}) *Repository_UpdateSummarySummarized_Call {
-	return &Repository_UpdateSummarySummarized_Call{Call: _e.mock.On("UpdateSummarySummarized", ctx, input)}
+func (_e *Repository_Expecter) UpdateSummarySummarized(ctx interface{}, workspaceID interface{}, input interface{}) *Repository_UpdateSummarySummarized_Call {
+	return &Repository_UpdateSummarySummarized_Call{Call: _e.mock.On("UpdateSummarySummarized", ctx, workspaceID, input)}
 }
 
-func (_c *Repository_UpdateSummarySummarized_Call) Run(run func(ctx context.Context, input repository.SummaryUpdateSummarizedInput)) *Repository_UpdateSummarySummarized_Call {
+func (_c *Repository_UpdateSummarySummarized_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, input repository.SummaryUpdateSummarizedInput)) *Repository_UpdateSummarySummarized_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.SummaryUpdateSummarizedInput))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.SummaryUpdateSummarizedInput))
 	})
 	return _c
 }
@@ -289,22 +452,22 @@ func (_c *Repository_UpdateSummarySummarized_Call) Return(_a0 error) *Repository
 	return _c
 }
 
-func (_c *Repository_UpdateSummarySummarized_Call) RunAndReturn(run func(context.Context, repository.SummaryUpdateSummarizedInput) error) *Repository_UpdateSummarySummarized_Call {
+func (_c *Repository_UpdateSummarySummarized_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.SummaryUpdateSummarizedInput) error) *Repository_UpdateSummarySummarized_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateSummaryTranscribed provides a mock function with given fields: ctx, input
-func (_m *Repository) UpdateSummaryTranscribed(ctx context.Context, input repository.SummaryUpdateTranscribedInput) error {
-	ret := _m.Called(ctx, input)
+// UpdateSummaryTranscribed provides a mock function with given fields: ctx, workspaceID, input
+func (_m *Repository) UpdateSummaryTranscribed(ctx context.Context, workspaceID uuid.UUID, input repository.SummaryUpdateTranscribedInput) error {
+	ret := _m.Called(ctx, workspaceID, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateSummaryTranscribed")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, repository.SummaryUpdateTranscribedInput) error); ok {
-		r0 = rf(ctx, input)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.SummaryUpdateTranscribedInput) error); ok {
+		r0 = rf(ctx, workspaceID, input)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -319,14 +482,15 @@ type Repository_UpdateSummaryTranscribed_Call struct {
 
 // UpdateSummaryTranscribed is a helper method to define mock.On call
 //   - ctx context.Context
+//   - workspaceID uuid.UUID
 //   - input repository.SummaryUpdateTranscribedInput
-func (_e *Repository_Expecter) UpdateSummaryTranscribed(ctx interface{}, input interface{}) *Repository_UpdateSummaryTranscribed_Call {
-	return &Repository_UpdateSummaryTranscribed_Call{Call: _e.mock.On("UpdateSummaryTranscribed", ctx, input)}
+func (_e *Repository_Expecter) UpdateSummaryTranscribed(ctx interface{}, workspaceID interface{}, input interface{}) *Repository_UpdateSummaryTranscribed_Call {
+	return &Repository_UpdateSummaryTranscribed_Call{Call: _e.mock.On("UpdateSummaryTranscribed", ctx, workspaceID, input)}
 }
 
-func (_c *Repository_UpdateSummaryTranscribed_Call) Run(run func(ctx context.Context, input repository.SummaryUpdateTranscribedInput)) *Repository_UpdateSummaryTranscribed_Call {
+func (_c *Repository_UpdateSummaryTranscribed_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, input repository.SummaryUpdateTranscribedInput)) *Repository_UpdateSummaryTranscribed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(repository.SummaryUpdateTranscribedInput))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.SummaryUpdateTranscribedInput))
 	})
 	return _c
 }
@@ -336,7 +500,7 @@ func (_c *Repository_UpdateSummaryTranscribed_Call) Return(_a0 error) *Repositor
 	return _c
 }
 
-func (_c *Repository_UpdateSummaryTranscribed_Call) RunAndReturn(run func(context.Context, repository.SummaryUpdateTranscribedInput) error) *Repository_UpdateSummaryTranscribed_Call {
+func (_c *Repository_UpdateSummaryTranscribed_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.SummaryUpdateTranscribedInput) error) *Repository_UpdateSummaryTranscribed_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package gatewaymocks
+
+import (
+	context "context"
+
+	events "github.com/diegofsousa/explicAI/internal/gateway/events"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Subscriber is an autogenerated mock type for the Subscriber type
+type Subscriber struct {
+	mock.Mock
+}
+
+type Subscriber_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Subscriber) EXPECT() *Subscriber_Expecter {
+	return &Subscriber_Expecter{mock: &_m.Mock}
+}
+
+// Subscribe provides a mock function with given fields: ctx
+func (_m *Subscriber) Subscribe(ctx context.Context) (<-chan events.Event, func()) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan events.Event
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(context.Context) (<-chan events.Event, func())); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan events.Event); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan events.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) func()); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// Subscriber_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type Subscriber_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Subscriber_Expecter) Subscribe(ctx interface{}) *Subscriber_Subscribe_Call {
+	return &Subscriber_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx)}
+}
+
+func (_c *Subscriber_Subscribe_Call) Run(run func(ctx context.Context)) *Subscriber_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Subscriber_Subscribe_Call) Return(_a0 <-chan events.Event, _a1 func()) *Subscriber_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Subscriber_Subscribe_Call) RunAndReturn(run func(context.Context) (<-chan events.Event, func())) *Subscriber_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSubscriber creates a new instance of Subscriber. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscriber(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Subscriber {
+	mock := &Subscriber{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
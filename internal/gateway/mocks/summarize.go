@@ -22,29 +22,29 @@ func (_m *Summarize) EXPECT() *Summarize_Expecter {
 	return &Summarize_Expecter{mock: &_m.Mock}
 }
 
-// FullTextOrganize provides a mock function with given fields: ctx, transcription
-func (_m *Summarize) FullTextOrganize(ctx context.Context, transcription string) (*string, error) {
-	ret := _m.Called(ctx, transcription)
+// FullTextOrganize provides a mock function with given fields: ctx, input
+func (_m *Summarize) FullTextOrganize(ctx context.Context, input summarize.Input) (*summarize.FullTextOutput, error) {
+	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FullTextOrganize")
 	}
 
-	var r0 *string
+	var r0 *summarize.FullTextOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*string, error)); ok {
-		return rf(ctx, transcription)
+	if rf, ok := ret.Get(0).(func(context.Context, summarize.Input) (*summarize.FullTextOutput, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *string); ok {
-		r0 = rf(ctx, transcription)
+	if rf, ok := ret.Get(0).(func(context.Context, summarize.Input) *summarize.FullTextOutput); ok {
+		r0 = rf(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*string)
+			r0 = ret.Get(0).(*summarize.FullTextOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, transcription)
+	if rf, ok := ret.Get(1).(func(context.Context, summarize.Input) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,31 +59,31 @@ type Summarize_FullTextOrganize_Call struct {
 
 // FullTextOrganize is a helper method to define mock.On call
 //   - ctx context.Context
-//   - transcription string
-func (_e *Summarize_Expecter) FullTextOrganize(ctx interface{}, transcription interface{}) *Summarize_FullTextOrganize_Call {
-	return &Summarize_FullTextOrganize_Call{Call: _e.mock.On("FullTextOrganize", ctx, transcription)}
+//   - input summarize.Input
+func (_e *Summarize_Expecter) FullTextOrganize(ctx interface{}, input interface{}) *Summarize_FullTextOrganize_Call {
+	return &Summarize_FullTextOrganize_Call{Call: _e.mock.On("FullTextOrganize", ctx, input)}
 }
 
-func (_c *Summarize_FullTextOrganize_Call) Run(run func(ctx context.Context, transcription string)) *Summarize_FullTextOrganize_Call {
+func (_c *Summarize_FullTextOrganize_Call) Run(run func(ctx context.Context, input summarize.Input)) *Summarize_FullTextOrganize_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(summarize.Input))
 	})
 	return _c
 }
 
-func (_c *Summarize_FullTextOrganize_Call) Return(_a0 *string, _a1 error) *Summarize_FullTextOrganize_Call {
+func (_c *Summarize_FullTextOrganize_Call) Return(_a0 *summarize.FullTextOutput, _a1 error) *Summarize_FullTextOrganize_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Summarize_FullTextOrganize_Call) RunAndReturn(run func(context.Context, string) (*string, error)) *Summarize_FullTextOrganize_Call {
+func (_c *Summarize_FullTextOrganize_Call) RunAndReturn(run func(context.Context, summarize.Input) (*summarize.FullTextOutput, error)) *Summarize_FullTextOrganize_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Resume provides a mock function with given fields: ctx, transcription
-func (_m *Summarize) Resume(ctx context.Context, transcription string) (*summarize.ResumeOutput, error) {
-	ret := _m.Called(ctx, transcription)
+// Resume provides a mock function with given fields: ctx, input
+func (_m *Summarize) Resume(ctx context.Context, input summarize.Input) (*summarize.ResumeOutput, error) {
+	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Resume")
@@ -91,19 +91,19 @@ func (_m *Summarize) Resume(ctx context.Context, transcription string) (*summari
 
 	var r0 *summarize.ResumeOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*summarize.ResumeOutput, error)); ok {
-		return rf(ctx, transcription)
+	if rf, ok := ret.Get(0).(func(context.Context, summarize.Input) (*summarize.ResumeOutput, error)); ok {
+		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *summarize.ResumeOutput); ok {
-		r0 = rf(ctx, transcription)
+	if rf, ok := ret.Get(0).(func(context.Context, summarize.Input) *summarize.ResumeOutput); ok {
+		r0 = rf(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*summarize.ResumeOutput)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, transcription)
+	if rf, ok := ret.Get(1).(func(context.Context, summarize.Input) error); ok {
+		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -118,14 +118,14 @@ type Summarize_Resume_Call struct {
 
 // Resume is a helper method to define mock.On call
 //   - ctx context.Context
-//   - transcription string
-func (_e *Summarize_Expecter) Resume(ctx interface{}, transcription interface{}) *Summarize_Resume_Call {
-	return &Summarize_Resume_Call{Call: _e.mock.On("Resume", ctx, transcription)}
+//   - input summarize.Input
+func (_e *Summarize_Expecter) Resume(ctx interface{}, input interface{}) *Summarize_Resume_Call {
+	return &Summarize_Resume_Call{Call: _e.mock.On("Resume", ctx, input)}
 }
 
-func (_c *Summarize_Resume_Call) Run(run func(ctx context.Context, transcription string)) *Summarize_Resume_Call {
+func (_c *Summarize_Resume_Call) Run(run func(ctx context.Context, input summarize.Input)) *Summarize_Resume_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(summarize.Input))
 	})
 	return _c
 }
@@ -135,7 +135,7 @@ func (_c *Summarize_Resume_Call) Return(_a0 *summarize.ResumeOutput, _a1 error)
 	return _c
 }
 
-func (_c *Summarize_Resume_Call) RunAndReturn(run func(context.Context, string) (*summarize.ResumeOutput, error)) *Summarize_Resume_Call {
+func (_c *Summarize_Resume_Call) RunAndReturn(run func(context.Context, summarize.Input) (*summarize.ResumeOutput, error)) *Summarize_Resume_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,406 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package gatewaymocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	upload "github.com/diegofsousa/explicAI/internal/gateway/upload"
+
+	uuid "github.com/google/uuid"
+)
+
+// UploadStore is an autogenerated mock type for the UploadStore type
+type UploadStore struct {
+	mock.Mock
+}
+
+type UploadStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UploadStore) EXPECT() *UploadStore_Expecter {
+	return &UploadStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateUpload provides a mock function with given fields: ctx, info
+func (_m *UploadStore) CreateUpload(ctx context.Context, info upload.UploadInfo) error {
+	ret := _m.Called(ctx, info)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUpload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, upload.UploadInfo) error); ok {
+		r0 = rf(ctx, info)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadStore_CreateUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUpload'
+type UploadStore_CreateUpload_Call struct {
+	*mock.Call
+}
+
+// CreateUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - info upload.UploadInfo
+func (_e *UploadStore_Expecter) CreateUpload(ctx interface{}, info interface{}) *UploadStore_CreateUpload_Call {
+	return &UploadStore_CreateUpload_Call{Call: _e.mock.On("CreateUpload", ctx, info)}
+}
+
+func (_c *UploadStore_CreateUpload_Call) Run(run func(ctx context.Context, info upload.UploadInfo)) *UploadStore_CreateUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(upload.UploadInfo))
+	})
+	return _c
+}
+
+func (_c *UploadStore_CreateUpload_Call) Return(_a0 error) *UploadStore_CreateUpload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadStore_CreateUpload_Call) RunAndReturn(run func(context.Context, upload.UploadInfo) error) *UploadStore_CreateUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUpload provides a mock function with given fields: ctx, id
+func (_m *UploadStore) DeleteUpload(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUpload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadStore_DeleteUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUpload'
+type UploadStore_DeleteUpload_Call struct {
+	*mock.Call
+}
+
+// DeleteUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *UploadStore_Expecter) DeleteUpload(ctx interface{}, id interface{}) *UploadStore_DeleteUpload_Call {
+	return &UploadStore_DeleteUpload_Call{Call: _e.mock.On("DeleteUpload", ctx, id)}
+}
+
+func (_c *UploadStore_DeleteUpload_Call) Run(run func(ctx context.Context, id uuid.UUID)) *UploadStore_DeleteUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UploadStore_DeleteUpload_Call) Return(_a0 error) *UploadStore_DeleteUpload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadStore_DeleteUpload_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *UploadStore_DeleteUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUploadContent provides a mock function with given fields: ctx, id
+func (_m *UploadStore) DeleteUploadContent(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUploadContent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadStore_DeleteUploadContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUploadContent'
+type UploadStore_DeleteUploadContent_Call struct {
+	*mock.Call
+}
+
+// DeleteUploadContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *UploadStore_Expecter) DeleteUploadContent(ctx interface{}, id interface{}) *UploadStore_DeleteUploadContent_Call {
+	return &UploadStore_DeleteUploadContent_Call{Call: _e.mock.On("DeleteUploadContent", ctx, id)}
+}
+
+func (_c *UploadStore_DeleteUploadContent_Call) Run(run func(ctx context.Context, id uuid.UUID)) *UploadStore_DeleteUploadContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UploadStore_DeleteUploadContent_Call) Return(_a0 error) *UploadStore_DeleteUploadContent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadStore_DeleteUploadContent_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *UploadStore_DeleteUploadContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUpload provides a mock function with given fields: ctx, id
+func (_m *UploadStore) GetUpload(ctx context.Context, id uuid.UUID) (*upload.UploadInfo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpload")
+	}
+
+	var r0 *upload.UploadInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*upload.UploadInfo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *upload.UploadInfo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*upload.UploadInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadStore_GetUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpload'
+type UploadStore_GetUpload_Call struct {
+	*mock.Call
+}
+
+// GetUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *UploadStore_Expecter) GetUpload(ctx interface{}, id interface{}) *UploadStore_GetUpload_Call {
+	return &UploadStore_GetUpload_Call{Call: _e.mock.On("GetUpload", ctx, id)}
+}
+
+func (_c *UploadStore_GetUpload_Call) Run(run func(ctx context.Context, id uuid.UUID)) *UploadStore_GetUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UploadStore_GetUpload_Call) Return(_a0 *upload.UploadInfo, _a1 error) *UploadStore_GetUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadStore_GetUpload_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*upload.UploadInfo, error)) *UploadStore_GetUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadUpload provides a mock function with given fields: ctx, id
+func (_m *UploadStore) ReadUpload(ctx context.Context, id uuid.UUID) (io.ReadSeekCloser, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadUpload")
+	}
+
+	var r0 io.ReadSeekCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (io.ReadSeekCloser, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) io.ReadSeekCloser); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadSeekCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadStore_ReadUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadUpload'
+type UploadStore_ReadUpload_Call struct {
+	*mock.Call
+}
+
+// ReadUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *UploadStore_Expecter) ReadUpload(ctx interface{}, id interface{}) *UploadStore_ReadUpload_Call {
+	return &UploadStore_ReadUpload_Call{Call: _e.mock.On("ReadUpload", ctx, id)}
+}
+
+func (_c *UploadStore_ReadUpload_Call) Run(run func(ctx context.Context, id uuid.UUID)) *UploadStore_ReadUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UploadStore_ReadUpload_Call) Return(_a0 io.ReadSeekCloser, _a1 error) *UploadStore_ReadUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadStore_ReadUpload_Call) RunAndReturn(run func(context.Context, uuid.UUID) (io.ReadSeekCloser, error)) *UploadStore_ReadUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUpload provides a mock function with given fields: ctx, info
+func (_m *UploadStore) UpdateUpload(ctx context.Context, info upload.UploadInfo) error {
+	ret := _m.Called(ctx, info)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUpload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, upload.UploadInfo) error); ok {
+		r0 = rf(ctx, info)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadStore_UpdateUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUpload'
+type UploadStore_UpdateUpload_Call struct {
+	*mock.Call
+}
+
+// UpdateUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - info upload.UploadInfo
+func (_e *UploadStore_Expecter) UpdateUpload(ctx interface{}, info interface{}) *UploadStore_UpdateUpload_Call {
+	return &UploadStore_UpdateUpload_Call{Call: _e.mock.On("UpdateUpload", ctx, info)}
+}
+
+func (_c *UploadStore_UpdateUpload_Call) Run(run func(ctx context.Context, info upload.UploadInfo)) *UploadStore_UpdateUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(upload.UploadInfo))
+	})
+	return _c
+}
+
+func (_c *UploadStore_UpdateUpload_Call) Return(_a0 error) *UploadStore_UpdateUpload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UploadStore_UpdateUpload_Call) RunAndReturn(run func(context.Context, upload.UploadInfo) error) *UploadStore_UpdateUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteChunk provides a mock function with given fields: ctx, id, offset, content
+func (_m *UploadStore) WriteChunk(ctx context.Context, id uuid.UUID, offset int64, content io.Reader) (int64, error) {
+	ret := _m.Called(ctx, id, offset, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteChunk")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64, io.Reader) (int64, error)); ok {
+		return rf(ctx, id, offset, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64, io.Reader) int64); ok {
+		r0 = rf(ctx, id, offset, content)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64, io.Reader) error); ok {
+		r1 = rf(ctx, id, offset, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadStore_WriteChunk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteChunk'
+type UploadStore_WriteChunk_Call struct {
+	*mock.Call
+}
+
+// WriteChunk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - offset int64
+//   - content io.Reader
+func (_e *UploadStore_Expecter) WriteChunk(ctx interface{}, id interface{}, offset interface{}, content interface{}) *UploadStore_WriteChunk_Call {
+	return &UploadStore_WriteChunk_Call{Call: _e.mock.On("WriteChunk", ctx, id, offset, content)}
+}
+
+func (_c *UploadStore_WriteChunk_Call) Run(run func(ctx context.Context, id uuid.UUID, offset int64, content io.Reader)) *UploadStore_WriteChunk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *UploadStore_WriteChunk_Call) Return(_a0 int64, _a1 error) *UploadStore_WriteChunk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UploadStore_WriteChunk_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64, io.Reader) (int64, error)) *UploadStore_WriteChunk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUploadStore creates a new instance of UploadStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUploadStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UploadStore {
+	mock := &UploadStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,147 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package gatewaymocks
+
+import (
+	context "context"
+
+	repository "github.com/diegofsousa/explicAI/internal/gateway/repository"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// UsageRepository is an autogenerated mock type for the UsageRepository type
+type UsageRepository struct {
+	mock.Mock
+}
+
+type UsageRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UsageRepository) EXPECT() *UsageRepository_Expecter {
+	return &UsageRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateUsage provides a mock function with given fields: ctx, workspaceID, input
+func (_m *UsageRepository) CreateUsage(ctx context.Context, workspaceID uuid.UUID, input repository.UsageCreateInput) error {
+	ret := _m.Called(ctx, workspaceID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUsage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.UsageCreateInput) error); ok {
+		r0 = rf(ctx, workspaceID, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UsageRepository_CreateUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUsage'
+type UsageRepository_CreateUsage_Call struct {
+	*mock.Call
+}
+
+// CreateUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - input repository.UsageCreateInput
+func (_e *UsageRepository_Expecter) CreateUsage(ctx interface{}, workspaceID interface{}, input interface{}) *UsageRepository_CreateUsage_Call {
+	return &UsageRepository_CreateUsage_Call{Call: _e.mock.On("CreateUsage", ctx, workspaceID, input)}
+}
+
+func (_c *UsageRepository_CreateUsage_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, input repository.UsageCreateInput)) *UsageRepository_CreateUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.UsageCreateInput))
+	})
+	return _c
+}
+
+func (_c *UsageRepository_CreateUsage_Call) Return(_a0 error) *UsageRepository_CreateUsage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UsageRepository_CreateUsage_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.UsageCreateInput) error) *UsageRepository_CreateUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsage provides a mock function with given fields: ctx, workspaceID, filter
+func (_m *UsageRepository) GetUsage(ctx context.Context, workspaceID uuid.UUID, filter repository.UsageFilter) ([]repository.UsageAggregateOutput, error) {
+	ret := _m.Called(ctx, workspaceID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsage")
+	}
+
+	var r0 []repository.UsageAggregateOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.UsageFilter) ([]repository.UsageAggregateOutput, error)); ok {
+		return rf(ctx, workspaceID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.UsageFilter) []repository.UsageAggregateOutput); ok {
+		r0 = rf(ctx, workspaceID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.UsageAggregateOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.UsageFilter) error); ok {
+		r1 = rf(ctx, workspaceID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UsageRepository_GetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsage'
+type UsageRepository_GetUsage_Call struct {
+	*mock.Call
+}
+
+// GetUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - filter repository.UsageFilter
+func (_e *UsageRepository_Expecter) GetUsage(ctx interface{}, workspaceID interface{}, filter interface{}) *UsageRepository_GetUsage_Call {
+	return &UsageRepository_GetUsage_Call{Call: _e.mock.On("GetUsage", ctx, workspaceID, filter)}
+}
+
+func (_c *UsageRepository_GetUsage_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, filter repository.UsageFilter)) *UsageRepository_GetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.UsageFilter))
+	})
+	return _c
+}
+
+func (_c *UsageRepository_GetUsage_Call) Return(_a0 []repository.UsageAggregateOutput, _a1 error) *UsageRepository_GetUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UsageRepository_GetUsage_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.UsageFilter) ([]repository.UsageAggregateOutput, error)) *UsageRepository_GetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUsageRepository creates a new instance of UsageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUsageRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UsageRepository {
+	mock := &UsageRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,492 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package gatewaymocks
+
+import (
+	context "context"
+
+	repository "github.com/diegofsousa/explicAI/internal/gateway/repository"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// WebhookRepository is an autogenerated mock type for the WebhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+type WebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookRepository) EXPECT() *WebhookRepository_Expecter {
+	return &WebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, workspaceID, input
+func (_m *WebhookRepository) CreateWebhook(ctx context.Context, workspaceID uuid.UUID, input repository.WebhookCreateInput) (*repository.WebhookOutput, error) {
+	ret := _m.Called(ctx, workspaceID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 *repository.WebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.WebhookCreateInput) (*repository.WebhookOutput, error)); ok {
+		return rf(ctx, workspaceID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.WebhookCreateInput) *repository.WebhookOutput); ok {
+		r0 = rf(ctx, workspaceID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.WebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.WebhookCreateInput) error); ok {
+		r1 = rf(ctx, workspaceID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type WebhookRepository_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - input repository.WebhookCreateInput
+func (_e *WebhookRepository_Expecter) CreateWebhook(ctx interface{}, workspaceID interface{}, input interface{}) *WebhookRepository_CreateWebhook_Call {
+	return &WebhookRepository_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, workspaceID, input)}
+}
+
+func (_c *WebhookRepository_CreateWebhook_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, input repository.WebhookCreateInput)) *WebhookRepository_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.WebhookCreateInput))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_CreateWebhook_Call) Return(_a0 *repository.WebhookOutput, _a1 error) *WebhookRepository_CreateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_CreateWebhook_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.WebhookCreateInput) (*repository.WebhookOutput, error)) *WebhookRepository_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhookDelivery provides a mock function with given fields: ctx, input
+func (_m *WebhookRepository) CreateWebhookDelivery(ctx context.Context, input repository.WebhookDeliveryCreateInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.WebhookDeliveryCreateInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_CreateWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookDelivery'
+type WebhookRepository_CreateWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input repository.WebhookDeliveryCreateInput
+func (_e *WebhookRepository_Expecter) CreateWebhookDelivery(ctx interface{}, input interface{}) *WebhookRepository_CreateWebhookDelivery_Call {
+	return &WebhookRepository_CreateWebhookDelivery_Call{Call: _e.mock.On("CreateWebhookDelivery", ctx, input)}
+}
+
+func (_c *WebhookRepository_CreateWebhookDelivery_Call) Run(run func(ctx context.Context, input repository.WebhookDeliveryCreateInput)) *WebhookRepository_CreateWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.WebhookDeliveryCreateInput))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_CreateWebhookDelivery_Call) Return(_a0 error) *WebhookRepository_CreateWebhookDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_CreateWebhookDelivery_Call) RunAndReturn(run func(context.Context, repository.WebhookDeliveryCreateInput) error) *WebhookRepository_CreateWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhookByExternalID provides a mock function with given fields: ctx, workspaceID, externalID
+func (_m *WebhookRepository) DeleteWebhookByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) error {
+	ret := _m.Called(ctx, workspaceID, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookByExternalID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, workspaceID, externalID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookRepository_DeleteWebhookByExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhookByExternalID'
+type WebhookRepository_DeleteWebhookByExternalID_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhookByExternalID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - externalID uuid.UUID
+func (_e *WebhookRepository_Expecter) DeleteWebhookByExternalID(ctx interface{}, workspaceID interface{}, externalID interface{}) *WebhookRepository_DeleteWebhookByExternalID_Call {
+	return &WebhookRepository_DeleteWebhookByExternalID_Call{Call: _e.mock.On("DeleteWebhookByExternalID", ctx, workspaceID, externalID)}
+}
+
+func (_c *WebhookRepository_DeleteWebhookByExternalID_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID)) *WebhookRepository_DeleteWebhookByExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_DeleteWebhookByExternalID_Call) Return(_a0 error) *WebhookRepository_DeleteWebhookByExternalID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookRepository_DeleteWebhookByExternalID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *WebhookRepository_DeleteWebhookByExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveWebhooksByStatus provides a mock function with given fields: ctx, workspaceID, status
+func (_m *WebhookRepository) GetActiveWebhooksByStatus(ctx context.Context, workspaceID uuid.UUID, status string) ([]repository.WebhookOutput, error) {
+	ret := _m.Called(ctx, workspaceID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveWebhooksByStatus")
+	}
+
+	var r0 []repository.WebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]repository.WebhookOutput, error)); ok {
+		return rf(ctx, workspaceID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []repository.WebhookOutput); ok {
+		r0 = rf(ctx, workspaceID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.WebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, workspaceID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_GetActiveWebhooksByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveWebhooksByStatus'
+type WebhookRepository_GetActiveWebhooksByStatus_Call struct {
+	*mock.Call
+}
+
+// GetActiveWebhooksByStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - status string
+func (_e *WebhookRepository_Expecter) GetActiveWebhooksByStatus(ctx interface{}, workspaceID interface{}, status interface{}) *WebhookRepository_GetActiveWebhooksByStatus_Call {
+	return &WebhookRepository_GetActiveWebhooksByStatus_Call{Call: _e.mock.On("GetActiveWebhooksByStatus", ctx, workspaceID, status)}
+}
+
+func (_c *WebhookRepository_GetActiveWebhooksByStatus_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, status string)) *WebhookRepository_GetActiveWebhooksByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_GetActiveWebhooksByStatus_Call) Return(_a0 []repository.WebhookOutput, _a1 error) *WebhookRepository_GetActiveWebhooksByStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_GetActiveWebhooksByStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]repository.WebhookOutput, error)) *WebhookRepository_GetActiveWebhooksByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookByExternalID provides a mock function with given fields: ctx, workspaceID, externalID
+func (_m *WebhookRepository) GetWebhookByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) (*repository.WebhookOutput, error) {
+	ret := _m.Called(ctx, workspaceID, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookByExternalID")
+	}
+
+	var r0 *repository.WebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*repository.WebhookOutput, error)); ok {
+		return rf(ctx, workspaceID, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *repository.WebhookOutput); ok {
+		r0 = rf(ctx, workspaceID, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.WebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, workspaceID, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_GetWebhookByExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookByExternalID'
+type WebhookRepository_GetWebhookByExternalID_Call struct {
+	*mock.Call
+}
+
+// GetWebhookByExternalID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - externalID uuid.UUID
+func (_e *WebhookRepository_Expecter) GetWebhookByExternalID(ctx interface{}, workspaceID interface{}, externalID interface{}) *WebhookRepository_GetWebhookByExternalID_Call {
+	return &WebhookRepository_GetWebhookByExternalID_Call{Call: _e.mock.On("GetWebhookByExternalID", ctx, workspaceID, externalID)}
+}
+
+func (_c *WebhookRepository_GetWebhookByExternalID_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID)) *WebhookRepository_GetWebhookByExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_GetWebhookByExternalID_Call) Return(_a0 *repository.WebhookOutput, _a1 error) *WebhookRepository_GetWebhookByExternalID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_GetWebhookByExternalID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*repository.WebhookOutput, error)) *WebhookRepository_GetWebhookByExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDeliveries provides a mock function with given fields: ctx, webhookExternalID
+func (_m *WebhookRepository) GetWebhookDeliveries(ctx context.Context, webhookExternalID uuid.UUID) ([]repository.WebhookDeliveryOutput, error) {
+	ret := _m.Called(ctx, webhookExternalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDeliveries")
+	}
+
+	var r0 []repository.WebhookDeliveryOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]repository.WebhookDeliveryOutput, error)); ok {
+		return rf(ctx, webhookExternalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []repository.WebhookDeliveryOutput); ok {
+		r0 = rf(ctx, webhookExternalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.WebhookDeliveryOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, webhookExternalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_GetWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDeliveries'
+type WebhookRepository_GetWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookExternalID uuid.UUID
+func (_e *WebhookRepository_Expecter) GetWebhookDeliveries(ctx interface{}, webhookExternalID interface{}) *WebhookRepository_GetWebhookDeliveries_Call {
+	return &WebhookRepository_GetWebhookDeliveries_Call{Call: _e.mock.On("GetWebhookDeliveries", ctx, webhookExternalID)}
+}
+
+func (_c *WebhookRepository_GetWebhookDeliveries_Call) Run(run func(ctx context.Context, webhookExternalID uuid.UUID)) *WebhookRepository_GetWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_GetWebhookDeliveries_Call) Return(_a0 []repository.WebhookDeliveryOutput, _a1 error) *WebhookRepository_GetWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_GetWebhookDeliveries_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]repository.WebhookDeliveryOutput, error)) *WebhookRepository_GetWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooks provides a mock function with given fields: ctx, workspaceID
+func (_m *WebhookRepository) GetWebhooks(ctx context.Context, workspaceID uuid.UUID) ([]repository.WebhookOutput, error) {
+	ret := _m.Called(ctx, workspaceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooks")
+	}
+
+	var r0 []repository.WebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]repository.WebhookOutput, error)); ok {
+		return rf(ctx, workspaceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []repository.WebhookOutput); ok {
+		r0 = rf(ctx, workspaceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.WebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, workspaceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_GetWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooks'
+type WebhookRepository_GetWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+func (_e *WebhookRepository_Expecter) GetWebhooks(ctx interface{}, workspaceID interface{}) *WebhookRepository_GetWebhooks_Call {
+	return &WebhookRepository_GetWebhooks_Call{Call: _e.mock.On("GetWebhooks", ctx, workspaceID)}
+}
+
+func (_c *WebhookRepository_GetWebhooks_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID)) *WebhookRepository_GetWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_GetWebhooks_Call) Return(_a0 []repository.WebhookOutput, _a1 error) *WebhookRepository_GetWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_GetWebhooks_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]repository.WebhookOutput, error)) *WebhookRepository_GetWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function with given fields: ctx, workspaceID, input
+func (_m *WebhookRepository) UpdateWebhook(ctx context.Context, workspaceID uuid.UUID, input repository.WebhookUpdateInput) (*repository.WebhookOutput, error) {
+	ret := _m.Called(ctx, workspaceID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 *repository.WebhookOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.WebhookUpdateInput) (*repository.WebhookOutput, error)); ok {
+		return rf(ctx, workspaceID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, repository.WebhookUpdateInput) *repository.WebhookOutput); ok {
+		r0 = rf(ctx, workspaceID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.WebhookOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, repository.WebhookUpdateInput) error); ok {
+		r1 = rf(ctx, workspaceID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookRepository_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type WebhookRepository_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceID uuid.UUID
+//   - input repository.WebhookUpdateInput
+func (_e *WebhookRepository_Expecter) UpdateWebhook(ctx interface{}, workspaceID interface{}, input interface{}) *WebhookRepository_UpdateWebhook_Call {
+	return &WebhookRepository_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", ctx, workspaceID, input)}
+}
+
+func (_c *WebhookRepository_UpdateWebhook_Call) Run(run func(ctx context.Context, workspaceID uuid.UUID, input repository.WebhookUpdateInput)) *WebhookRepository_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(repository.WebhookUpdateInput))
+	})
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateWebhook_Call) Return(_a0 *repository.WebhookOutput, _a1 error) *WebhookRepository_UpdateWebhook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookRepository_UpdateWebhook_Call) RunAndReturn(run func(context.Context, uuid.UUID, repository.WebhookUpdateInput) (*repository.WebhookOutput, error)) *WebhookRepository_UpdateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
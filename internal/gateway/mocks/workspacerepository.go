@@ -0,0 +1,215 @@
+// Code generated by mockery v2.52.3. DO NOT EDIT.
+
+package gatewaymocks
+
+import (
+	context "context"
+
+	repository "github.com/diegofsousa/explicAI/internal/gateway/repository"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// WorkspaceRepository is an autogenerated mock type for the WorkspaceRepository type
+type WorkspaceRepository struct {
+	mock.Mock
+}
+
+type WorkspaceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WorkspaceRepository) EXPECT() *WorkspaceRepository_Expecter {
+	return &WorkspaceRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateWorkspace provides a mock function with given fields: ctx, name
+func (_m *WorkspaceRepository) CreateWorkspace(ctx context.Context, name string) (*repository.WorkspaceOutput, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkspace")
+	}
+
+	var r0 *repository.WorkspaceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*repository.WorkspaceOutput, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *repository.WorkspaceOutput); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.WorkspaceOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkspaceRepository_CreateWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorkspace'
+type WorkspaceRepository_CreateWorkspace_Call struct {
+	*mock.Call
+}
+
+// CreateWorkspace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *WorkspaceRepository_Expecter) CreateWorkspace(ctx interface{}, name interface{}) *WorkspaceRepository_CreateWorkspace_Call {
+	return &WorkspaceRepository_CreateWorkspace_Call{Call: _e.mock.On("CreateWorkspace", ctx, name)}
+}
+
+func (_c *WorkspaceRepository_CreateWorkspace_Call) Run(run func(ctx context.Context, name string)) *WorkspaceRepository_CreateWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WorkspaceRepository_CreateWorkspace_Call) Return(_a0 *repository.WorkspaceOutput, _a1 error) *WorkspaceRepository_CreateWorkspace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkspaceRepository_CreateWorkspace_Call) RunAndReturn(run func(context.Context, string) (*repository.WorkspaceOutput, error)) *WorkspaceRepository_CreateWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkspaceByExternalID provides a mock function with given fields: ctx, externalID
+func (_m *WorkspaceRepository) GetWorkspaceByExternalID(ctx context.Context, externalID uuid.UUID) (*repository.WorkspaceOutput, error) {
+	ret := _m.Called(ctx, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaceByExternalID")
+	}
+
+	var r0 *repository.WorkspaceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*repository.WorkspaceOutput, error)); ok {
+		return rf(ctx, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *repository.WorkspaceOutput); ok {
+		r0 = rf(ctx, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.WorkspaceOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkspaceRepository_GetWorkspaceByExternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaceByExternalID'
+type WorkspaceRepository_GetWorkspaceByExternalID_Call struct {
+	*mock.Call
+}
+
+// GetWorkspaceByExternalID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - externalID uuid.UUID
+func (_e *WorkspaceRepository_Expecter) GetWorkspaceByExternalID(ctx interface{}, externalID interface{}) *WorkspaceRepository_GetWorkspaceByExternalID_Call {
+	return &WorkspaceRepository_GetWorkspaceByExternalID_Call{Call: _e.mock.On("GetWorkspaceByExternalID", ctx, externalID)}
+}
+
+func (_c *WorkspaceRepository_GetWorkspaceByExternalID_Call) Run(run func(ctx context.Context, externalID uuid.UUID)) *WorkspaceRepository_GetWorkspaceByExternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WorkspaceRepository_GetWorkspaceByExternalID_Call) Return(_a0 *repository.WorkspaceOutput, _a1 error) *WorkspaceRepository_GetWorkspaceByExternalID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkspaceRepository_GetWorkspaceByExternalID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*repository.WorkspaceOutput, error)) *WorkspaceRepository_GetWorkspaceByExternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkspaces provides a mock function with given fields: ctx
+func (_m *WorkspaceRepository) GetWorkspaces(ctx context.Context) ([]repository.WorkspaceOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspaces")
+	}
+
+	var r0 []repository.WorkspaceOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]repository.WorkspaceOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []repository.WorkspaceOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.WorkspaceOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkspaceRepository_GetWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspaces'
+type WorkspaceRepository_GetWorkspaces_Call struct {
+	*mock.Call
+}
+
+// GetWorkspaces is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WorkspaceRepository_Expecter) GetWorkspaces(ctx interface{}) *WorkspaceRepository_GetWorkspaces_Call {
+	return &WorkspaceRepository_GetWorkspaces_Call{Call: _e.mock.On("GetWorkspaces", ctx)}
+}
+
+func (_c *WorkspaceRepository_GetWorkspaces_Call) Run(run func(ctx context.Context)) *WorkspaceRepository_GetWorkspaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WorkspaceRepository_GetWorkspaces_Call) Return(_a0 []repository.WorkspaceOutput, _a1 error) *WorkspaceRepository_GetWorkspaces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WorkspaceRepository_GetWorkspaces_Call) RunAndReturn(run func(context.Context) ([]repository.WorkspaceOutput, error)) *WorkspaceRepository_GetWorkspaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWorkspaceRepository creates a new instance of WorkspaceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorkspaceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WorkspaceRepository {
+	mock := &WorkspaceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
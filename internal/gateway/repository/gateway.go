@@ -7,10 +7,42 @@ import (
 )
 
 type Repository interface {
-	CreateSummary(ctx context.Context, status Status) (*SummaryCreateOutput, error)
-	UpdateSummaryTranscribed(ctx context.Context, input SummaryUpdateTranscribedInput) error
-	UpdateSummarySummarized(ctx context.Context, input SummaryUpdateSummarizedInput) error
-	GetSummaries(ctx context.Context) ([]SummaryOutput, error)
-	GetSummaryByExternalID(ctx context.Context, externalID uuid.UUID) (*SummaryOutput, error)
-	DeleteSummaryByExternalID(ctx context.Context, externalID uuid.UUID) error
+	CreateSummary(ctx context.Context, workspaceID uuid.UUID, input SummaryCreateInput) (*SummaryCreateOutput, error)
+	UpdateSummaryDownloaded(ctx context.Context, workspaceID uuid.UUID, input SummaryUpdateDownloadedInput) error
+	UpdateSummaryTranscribed(ctx context.Context, workspaceID uuid.UUID, input SummaryUpdateTranscribedInput) error
+	UpdateSummarySummarized(ctx context.Context, workspaceID uuid.UUID, input SummaryUpdateSummarizedInput) error
+	GetSummaries(ctx context.Context, workspaceID uuid.UUID, filter SummaryFilter) ([]SummaryOutput, error)
+	StreamSummaries(ctx context.Context, workspaceID uuid.UUID, filter SummaryFilter, fn func(SummaryOutput) error) error
+	GetSummaryByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) (*SummaryOutput, error)
+	GetSummaryByAudioHash(ctx context.Context, workspaceID uuid.UUID, audioHash string) (*SummaryOutput, error)
+	DeleteSummaryByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) error
+}
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, workspaceID uuid.UUID, input WebhookCreateInput) (*WebhookOutput, error)
+	UpdateWebhook(ctx context.Context, workspaceID uuid.UUID, input WebhookUpdateInput) (*WebhookOutput, error)
+	GetWebhooks(ctx context.Context, workspaceID uuid.UUID) ([]WebhookOutput, error)
+	GetActiveWebhooksByStatus(ctx context.Context, workspaceID uuid.UUID, status string) ([]WebhookOutput, error)
+	GetWebhookByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) (*WebhookOutput, error)
+	DeleteWebhookByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) error
+	CreateWebhookDelivery(ctx context.Context, input WebhookDeliveryCreateInput) error
+	GetWebhookDeliveries(ctx context.Context, webhookExternalID uuid.UUID) ([]WebhookDeliveryOutput, error)
+}
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, workspaceID uuid.UUID, input APIKeyCreateInput) (*APIKeyOutput, error)
+	GetAPIKeys(ctx context.Context, workspaceID uuid.UUID) ([]APIKeyOutput, error)
+	GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*APIKeyOutput, error)
+	RevokeAPIKeyByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) error
+}
+
+type WorkspaceRepository interface {
+	CreateWorkspace(ctx context.Context, name string) (*WorkspaceOutput, error)
+	GetWorkspaces(ctx context.Context) ([]WorkspaceOutput, error)
+	GetWorkspaceByExternalID(ctx context.Context, externalID uuid.UUID) (*WorkspaceOutput, error)
+}
+
+type UsageRepository interface {
+	CreateUsage(ctx context.Context, workspaceID uuid.UUID, input UsageCreateInput) error
+	GetUsage(ctx context.Context, workspaceID uuid.UUID, filter UsageFilter) ([]UsageAggregateOutput, error)
 }
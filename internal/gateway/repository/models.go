@@ -15,6 +15,8 @@ const (
 	TranscribedFailed
 	Summarized
 	SummarizedFailed
+	Downloading
+	DownloadFailed
 )
 
 type StatusDomain struct {
@@ -29,10 +31,28 @@ var (
 		Trancribed:        {"TRANSCRIBED", 66},
 		SummarizedFailed:  {"SUMMARIZED_FAILED", 66},
 		Summarized:        {"SUMMARIZED", 100},
+		Downloading:       {"DOWNLOADING", 0},
+		DownloadFailed:    {"DOWNLOAD_FAILED", 0},
 	}
 )
 
 type (
+	AudioMetadata struct {
+		DurationSeconds float64
+		Codec           string
+		Bitrate         int
+		SampleRate      int
+		Channels        int
+	}
+
+	SummaryCreateInput struct {
+		Status           Status
+		AudioKey         string
+		AudioContentType string
+		AudioHash        string
+		Audio            AudioMetadata
+	}
+
 	SummaryCreateOutput struct {
 		ExternalID uuid.UUID
 		Status     string
@@ -56,6 +76,23 @@ type (
 		ExternalID uuid.UUID
 		Status     Status
 	}
+
+	SummaryUpdateDownloadedInput struct {
+		ExternalID       uuid.UUID
+		Status           Status
+		AudioKey         string
+		AudioContentType string
+		AudioHash        string
+		Audio            AudioMetadata
+	}
+)
+
+type (
+	SummaryFilter struct {
+		Status      string
+		CreatedFrom time.Time
+		CreatedTo   time.Time
+	}
 )
 
 type (
@@ -70,5 +107,121 @@ type (
 		MediumResume sql.NullString
 		Progress     sql.NullInt32
 		FullText     sql.NullString
+
+		AudioKey         sql.NullString
+		AudioContentType sql.NullString
+		AudioDuration    sql.NullFloat64
+		AudioCodec       sql.NullString
+		AudioBitrate     sql.NullInt32
+		AudioSampleRate  sql.NullInt32
+		AudioChannels    sql.NullInt32
+	}
+)
+
+type (
+	WebhookCreateInput struct {
+		URL      string
+		Secret   string
+		Statuses []string
+	}
+
+	WebhookUpdateInput struct {
+		ExternalID uuid.UUID
+		URL        string
+		Statuses   []string
+		Active     bool
+	}
+
+	WebhookOutput struct {
+		ExternalID uuid.UUID
+		CreatedAt  time.Time
+		UpdatedAt  time.Time
+		URL        string
+		Secret     string
+		Statuses   []string
+		Active     bool
+	}
+)
+
+type (
+	WebhookDeliveryCreateInput struct {
+		WebhookExternalID uuid.UUID
+		EventID           uuid.UUID
+		EventType         string
+		Attempt           int
+		Payload           string
+		StatusCode        sql.NullInt32
+		Error             sql.NullString
+		Delivered         bool
+	}
+
+	WebhookDeliveryOutput struct {
+		ExternalID uuid.UUID
+		CreatedAt  time.Time
+		EventID    uuid.UUID
+		EventType  string
+		Attempt    int
+		Payload    string
+		StatusCode sql.NullInt32
+		Error      sql.NullString
+		Delivered  bool
+	}
+)
+
+type (
+	APIKeyCreateInput struct {
+		Name    string
+		Prefix  string
+		KeyHash string
+		Role    string
+	}
+
+	APIKeyOutput struct {
+		ExternalID  uuid.UUID
+		WorkspaceID uuid.UUID
+		CreatedAt   time.Time
+		Name        string
+		Prefix      string
+		Role        string
+		RevokedAt   sql.NullTime
+	}
+)
+
+type (
+	WorkspaceOutput struct {
+		ExternalID uuid.UUID
+		CreatedAt  time.Time
+		Name       string
+	}
+)
+
+type (
+	UsageCreateInput struct {
+		SummaryExternalID uuid.UUID
+		UserID            uuid.UUID
+		Operation         string
+		Model             string
+		Provider          string
+		PromptTokens      int
+		CompletionTokens  int
+		AudioSeconds      float64
+		Cost              float64
+		ValidationError   string
+	}
+
+	UsageFilter struct {
+		From time.Time
+		To   time.Time
+	}
+
+	UsageAggregateOutput struct {
+		Day              time.Time
+		UserID           uuid.UUID
+		Requests         int64
+		PromptTokens     int64
+		CompletionTokens int64
+		AudioSeconds     float64
+		Cost             float64
+		Rejected         int64
 	}
 )
@@ -3,6 +3,6 @@ package summarize
 import "context"
 
 type Summarize interface {
-	Resume(ctx context.Context, transcription string) (*ResumeOutput, error)
-	FullTextOrganize(ctx context.Context, transcription string) (*string, error)
+	Resume(ctx context.Context, input Input) (*ResumeOutput, error)
+	FullTextOrganize(ctx context.Context, input Input) (*FullTextOutput, error)
 }
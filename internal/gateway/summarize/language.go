@@ -0,0 +1,74 @@
+package summarize
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// minLanguageHits is how many stopwords a text needs before its language is
+// guessed, so a short title is not judged by a single word.
+const minLanguageHits = 3
+
+// stopwords holds frequent words of each language that are not spelled the
+// same in the others, as "que" or "para" say nothing between pt and es.
+var stopwords = map[string]map[string]bool{
+	"pt": words("e", "não", "uma", "com", "os", "da", "dos", "das", "em", "na", "nas", "ao", "aos", "pelo",
+		"pela", "isso", "também", "você", "foi", "são", "mas", "seu", "sua", "ele", "ela", "muito", "já",
+		"então", "nós", "quando", "até", "depois", "ainda"),
+	"en": words("the", "and", "of", "to", "is", "are", "was", "that", "this", "with", "for", "it", "you",
+		"we", "not", "but", "have", "be", "on", "in", "they", "from", "about", "when", "which", "will"),
+	"es": words("y", "el", "los", "las", "del", "una", "con", "es", "pero", "al", "esto", "también",
+		"usted", "fue", "su", "muy", "ya", "entonces", "nosotros", "lo", "cuando", "hasta", "todavía"),
+}
+
+func words(list ...string) map[string]bool {
+	set := map[string]bool{}
+	for _, word := range list {
+		set[word] = true
+	}
+	return set
+}
+
+// DetectLanguage guesses the language of a text by its stopwords. It returns
+// an empty string when the text is too short or the guess is a tie.
+func DetectLanguage(text string) string {
+	hits := map[string]int{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !unicode.IsLetter(r) }) {
+		for language, set := range stopwords {
+			if set[word] {
+				hits[language]++
+			}
+		}
+	}
+
+	detected, best, tie := "", 0, false
+	for language, count := range hits {
+		switch {
+		case count > best:
+			detected, best, tie = language, count, false
+		case count == best:
+			tie = true
+		}
+	}
+
+	if best < minLanguageHits || tie {
+		return ""
+	}
+
+	return detected
+}
+
+// ValidateLanguage checks that the text is written in the language, which is
+// only judged when it can be detected.
+func ValidateLanguage(text, language string) error {
+	if language == "" {
+		return nil
+	}
+
+	if detected := DetectLanguage(text); detected != "" && detected != language {
+		return &ValidationError{Problems: []string{fmt.Sprintf("text is in %s, expected %s", detected, language)}}
+	}
+
+	return nil
+}
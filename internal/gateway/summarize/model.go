@@ -1,8 +1,56 @@
 package summarize
 
+import "strings"
+
+// Input is the transcription to summarize. Rejected is set when the model is
+// asked again for a response that failed validation.
+type Input struct {
+	Transcription string
+	Rejected      *Rejected
+}
+
+// Rejected is a response that failed validation and the problems found in it.
+type Rejected struct {
+	Response string
+	Problems []string
+}
+
+// ResumeOutput declares in its tags the limits sent to the model, which are
+// checked by Validate as providers do not enforce them.
 type ResumeOutput struct {
-	Title        string `json:"title"`
-	Description  string `json:"description"`
-	BriefResume  string `json:"briefResume"`
-	MediumResume string `json:"mediumResume"`
+	Title        string     `json:"title" description:"Título de até 60 caracteres" maxLength:"60"`
+	Description  string     `json:"description" description:"Descrição de até 300 caracteres" maxLength:"300"`
+	BriefResume  string     `json:"briefResume" description:"Resumo breve de até 5 linhas" maxLines:"5"`
+	MediumResume string     `json:"mediumResume" description:"Resumo médio de até 15 linhas" maxLines:"15"`
+	Usage        TokenUsage `json:"-"`
+}
+
+type FullTextOutput struct {
+	Text  string     `json:"text"`
+	Usage TokenUsage `json:"-"`
+}
+
+type TokenUsage struct {
+	Model            string
+	Provider         string
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// Content builds the user message from the prompt and the transcription,
+// followed by the problems of the rejected response so the model fixes them.
+func (i Input) Content(prompt string) string {
+	content := prompt + "\n" + i.Transcription
+	if i.Rejected == nil {
+		return content
+	}
+
+	content += "\n\nA resposta anterior foi rejeitada pelos seguintes problemas: " +
+		strings.Join(i.Rejected.Problems, "; ") + ". Corrija-os na nova resposta."
+
+	if i.Rejected.Response != "" {
+		content += "\nResposta anterior: " + i.Rejected.Response
+	}
+
+	return content
 }
@@ -0,0 +1,147 @@
+package summarize
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// JSONSchema is the subset of JSON Schema accepted by the strict mode of the
+// providers, where every field is required and no other field is allowed.
+type JSONSchema struct {
+	Type                 string                `json:"type"`
+	Description          string                `json:"description,omitempty"`
+	Properties           map[string]JSONSchema `json:"properties,omitempty"`
+	Required             []string              `json:"required,omitempty"`
+	AdditionalProperties *bool                 `json:"additionalProperties,omitempty"`
+}
+
+// ValidationError lists every field of a response that breaks its limits.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid response: " + strings.Join(e.Problems, "; ")
+}
+
+type schemaField struct {
+	name      string
+	index     int
+	kind      reflect.Kind
+	tag       reflect.StructTag
+	maxLength int
+	maxLines  int
+}
+
+// Schema builds the schema of a struct from its json and description tags.
+// The limits are only described, as strict mode rejects maxLength.
+func Schema(v any) JSONSchema {
+	additionalProperties := false
+	schema := JSONSchema{
+		Type:                 "object",
+		Properties:           map[string]JSONSchema{},
+		AdditionalProperties: &additionalProperties,
+	}
+
+	for _, field := range schemaFields(reflect.TypeOf(v)) {
+		schema.Properties[field.name] = JSONSchema{
+			Type:        jsonType(field.kind),
+			Description: field.tag.Get("description"),
+		}
+		schema.Required = append(schema.Required, field.name)
+	}
+
+	return schema
+}
+
+// Validate checks that the string fields of a struct are filled and within
+// their maxLength, in characters, and maxLines tags.
+func Validate(v any) error {
+	value := reflect.Indirect(reflect.ValueOf(v))
+
+	var problems []string
+	for _, field := range schemaFields(value.Type()) {
+		if field.kind != reflect.String {
+			continue
+		}
+
+		text := strings.TrimSpace(value.Field(field.index).String())
+		if text == "" {
+			problems = append(problems, fmt.Sprintf("%s is empty", field.name))
+			continue
+		}
+
+		if length := utf8.RuneCountInString(text); field.maxLength > 0 && length > field.maxLength {
+			problems = append(problems, fmt.Sprintf("%s has %d characters, more than %d", field.name, length, field.maxLength))
+		}
+
+		if lines := countLines(text); field.maxLines > 0 && lines > field.maxLines {
+			problems = append(problems, fmt.Sprintf("%s has %d lines, more than %d", field.name, lines, field.maxLines))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+func schemaFields(t reflect.Type) []schemaField {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var fields []schemaField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		maxLength, _ := strconv.Atoi(field.Tag.Get("maxLength"))
+		maxLines, _ := strconv.Atoi(field.Tag.Get("maxLines"))
+
+		fields = append(fields, schemaField{
+			name:      name,
+			index:     i,
+			kind:      field.Type.Kind(),
+			tag:       field.Tag,
+			maxLength: maxLength,
+			maxLines:  maxLines,
+		})
+	}
+
+	return fields
+}
+
+func jsonType(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	}
+	return "string"
+}
+
+// countLines ignores blank lines, which models use to separate paragraphs.
+func countLines(text string) int {
+	lines := 0
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines++
+		}
+	}
+	return lines
+}
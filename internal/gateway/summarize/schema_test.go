@@ -0,0 +1,104 @@
+package summarize
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SchemaTestSuite struct {
+	suite.Suite
+}
+
+func TestSchema(t *testing.T) {
+	suite.Run(t, new(SchemaTestSuite))
+}
+
+func (s *SchemaTestSuite) TestSchema() {
+	schema := Schema(ResumeOutput{})
+
+	s.Equal("object", schema.Type)
+	s.Equal([]string{"title", "description", "briefResume", "mediumResume"}, schema.Required)
+	s.False(*schema.AdditionalProperties)
+	s.Equal(JSONSchema{Type: "string", Description: "Resumo breve de até 5 linhas"}, schema.Properties["briefResume"])
+	s.NotContains(schema.Properties, "Usage")
+}
+
+func (s *SchemaTestSuite) TestValidate() {
+	valid := ResumeOutput{
+		Title:        strings.Repeat("é", 60),
+		Description:  "description",
+		BriefResume:  "one\n\ntwo\nthree\nfour\nfive\n",
+		MediumResume: "medium",
+	}
+
+	s.Run("accept the limits counted in characters and filled lines", func() {
+		s.NoError(Validate(valid))
+		s.NoError(Validate(&valid))
+	})
+
+	s.Run("list every problem", func() {
+		output := valid
+		output.Title = strings.Repeat("é", 61)
+		output.Description = " "
+		output.BriefResume = "1\n2\n3\n4\n5\n6"
+
+		err := Validate(output)
+
+		var validationError *ValidationError
+		s.Require().ErrorAs(err, &validationError)
+		s.Equal([]string{
+			"title has 61 characters, more than 60",
+			"description is empty",
+			"briefResume has 6 lines, more than 5",
+		}, validationError.Problems)
+	})
+}
+
+func (s *SchemaTestSuite) TestValidateLanguage() {
+	cases := map[string]struct {
+		text string
+		err  string
+	}{
+		"text in the expected language": {
+			text: "A reunião tratou do orçamento e também das metas, mas não houve acordo sobre o prazo.",
+		},
+		"text in another language": {
+			text: "The meeting was about the budget and the goals, but there was no agreement on the deadline.",
+			err:  "invalid response: text is in en, expected pt",
+		},
+		"text in a close language": {
+			text: "La reunión fue sobre el presupuesto y las metas, pero no hubo acuerdo con los plazos.",
+			err:  "invalid response: text is in es, expected pt",
+		},
+		"text too short to judge": {
+			text: "Orçamento 2025",
+		},
+	}
+
+	for name, c := range cases {
+		s.Run(name, func() {
+			err := ValidateLanguage(c.text, "pt")
+			if c.err == "" {
+				s.NoError(err)
+				return
+			}
+			s.EqualError(err, c.err)
+		})
+	}
+
+	s.Run("skip the check without a language", func() {
+		s.NoError(ValidateLanguage("The meeting was about the budget and the goals.", ""))
+	})
+}
+
+func (s *SchemaTestSuite) TestInputContent() {
+	input := Input{Transcription: "transcription"}
+	s.Equal("prompt\ntranscription", input.Content("prompt"))
+
+	input.Rejected = &Rejected{Response: `{"title":""}`, Problems: []string{"title is empty", "description is empty"}}
+	s.Equal("prompt\ntranscription\n\n"+
+		"A resposta anterior foi rejeitada pelos seguintes problemas: title is empty; description is empty. Corrija-os na nova resposta.\n"+
+		`Resposta anterior: {"title":""}`, input.Content("prompt"))
+}
@@ -0,0 +1,18 @@
+package upload
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+type UploadStore interface {
+	CreateUpload(ctx context.Context, info UploadInfo) error
+	GetUpload(ctx context.Context, id uuid.UUID) (*UploadInfo, error)
+	UpdateUpload(ctx context.Context, info UploadInfo) error
+	WriteChunk(ctx context.Context, id uuid.UUID, offset int64, content io.Reader) (int64, error)
+	ReadUpload(ctx context.Context, id uuid.UUID) (io.ReadSeekCloser, error)
+	DeleteUploadContent(ctx context.Context, id uuid.UUID) error
+	DeleteUpload(ctx context.Context, id uuid.UUID) error
+}
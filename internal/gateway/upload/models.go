@@ -0,0 +1,18 @@
+package upload
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UploadInfo struct {
+	ID                uuid.UUID         `json:"id"`
+	WorkspaceID       uuid.UUID         `json:"workspaceId"`
+	OwnerID           uuid.UUID         `json:"ownerId"`
+	Length            int64             `json:"length"`
+	Offset            int64             `json:"-"`
+	Metadata          map[string]string `json:"metadata,omitempty"`
+	SummaryExternalID uuid.UUID         `json:"summaryExternalId"`
+	CreatedAt         time.Time         `json:"createdAt"`
+}
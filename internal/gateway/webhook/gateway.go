@@ -0,0 +1,7 @@
+package webhook
+
+import "context"
+
+type Sender interface {
+	Send(ctx context.Context, input SendInput) (*SendOutput, error)
+}
@@ -0,0 +1,14 @@
+package webhook
+
+type (
+	SendInput struct {
+		URL     string
+		Payload []byte
+		Headers map[string]string
+	}
+
+	SendOutput struct {
+		StatusCode int
+		Body       []byte
+	}
+)
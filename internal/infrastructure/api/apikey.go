@@ -0,0 +1,72 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/errors"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type APIKeyServer struct {
+	apiKey service.APIKeyUseCase
+}
+
+func NewAPIKeyServer(apiKey service.APIKeyUseCase) *APIKeyServer {
+	return &APIKeyServer{
+		apiKey: apiKey,
+	}
+}
+
+func (api *APIKeyServer) Register(server *echo.Echo) {
+	server.POST("/api-keys", api.CreateAPIKey)
+	server.GET("/api-keys", api.ListAPIKeys)
+	server.DELETE("/api-keys/:externalId", api.RevokeAPIKey)
+}
+
+func (api *APIKeyServer) CreateAPIKey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input service.APIKeyCreateInput
+	if err := c.Bind(&input); err != nil {
+		return errors.Handle(c, application.InvalidAPIKey)
+	}
+
+	result, err := api.apiKey.CreateAPIKey(ctx, input)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, result)
+}
+
+func (api *APIKeyServer) ListAPIKeys(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	result, err := api.apiKey.ListAPIKeys(ctx)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func (api *APIKeyServer) RevokeAPIKey(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	parsedExternalID, err := uuid.Parse(c.Param("externalId"))
+	if err != nil {
+		return errors.Handle(c, application.ExternalIDIsInvalid)
+	}
+
+	err = api.apiKey.RevokeAPIKey(ctx, parsedExternalID)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "api key has been revoked",
+	})
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	servicemocks "github.com/diegofsousa/explicAI/internal/application/service/mocks"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	apiKeyExternalIDStr  = "6c1f9a3b-2d4e-4f5a-8b7c-9e0d1a2b3c4d"
+	apiKeyExternalIDUUID = uuid.MustParse(apiKeyExternalIDStr)
+)
+
+func (s *ControllerTestSuite) TestCreateAPIKey() {
+	s.Run("successful create api key", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodPost, "/api-keys", strings.NewReader(`{"name":"ci","role":"admin"}`))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		apiKey := new(servicemocks.APIKeyUseCase)
+		apiKey.EXPECT().
+			CreateAPIKey(mock.Anything, service.APIKeyCreateInput{Name: "ci", Role: "admin"}).
+			Return(&service.APIKeyOutput{
+				ExternalID: apiKeyExternalIDUUID,
+				Name:       "ci",
+				Prefix:     "eai_0123abcd",
+				Role:       "admin",
+				Key:        "eai_0123abcdef",
+			}, nil)
+
+		handler := NewAPIKeyServer(apiKey)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusCreated, recorder.Code)
+		s.Contains(recorder.Body.String(), `"key":"eai_0123abcdef"`)
+	})
+
+	s.Run("invalid api key body", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodPost, "/api-keys", strings.NewReader(`{"name":`))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		handler := NewAPIKeyServer(new(servicemocks.APIKeyUseCase))
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("create api key without admin key", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodPost, "/api-keys", strings.NewReader(`{"name":"ci"}`))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		apiKey := new(servicemocks.APIKeyUseCase)
+		apiKey.EXPECT().
+			CreateAPIKey(mock.Anything, mock.Anything).
+			Return(nil, application.Forbidden)
+
+		handler := NewAPIKeyServer(apiKey)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusForbidden, recorder.Code)
+	})
+}
+
+func (s *ControllerTestSuite) TestListAPIKeys() {
+	s.Run("successful list api keys", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/api-keys", nil)
+		recorder := httptest.NewRecorder()
+
+		apiKey := new(servicemocks.APIKeyUseCase)
+		apiKey.EXPECT().
+			ListAPIKeys(mock.Anything).
+			Return(&service.APIKeyListOutput{
+				Data: []service.APIKeyOutput{{ExternalID: apiKeyExternalIDUUID, Name: "ci"}},
+			}, nil)
+
+		handler := NewAPIKeyServer(apiKey)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), apiKeyExternalIDStr)
+		s.NotContains(recorder.Body.String(), `"key"`)
+	})
+}
+
+func (s *ControllerTestSuite) TestRevokeAPIKey() {
+	s.Run("successful revoke api key", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodDelete, "/api-keys/"+apiKeyExternalIDStr, nil)
+		recorder := httptest.NewRecorder()
+
+		apiKey := new(servicemocks.APIKeyUseCase)
+		apiKey.EXPECT().
+			RevokeAPIKey(mock.Anything, apiKeyExternalIDUUID).
+			Return(nil)
+
+		handler := NewAPIKeyServer(apiKey)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+	})
+
+	s.Run("revoke unknown api key", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodDelete, "/api-keys/"+apiKeyExternalIDStr, nil)
+		recorder := httptest.NewRecorder()
+
+		apiKey := new(servicemocks.APIKeyUseCase)
+		apiKey.EXPECT().
+			RevokeAPIKey(mock.Anything, apiKeyExternalIDUUID).
+			Return(application.APIKeyNotFound)
+
+		handler := NewAPIKeyServer(apiKey)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusNotFound, recorder.Code)
+	})
+
+	s.Run("invalid external id format", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodDelete, "/api-keys/invalid-uuid", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := NewAPIKeyServer(new(servicemocks.APIKeyUseCase))
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+}
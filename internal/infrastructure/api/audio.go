@@ -0,0 +1,49 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/errors"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type AudioServer struct {
+	audio service.AudioUseCase
+}
+
+func NewAudioServer(audio service.AudioUseCase) *AudioServer {
+	return &AudioServer{
+		audio: audio,
+	}
+}
+
+func (api *AudioServer) Register(server *echo.Echo) {
+	server.GET("/summaries/:externalId/audio", api.GetSummaryAudio)
+}
+
+// GetSummaryAudio serves the original audio, answering range requests with
+// partial content so players can seek without downloading the whole file.
+func (api *AudioServer) GetSummaryAudio(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	parsedExternalID, err := uuid.Parse(c.Param("externalId"))
+	if err != nil {
+		return errors.Handle(c, application.ExternalIDIsInvalid)
+	}
+
+	result, err := api.audio.GetSummaryAudio(ctx, parsedExternalID)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+	defer result.Content.Close()
+
+	if result.ContentType != "" {
+		c.Response().Header().Set(echo.HeaderContentType, result.ContentType)
+	}
+
+	http.ServeContent(c.Response(), c.Request(), "", result.ModTime, result.Content)
+	return nil
+}
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	servicemocks "github.com/diegofsousa/explicAI/internal/application/service/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+)
+
+type readSeekNopCloser struct {
+	io.ReadSeeker
+}
+
+func (readSeekNopCloser) Close() error { return nil }
+
+func (s *ControllerTestSuite) TestGetSummaryAudio() {
+	audioOutput := func() *service.AudioOutput {
+		return &service.AudioOutput{
+			Content:     readSeekNopCloser{strings.NewReader("0123456789")},
+			ContentType: "audio/mpeg",
+			Size:        10,
+		}
+	}
+
+	s.Run("successful get audio", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/audio", nil)
+		recorder := httptest.NewRecorder()
+
+		audio := new(servicemocks.AudioUseCase)
+		audio.EXPECT().
+			GetSummaryAudio(mock.Anything, summaryExternalIDUUID).
+			Return(audioOutput(), nil)
+
+		handler := NewAudioServer(audio)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal("audio/mpeg", recorder.Header().Get(echo.HeaderContentType))
+		s.Equal("bytes", recorder.Header().Get("Accept-Ranges"))
+		s.Equal("0123456789", recorder.Body.String())
+	})
+
+	s.Run("successful get audio range", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/audio", nil)
+		request.Header.Set("Range", "bytes=2-5")
+		recorder := httptest.NewRecorder()
+
+		audio := new(servicemocks.AudioUseCase)
+		audio.EXPECT().
+			GetSummaryAudio(mock.Anything, summaryExternalIDUUID).
+			Return(audioOutput(), nil)
+
+		handler := NewAudioServer(audio)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusPartialContent, recorder.Code)
+		s.Equal("bytes 2-5/10", recorder.Header().Get("Content-Range"))
+		s.Equal("2345", recorder.Body.String())
+	})
+
+	s.Run("unsatisfiable range", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/audio", nil)
+		request.Header.Set("Range", "bytes=20-")
+		recorder := httptest.NewRecorder()
+
+		audio := new(servicemocks.AudioUseCase)
+		audio.EXPECT().
+			GetSummaryAudio(mock.Anything, summaryExternalIDUUID).
+			Return(audioOutput(), nil)
+
+		handler := NewAudioServer(audio)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusRequestedRangeNotSatisfiable, recorder.Code)
+	})
+
+	s.Run("audio not found", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/audio", nil)
+		recorder := httptest.NewRecorder()
+
+		audio := new(servicemocks.AudioUseCase)
+		audio.EXPECT().
+			GetSummaryAudio(mock.Anything, summaryExternalIDUUID).
+			Return(nil, application.AudioNotFound)
+
+		handler := NewAudioServer(audio)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusNotFound, recorder.Code)
+	})
+
+	s.Run("invalid external id", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/invalid/audio", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := NewAudioServer(new(servicemocks.AudioUseCase))
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+}
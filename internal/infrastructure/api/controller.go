@@ -1,14 +1,18 @@
 package api
 
 import (
-	"bytes"
 	"context"
+	stderrors "errors"
 	"io"
+	"math"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/audioformat"
 	"github.com/diegofsousa/explicAI/internal/application/service"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/errors"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
@@ -16,18 +20,25 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// multipartOverhead bounds the bytes of an upload request besides the file
+// itself, such as boundaries and part headers.
+const multipartOverhead = 1 << 20
+
 type ExplicaServer struct {
 	summary service.SummaryUseCase
+	maxSize int64
 }
 
-func NewExplicaServer(summary service.SummaryUseCase) *ExplicaServer {
+func NewExplicaServer(summary service.SummaryUseCase, maxSize int64) *ExplicaServer {
 	return &ExplicaServer{
 		summary: summary,
+		maxSize: maxSize,
 	}
 }
 
 func (api *ExplicaServer) Register(server *echo.Echo) {
 	server.POST("/upload", api.Upload)
+	server.POST("/uploads/from-url", api.UploadFromURL)
 	server.GET("/summaries", api.ListSummaries)
 	server.GET("/summaries/:externalId", api.GetSummaryByExternalID)
 	server.DELETE("/summaries/:externalId", api.DeleteSummaryByExternalID)
@@ -35,23 +46,54 @@ func (api *ExplicaServer) Register(server *echo.Echo) {
 
 func (api *ExplicaServer) Upload(c echo.Context) error {
 	ctx := c.Request().Context()
-	file, err := api.getFileFromRequest(ctx, c)
+	file, filename, size, err := api.getFileFromRequest(ctx, c)
 	if err != nil {
 		return errors.Handle(c, err)
 	}
+	defer os.Remove(file.Name())
+	defer file.Close()
 
-	result, err := api.summary.CreateSummaryAndTriggerAIProccess(ctx, file)
+	result, err := api.summary.CreateSummaryAndTriggerAIProccess(ctx, service.SummaryAudioInput{
+		Content:  file,
+		Size:     size,
+		Filename: filename,
+	})
 	if err != nil {
 		return errors.Handle(c, err)
 	}
 
+	if result.Duplicate {
+		return c.JSON(http.StatusOK, result)
+	}
+
 	return c.JSON(http.StatusCreated, result)
 }
 
+func (api *ExplicaServer) UploadFromURL(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input service.SummaryFromURLInput
+	if err := c.Bind(&input); err != nil {
+		return errors.Handle(c, application.InvalidDownloadURL)
+	}
+
+	result, err := api.summary.CreateSummaryFromURL(ctx, input)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, result)
+}
+
 func (api *ExplicaServer) ListSummaries(c echo.Context) error {
 	ctx := c.Request().Context()
 
-	result, err := api.summary.ListSummaries(ctx)
+	filter, err := getSummaryFilterFromRequest(c)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	result, err := api.summary.ListSummaries(ctx, filter)
 	if err != nil {
 		return errors.Handle(c, err)
 	}
@@ -95,40 +137,115 @@ func (api *ExplicaServer) DeleteSummaryByExternalID(c echo.Context) error {
 	})
 }
 
-func (api *ExplicaServer) getFileFromRequest(ctx context.Context, c echo.Context) ([]byte, error) {
-	file, err := c.FormFile("file")
+// getFileFromRequest streams the file part of the multipart body to a
+// temporary file, rejecting it as soon as it exceeds the maximum size. The
+// caller must close and remove the returned file.
+func (api *ExplicaServer) getFileFromRequest(ctx context.Context, c echo.Context) (*os.File, string, int64, error) {
+	request := c.Request()
+	if api.maxSize > 0 {
+		request.Body = http.MaxBytesReader(c.Response(), request.Body, api.maxSize+multipartOverhead)
+	}
+
+	reader, err := request.MultipartReader()
 	if err != nil {
 		log.LogError(ctx, "missing file", err)
-		return nil, application.MissingFile
+		return nil, "", 0, application.MissingFile
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil, "", 0, application.MissingFile
+		}
+
+		if err != nil {
+			return nil, "", 0, uploadReadError(ctx, err)
+		}
+
+		if part.FormName() != "file" || part.FileName() == "" {
+			continue
+		}
+
+		if !audioformat.IsSupportedExtension(filepath.Ext(part.FileName())) {
+			return nil, "", 0, application.InvalidFile
+		}
+
+		file, size, err := api.copyToTempFile(ctx, part)
+		return file, part.FileName(), size, err
 	}
+}
 
-	allowedExtensions := map[string]bool{
-		".mp3":  true,
-		".mp4":  true,
-		".mpeg": true,
-		".mpga": true,
-		".m4a":  true,
-		".wav":  true,
-		".webm": true,
+func (api *ExplicaServer) copyToTempFile(ctx context.Context, content io.Reader) (*os.File, int64, error) {
+	file, err := os.CreateTemp("", "explicai-upload-*")
+	if err != nil {
+		log.LogError(ctx, "fail to create temporary file", err)
+		return nil, 0, application.FailedReadFile
 	}
 
-	fileExtension := strings.ToLower(filepath.Ext(file.Filename))
-	if !allowedExtensions[fileExtension] {
-		return nil, application.InvalidFile
+	discard := func(err error) (*os.File, int64, error) {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, 0, err
 	}
 
-	src, err := file.Open()
+	limit := api.maxSize
+	if limit <= 0 {
+		limit = math.MaxInt64 - 1
+	}
+
+	size, err := io.Copy(file, io.LimitReader(content, limit+1))
 	if err != nil {
-		log.LogError(ctx, "fail to open file", err)
-		return nil, application.FailedReadFile
+		return discard(uploadReadError(ctx, err))
+	}
+
+	if size > limit {
+		return discard(application.UploadTooLarge)
 	}
-	defer src.Close()
 
-	var buf bytes.Buffer
-	if _, err := io.Copy(&buf, src); err != nil {
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
 		log.LogError(ctx, "fail to read file", err)
-		return nil, application.FailedReadFile
+		return discard(application.FailedReadFile)
+	}
+
+	return file, size, nil
+}
+
+func uploadReadError(ctx context.Context, err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if stderrors.As(err, &maxBytesErr) {
+		return application.UploadTooLarge
+	}
+
+	log.LogError(ctx, "fail to read file", err)
+	return application.FailedReadFile
+}
+
+func getSummaryFilterFromRequest(c echo.Context) (service.SummaryFilterInput, error) {
+	createdFrom, err := parseFilterDate(c.QueryParam("from"))
+	if err != nil {
+		return service.SummaryFilterInput{}, application.InvalidSummaryFilter
+	}
+
+	createdTo, err := parseFilterDate(c.QueryParam("to"))
+	if err != nil {
+		return service.SummaryFilterInput{}, application.InvalidSummaryFilter
+	}
+
+	return service.SummaryFilterInput{
+		Status:      strings.ToUpper(c.QueryParam("status")),
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+	}, nil
+}
+
+func parseFilterDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
 	}
 
-	return buf.Bytes(), nil
+	return time.Parse(time.DateOnly, value)
 }
@@ -69,7 +69,9 @@ func (s *ControllerTestSuite) TestUpload() {
 
 		s.summary = new(servicemocks.SummaryUseCase)
 		s.summary.EXPECT().
-			CreateSummaryAndTriggerAIProccess(mock.Anything, mock.Anything).
+			CreateSummaryAndTriggerAIProccess(mock.Anything, mock.MatchedBy(func(input service.SummaryAudioInput) bool {
+				return input.Size == int64(len("test file content"))
+			})).
 			Return(&service.SummarySimpleOutput{
 				ExternalID: summaryExternalIDUUID,
 				Status:     "RECEIVED_FILE",
@@ -78,7 +80,7 @@ func (s *ControllerTestSuite) TestUpload() {
 				Progress:   33,
 			}, nil)
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(ctx.Echo())
 		e.ServeHTTP(recorder, request)
 
@@ -93,6 +95,45 @@ func (s *ControllerTestSuite) TestUpload() {
 		s.Equal(33, response.Progress)
 	})
 
+	s.Run("duplicated audio returns the existing summary", func() {
+		e := echo.New()
+
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+
+		part, err := writer.CreateFormFile("file", "test.mp3")
+		s.Require().NoError(err)
+		_, err = part.Write([]byte("test file content"))
+		s.Require().NoError(err)
+		writer.Close()
+
+		request := httptest.NewRequest(http.MethodPost, "/upload", body)
+		request.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		recorder := httptest.NewRecorder()
+
+		s.summary = new(servicemocks.SummaryUseCase)
+		s.summary.EXPECT().
+			CreateSummaryAndTriggerAIProccess(mock.Anything, mock.Anything).
+			Return(&service.SummarySimpleOutput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     "SUMMARIZED",
+				Progress:   100,
+				Duplicate:  true,
+			}, nil)
+
+		handler := NewExplicaServer(s.summary, 100)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		var response service.SummarySimpleOutput
+		err = json.Unmarshal(recorder.Body.Bytes(), &response)
+		s.Require().NoError(err)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(summaryExternalIDUUID, response.ExternalID)
+		s.True(response.Duplicate)
+	})
+
 	s.Run("failed create summary", func() {
 		e := echo.New()
 
@@ -117,7 +158,7 @@ func (s *ControllerTestSuite) TestUpload() {
 			CreateSummaryAndTriggerAIProccess(mock.Anything, mock.Anything).
 			Return(nil, errors.New("some error"))
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(ctx.Echo())
 		e.ServeHTTP(recorder, request)
 		handler.Upload(ctx)
@@ -148,7 +189,7 @@ func (s *ControllerTestSuite) TestUpload() {
 		ctx := e.NewContext(request, recorder)
 		ctx.SetPath("/upload")
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(ctx.Echo())
 		e.ServeHTTP(recorder, request)
 		handler.Upload(ctx)
@@ -160,6 +201,61 @@ func (s *ControllerTestSuite) TestUpload() {
 		s.Equal(http.StatusBadRequest, recorder.Code)
 	})
 
+	s.Run("audio content does not match the extension", func() {
+		e := echo.New()
+
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+
+		part, err := writer.CreateFormFile("file", "test.wav")
+		s.Require().NoError(err)
+		_, err = part.Write([]byte("ID3"))
+		s.Require().NoError(err)
+		writer.Close()
+
+		request := httptest.NewRequest(http.MethodPost, "/upload", body)
+		request.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		recorder := httptest.NewRecorder()
+
+		s.summary = new(servicemocks.SummaryUseCase)
+		s.summary.EXPECT().
+			CreateSummaryAndTriggerAIProccess(mock.Anything, mock.Anything).
+			Return(nil, application.AudioFormatMismatch)
+
+		handler := NewExplicaServer(s.summary, 100)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+		s.Contains(recorder.Body.String(), application.AudioFormatMismatch.Error())
+	})
+
+	s.Run("file above the maximum size", func() {
+		e := echo.New()
+
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+
+		part, err := writer.CreateFormFile("file", "test.mp3")
+		s.Require().NoError(err)
+		_, err = part.Write(bytes.Repeat([]byte("a"), 101))
+		s.Require().NoError(err)
+		writer.Close()
+
+		request := httptest.NewRequest(http.MethodPost, "/upload", body)
+		request.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		recorder := httptest.NewRecorder()
+
+		s.summary = new(servicemocks.SummaryUseCase)
+
+		handler := NewExplicaServer(s.summary, 100)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusRequestEntityTooLarge, recorder.Code)
+		s.summary.AssertNotCalled(s.T(), "CreateSummaryAndTriggerAIProccess", mock.Anything, mock.Anything)
+	})
+
 	s.Run("failed missing file", func() {
 		e := echo.New()
 
@@ -170,7 +266,7 @@ func (s *ControllerTestSuite) TestUpload() {
 		ctx := e.NewContext(request, recorder)
 		ctx.SetPath("/upload")
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(e)
 		e.ServeHTTP(recorder, request)
 		handler.Upload(ctx)
@@ -182,6 +278,76 @@ func (s *ControllerTestSuite) TestUpload() {
 	})
 }
 
+func (s *ControllerTestSuite) TestUploadFromURL() {
+	s.Run("successful create summary from url", func() {
+		e := echo.New()
+
+		body := bytes.NewBufferString(`{"url":"https://example.com/meeting.mp3"}`)
+		request := httptest.NewRequest(http.MethodPost, "/uploads/from-url", body)
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		s.summary = new(servicemocks.SummaryUseCase)
+		s.summary.EXPECT().
+			CreateSummaryFromURL(mock.Anything, service.SummaryFromURLInput{URL: "https://example.com/meeting.mp3"}).
+			Return(&service.SummarySimpleOutput{
+				ExternalID: summaryExternalIDUUID,
+				Status:     "DOWNLOADING",
+				CreatedAt:  createdAt,
+				UpdatedAt:  createdAt,
+			}, nil)
+
+		handler := NewExplicaServer(s.summary, 100)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		var response service.SummarySimpleOutput
+		err := json.Unmarshal(recorder.Body.Bytes(), &response)
+		s.Require().NoError(err)
+
+		s.Equal(http.StatusAccepted, recorder.Code)
+		s.Equal(summaryExternalIDUUID, response.ExternalID)
+		s.Equal("DOWNLOADING", response.Status)
+	})
+
+	s.Run("invalid url", func() {
+		e := echo.New()
+
+		body := bytes.NewBufferString(`{"url":"ftp://example.com/meeting.mp3"}`)
+		request := httptest.NewRequest(http.MethodPost, "/uploads/from-url", body)
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		s.summary = new(servicemocks.SummaryUseCase)
+		s.summary.EXPECT().
+			CreateSummaryFromURL(mock.Anything, mock.Anything).
+			Return(nil, application.InvalidDownloadURL)
+
+		handler := NewExplicaServer(s.summary, 100)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("invalid body", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodPost, "/uploads/from-url", bytes.NewBufferString(`{"url":`))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		s.summary = new(servicemocks.SummaryUseCase)
+
+		handler := NewExplicaServer(s.summary, 100)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+		s.summary.AssertNotCalled(s.T(), "CreateSummaryFromURL", mock.Anything, mock.Anything)
+	})
+}
+
 func (s *ControllerTestSuite) TestListSummaries() {
 	s.Run("successful list summaries", func() {
 		e := echo.New()
@@ -206,10 +372,10 @@ func (s *ControllerTestSuite) TestListSummaries() {
 
 		s.summary = new(servicemocks.SummaryUseCase)
 		s.summary.EXPECT().
-			ListSummaries(mock.Anything).
+			ListSummaries(mock.Anything, service.SummaryFilterInput{}).
 			Return(&service.SummaryListOutput{Data: expected}, nil)
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(ctx.Echo())
 		e.ServeHTTP(recorder, request)
 
@@ -239,10 +405,10 @@ func (s *ControllerTestSuite) TestListSummaries() {
 
 		s.summary = new(servicemocks.SummaryUseCase)
 		s.summary.EXPECT().
-			ListSummaries(mock.Anything).
+			ListSummaries(mock.Anything, service.SummaryFilterInput{}).
 			Return(nil, errors.New("some error"))
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(ctx.Echo())
 		e.ServeHTTP(recorder, request)
 
@@ -250,6 +416,63 @@ func (s *ControllerTestSuite) TestListSummaries() {
 	})
 }
 
+func (s *ControllerTestSuite) TestListSummariesWithFilter() {
+	s.Run("successful list summaries with filter", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet,
+			"/summaries?status=summarized&from=2025-01-25&to=2025-01-26T00:00:00Z", nil)
+		recorder := httptest.NewRecorder()
+
+		s.summary = new(servicemocks.SummaryUseCase)
+		s.summary.EXPECT().
+			ListSummaries(mock.Anything, service.SummaryFilterInput{
+				Status:      "SUMMARIZED",
+				CreatedFrom: time.Date(2025, 1, 25, 0, 0, 0, 0, time.UTC),
+				CreatedTo:   time.Date(2025, 1, 26, 0, 0, 0, 0, time.UTC),
+			}).
+			Return(&service.SummaryListOutput{}, nil)
+
+		handler := NewExplicaServer(s.summary, 100)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.summary.AssertExpectations(s.T())
+	})
+
+	s.Run("invalid date filter", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries?from=25/01/2025", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := NewExplicaServer(new(servicemocks.SummaryUseCase), 100)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("invalid status filter", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries?status=unknown", nil)
+		recorder := httptest.NewRecorder()
+
+		s.summary = new(servicemocks.SummaryUseCase)
+		s.summary.EXPECT().
+			ListSummaries(mock.Anything, service.SummaryFilterInput{Status: "UNKNOWN"}).
+			Return(nil, application.InvalidSummaryFilter)
+
+		handler := NewExplicaServer(s.summary, 100)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+}
+
 func (s *ControllerTestSuite) TestGetSummaryByExternalID() {
 	s.Run("successful get summary", func() {
 		e := echo.New()
@@ -280,7 +503,7 @@ func (s *ControllerTestSuite) TestGetSummaryByExternalID() {
 			GetSummaryByExternalID(mock.Anything, summaryExternalIDUUID).
 			Return(expected, nil)
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(e)
 		e.ServeHTTP(recorder, request)
 
@@ -311,7 +534,7 @@ func (s *ControllerTestSuite) TestGetSummaryByExternalID() {
 		ctx.SetParamNames("externalId")
 		ctx.SetParamValues(invalidID)
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(e)
 		e.ServeHTTP(recorder, request)
 
@@ -334,7 +557,7 @@ func (s *ControllerTestSuite) TestGetSummaryByExternalID() {
 			GetSummaryByExternalID(mock.Anything, summaryExternalIDUUID).
 			Return(nil, application.SummaryNotFound)
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(e)
 		e.ServeHTTP(recorder, request)
 
@@ -357,7 +580,7 @@ func (s *ControllerTestSuite) TestGetSummaryByExternalID() {
 			GetSummaryByExternalID(mock.Anything, summaryExternalIDUUID).
 			Return(nil, errors.New("service error"))
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(e)
 		e.ServeHTTP(recorder, request)
 
@@ -382,7 +605,7 @@ func (s *ControllerTestSuite) TestDeleteSummaryByExternalID() {
 			DeleteSummaryByExternalID(mock.Anything, summaryExternalIDUUID).
 			Return(nil)
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(e)
 		e.ServeHTTP(recorder, request)
 
@@ -404,7 +627,7 @@ func (s *ControllerTestSuite) TestDeleteSummaryByExternalID() {
 		ctx.SetParamNames("externalId")
 		ctx.SetParamValues(summaryExternalIDStr)
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(e)
 		e.ServeHTTP(recorder, request)
 
@@ -427,7 +650,7 @@ func (s *ControllerTestSuite) TestDeleteSummaryByExternalID() {
 			DeleteSummaryByExternalID(mock.Anything, summaryExternalIDUUID).
 			Return(application.SummaryNotFound)
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(e)
 		e.ServeHTTP(recorder, request)
 
@@ -450,7 +673,7 @@ func (s *ControllerTestSuite) TestDeleteSummaryByExternalID() {
 			DeleteSummaryByExternalID(mock.Anything, summaryExternalIDUUID).
 			Return(errors.New("some error"))
 
-		handler := NewExplicaServer(s.summary)
+		handler := NewExplicaServer(s.summary, 100)
 		handler.Register(e)
 		e.ServeHTTP(recorder, request)
 
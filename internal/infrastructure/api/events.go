@@ -0,0 +1,106 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/errors"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	mimeTextEventStream = "text/event-stream"
+)
+
+type EventServer struct {
+	events    service.EventUseCase
+	heartbeat time.Duration
+}
+
+func NewEventServer(events service.EventUseCase, heartbeat time.Duration) *EventServer {
+	return &EventServer{
+		events:    events,
+		heartbeat: heartbeat,
+	}
+}
+
+func (api *EventServer) Register(server *echo.Echo) {
+	server.GET("/events", api.StreamEvents)
+	server.GET("/summaries/:externalId/events", api.StreamSummaryEvents)
+}
+
+func (api *EventServer) StreamEvents(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	subscription, err := api.events.SubscribeEvents(ctx)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return api.stream(c, subscription)
+}
+
+func (api *EventServer) StreamSummaryEvents(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	parsedExternalID, err := uuid.Parse(c.Param("externalId"))
+	if err != nil {
+		return errors.Handle(c, application.ExternalIDIsInvalid)
+	}
+
+	subscription, err := api.events.SubscribeSummaryEvents(ctx, parsedExternalID)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return api.stream(c, subscription)
+}
+
+// stream writes the subscription as Server-Sent Events until the client
+// disconnects or the subscription ends, sending a comment line as heartbeat
+// so proxies keep the connection open.
+func (api *EventServer) stream(c echo.Context, subscription *service.EventSubscriptionOutput) error {
+	defer subscription.Close()
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, mimeTextEventStream)
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	heartbeat := time.NewTicker(api.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(response, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			response.Flush()
+		case event, ok := <-subscription.Events:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(event)
+			if err != nil {
+				return nil
+			}
+
+			if _, err = fmt.Fprintf(response, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return nil
+			}
+			response.Flush()
+		}
+	}
+}
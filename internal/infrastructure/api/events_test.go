@@ -0,0 +1,135 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	servicemocks "github.com/diegofsousa/explicAI/internal/application/service/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+)
+
+func closedSubscription(items ...events.Event) (*service.EventSubscriptionOutput, *bool) {
+	source := make(chan events.Event, len(items))
+	for _, item := range items {
+		source <- item
+	}
+	close(source)
+
+	closed := false
+	return &service.EventSubscriptionOutput{
+		Events: source,
+		Close:  func() { closed = true },
+	}, &closed
+}
+
+func (s *ControllerTestSuite) TestStreamSummaryEvents() {
+	s.Run("successful stream summary events", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/events", nil)
+		recorder := httptest.NewRecorder()
+
+		eventID := uuid.MustParse("5f0c3a7e-1f7b-4b43-9b65-2f1f4f8f7e11")
+		subscription, closed := closedSubscription(events.Event{
+			ID:   eventID,
+			Type: events.SummaryStatusChanged,
+			Summary: &events.SummaryData{
+				ExternalID: summaryExternalIDUUID,
+				Status:     "SUMMARIZED",
+				Progress:   100,
+			},
+		})
+
+		eventUseCase := new(servicemocks.EventUseCase)
+		eventUseCase.EXPECT().
+			SubscribeSummaryEvents(mock.Anything, summaryExternalIDUUID).
+			Return(subscription, nil)
+
+		handler := NewEventServer(eventUseCase, time.Minute)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal("text/event-stream", recorder.Header().Get(echo.HeaderContentType))
+		s.Equal("no-cache", recorder.Header().Get(echo.HeaderCacheControl))
+
+		body := recorder.Body.String()
+		s.True(strings.HasPrefix(body, "id: "+eventID.String()+"\nevent: summary.status_changed\ndata: {"))
+		s.Contains(body, `"status":"SUMMARIZED"`)
+		s.True(strings.HasSuffix(body, "\n\n"))
+		s.True(*closed)
+	})
+
+	s.Run("invalid external id format", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/invalid-uuid/events", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := NewEventServer(new(servicemocks.EventUseCase), time.Minute)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("summary not found", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/events", nil)
+		recorder := httptest.NewRecorder()
+
+		eventUseCase := new(servicemocks.EventUseCase)
+		eventUseCase.EXPECT().
+			SubscribeSummaryEvents(mock.Anything, summaryExternalIDUUID).
+			Return(nil, application.SummaryNotFound)
+
+		handler := NewEventServer(eventUseCase, time.Minute)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusNotFound, recorder.Code)
+	})
+}
+
+func (s *ControllerTestSuite) TestStreamEvents() {
+	s.Run("successful stream events with heartbeat", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/events", nil)
+		recorder := httptest.NewRecorder()
+
+		source := make(chan events.Event)
+		closed := false
+
+		eventUseCase := new(servicemocks.EventUseCase)
+		eventUseCase.EXPECT().
+			SubscribeEvents(mock.Anything).
+			Return(&service.EventSubscriptionOutput{
+				Events: source,
+				Close:  func() { closed = true },
+			}, nil)
+
+		go func() {
+			time.Sleep(30 * time.Millisecond)
+			source <- events.Event{ID: uuid.New(), Type: events.SummaryStatusChanged}
+			close(source)
+		}()
+
+		handler := NewEventServer(eventUseCase, 5*time.Millisecond)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), ": heartbeat\n\n")
+		s.Contains(recorder.Body.String(), "event: summary.status_changed\n")
+		s.True(closed)
+	})
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/errors"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const defaultExportFormat = "md"
+
+type ExportServer struct {
+	export service.ExportUseCase
+}
+
+func NewExportServer(export service.ExportUseCase) *ExportServer {
+	return &ExportServer{
+		export: export,
+	}
+}
+
+func (api *ExportServer) Register(server *echo.Echo) {
+	server.GET("/summaries/export.csv", api.ExportSummariesCSV)
+	server.GET("/summaries/:externalId/export", api.ExportSummary)
+}
+
+func (api *ExportServer) ExportSummary(c echo.Context) error {
+	ctx := c.Request().Context()
+	externalID := c.Param("externalId")
+
+	parsedExternalID, err := uuid.Parse(externalID)
+	if err != nil {
+		return errors.Handle(c, application.ExternalIDIsInvalid)
+	}
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = defaultExportFormat
+	}
+
+	result, err := api.export.ExportSummary(ctx, parsedExternalID, format)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=%q", result.FileName))
+
+	return c.Blob(http.StatusOK, result.ContentType, result.Content)
+}
+
+func (api *ExportServer) ExportSummariesCSV(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter, err := getSummaryFilterFromRequest(c)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	response.Header().Set(echo.HeaderContentDisposition, `attachment; filename="summaries.csv"`)
+
+	if err = api.export.ExportSummariesCSV(ctx, filter, response); err != nil {
+		if response.Committed {
+			log.LogError(ctx, "summaries csv export interrupted", err)
+			return nil
+		}
+
+		response.Header().Del(echo.HeaderContentDisposition)
+		return errors.Handle(c, err)
+	}
+
+	if !response.Committed {
+		response.WriteHeader(http.StatusOK)
+	}
+
+	response.Flush()
+	return nil
+}
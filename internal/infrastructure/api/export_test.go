@@ -0,0 +1,196 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	servicemocks "github.com/diegofsousa/explicAI/internal/application/service/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *ControllerTestSuite) TestExportSummary() {
+	s.Run("successful export summary", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/export?format=txt", nil)
+		recorder := httptest.NewRecorder()
+
+		export := new(servicemocks.ExportUseCase)
+		export.EXPECT().
+			ExportSummary(mock.Anything, summaryExternalIDUUID, "txt").
+			Return(&service.SummaryExportOutput{
+				FileName:    "summary-" + summaryExternalIDStr + ".txt",
+				ContentType: "text/plain; charset=utf-8",
+				Content:     []byte("title"),
+			}, nil)
+
+		handler := NewExportServer(export)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal("text/plain; charset=utf-8", recorder.Header().Get(echo.HeaderContentType))
+		s.Equal(`attachment; filename="summary-`+summaryExternalIDStr+`.txt"`,
+			recorder.Header().Get(echo.HeaderContentDisposition))
+		s.Equal("title", recorder.Body.String())
+	})
+
+	s.Run("default export format", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/export", nil)
+		recorder := httptest.NewRecorder()
+
+		export := new(servicemocks.ExportUseCase)
+		export.EXPECT().
+			ExportSummary(mock.Anything, summaryExternalIDUUID, "md").
+			Return(&service.SummaryExportOutput{
+				FileName:    "summary-" + summaryExternalIDStr + ".md",
+				ContentType: "text/markdown; charset=utf-8",
+				Content:     []byte("# title"),
+			}, nil)
+
+		handler := NewExportServer(export)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		export.AssertExpectations(s.T())
+	})
+
+	s.Run("invalid external id format", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/invalid-uuid/export", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := NewExportServer(new(servicemocks.ExportUseCase))
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("invalid export format", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/export?format=pdf", nil)
+		recorder := httptest.NewRecorder()
+
+		export := new(servicemocks.ExportUseCase)
+		export.EXPECT().
+			ExportSummary(mock.Anything, summaryExternalIDUUID, "pdf").
+			Return(nil, application.InvalidExportFormat)
+
+		handler := NewExportServer(export)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("summary not found", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/export", nil)
+		recorder := httptest.NewRecorder()
+
+		export := new(servicemocks.ExportUseCase)
+		export.EXPECT().
+			ExportSummary(mock.Anything, summaryExternalIDUUID, "md").
+			Return(nil, application.SummaryNotFound)
+
+		handler := NewExportServer(export)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusNotFound, recorder.Code)
+	})
+
+	s.Run("service error when export summary", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/"+summaryExternalIDStr+"/export", nil)
+		recorder := httptest.NewRecorder()
+
+		export := new(servicemocks.ExportUseCase)
+		export.EXPECT().
+			ExportSummary(mock.Anything, summaryExternalIDUUID, "md").
+			Return(nil, errors.New("some error"))
+
+		handler := NewExportServer(export)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ControllerTestSuite) TestExportSummariesCSV() {
+	s.Run("successful export summaries csv", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/export.csv?status=summarized", nil)
+		recorder := httptest.NewRecorder()
+
+		export := new(servicemocks.ExportUseCase)
+		export.EXPECT().
+			ExportSummariesCSV(mock.Anything, service.SummaryFilterInput{Status: "SUMMARIZED"}, mock.Anything).
+			RunAndReturn(func(ctx context.Context, filter service.SummaryFilterInput, w io.Writer) error {
+				_, err := w.Write([]byte("externalId,status\n"))
+				return err
+			})
+
+		handler := NewExportServer(export)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal("text/csv; charset=utf-8", recorder.Header().Get(echo.HeaderContentType))
+		s.Equal(`attachment; filename="summaries.csv"`, recorder.Header().Get(echo.HeaderContentDisposition))
+		s.Equal("externalId,status\n", recorder.Body.String())
+	})
+
+	s.Run("invalid filter on export summaries csv", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/export.csv?status=unknown", nil)
+		recorder := httptest.NewRecorder()
+
+		export := new(servicemocks.ExportUseCase)
+		export.EXPECT().
+			ExportSummariesCSV(mock.Anything, service.SummaryFilterInput{Status: "UNKNOWN"}, mock.Anything).
+			Return(application.InvalidSummaryFilter)
+
+		handler := NewExportServer(export)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+		s.Empty(recorder.Header().Get(echo.HeaderContentDisposition))
+	})
+
+	s.Run("service error before streaming summaries csv", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/summaries/export.csv", nil)
+		recorder := httptest.NewRecorder()
+
+		export := new(servicemocks.ExportUseCase)
+		export.EXPECT().
+			ExportSummariesCSV(mock.Anything, service.SummaryFilterInput{}, mock.Anything).
+			Return(application.ExportFailed)
+
+		handler := NewExportServer(export)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"encoding/base64"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/audioformat"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/errors"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	tusVersion    = "1.0.0"
+	tusExtensions = "creation,termination"
+	tusBasePath   = "/uploads/tus"
+
+	headerTusResumable  = "Tus-Resumable"
+	headerTusVersion    = "Tus-Version"
+	headerTusExtension  = "Tus-Extension"
+	headerTusMaxSize    = "Tus-Max-Size"
+	headerUploadLength  = "Upload-Length"
+	headerUploadOffset  = "Upload-Offset"
+	headerUploadMeta    = "Upload-Metadata"
+	headerSummaryID     = "X-Summary-Id"
+	mimeOffsetOctStream = "application/offset+octet-stream"
+)
+
+// TusServer implements the core, creation and termination parts of the tus
+// resumable upload protocol (https://tus.io/protocols/resumable-upload).
+type TusServer struct {
+	upload  service.UploadUseCase
+	maxSize int64
+}
+
+func NewTusServer(upload service.UploadUseCase, maxSize int64) *TusServer {
+	return &TusServer{
+		upload:  upload,
+		maxSize: maxSize,
+	}
+}
+
+func (api *TusServer) Register(server *echo.Echo) {
+	group := server.Group(tusBasePath, api.tusResumable)
+	group.OPTIONS("", api.Options)
+	group.POST("", api.CreateUpload)
+	group.HEAD("/:id", api.GetUpload)
+	group.PATCH("/:id", api.WriteUploadChunk)
+	group.DELETE("/:id", api.DeleteUpload)
+}
+
+func (api *TusServer) Options(c echo.Context) error {
+	header := c.Response().Header()
+	header.Set(headerTusVersion, tusVersion)
+	header.Set(headerTusExtension, tusExtensions)
+	if api.maxSize > 0 {
+		header.Set(headerTusMaxSize, strconv.FormatInt(api.maxSize, 10))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (api *TusServer) CreateUpload(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	length, err := strconv.ParseInt(c.Request().Header.Get(headerUploadLength), 10, 64)
+	if err != nil {
+		return errors.Handle(c, application.InvalidUpload)
+	}
+
+	metadata, err := parseUploadMetadata(c.Request().Header.Get(headerUploadMeta))
+	if err != nil {
+		return errors.Handle(c, application.InvalidUpload)
+	}
+
+	if filename, ok := metadata["filename"]; ok && !audioformat.IsSupportedExtension(filepath.Ext(filename)) {
+		return errors.Handle(c, application.InvalidFile)
+	}
+
+	result, err := api.upload.CreateUpload(ctx, service.UploadCreateInput{
+		Length:   length,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, tusBasePath+"/"+result.ID.String())
+
+	return c.NoContent(http.StatusCreated)
+}
+
+func (api *TusServer) GetUpload(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errors.Handle(c, application.UploadNotFound)
+	}
+
+	result, err := api.upload.GetUpload(ctx, id)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderCacheControl, "no-store")
+	header.Set(headerUploadLength, strconv.FormatInt(result.Length, 10))
+	if len(result.Metadata) > 0 {
+		header.Set(headerUploadMeta, formatUploadMetadata(result.Metadata))
+	}
+	setUploadProgress(c, result)
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (api *TusServer) WriteUploadChunk(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	if c.Request().Header.Get(echo.HeaderContentType) != mimeOffsetOctStream {
+		return echo.ErrUnsupportedMediaType
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errors.Handle(c, application.UploadNotFound)
+	}
+
+	offset, err := strconv.ParseInt(c.Request().Header.Get(headerUploadOffset), 10, 64)
+	if err != nil || offset < 0 {
+		return errors.Handle(c, application.InvalidUpload)
+	}
+
+	result, err := api.upload.WriteUploadChunk(ctx, service.UploadChunkInput{
+		ID:      id,
+		Offset:  offset,
+		Content: c.Request().Body,
+	})
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	setUploadProgress(c, result)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (api *TusServer) DeleteUpload(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errors.Handle(c, application.UploadNotFound)
+	}
+
+	if err = api.upload.DeleteUpload(ctx, id); err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// tusResumable answers every request with the protocol version and rejects
+// clients speaking another one. OPTIONS is how clients discover the version,
+// so it does not require the header.
+func (api *TusServer) tusResumable(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		header := c.Response().Header()
+		header.Set(headerTusResumable, tusVersion)
+
+		if c.Request().Method != http.MethodOptions && c.Request().Header.Get(headerTusResumable) != tusVersion {
+			header.Set(headerTusVersion, tusVersion)
+			return echo.ErrPreconditionFailed
+		}
+
+		return next(c)
+	}
+}
+
+func setUploadProgress(c echo.Context, result *service.UploadOutput) {
+	header := c.Response().Header()
+	header.Set(headerUploadOffset, strconv.FormatInt(result.Offset, 10))
+	if result.SummaryExternalID != uuid.Nil {
+		header.Set(headerSummaryID, result.SummaryExternalID.String())
+	}
+}
+
+// parseUploadMetadata decodes the Upload-Metadata header, a comma separated
+// list of keys followed by an optional base64 encoded value.
+func parseUploadMetadata(value string) (map[string]string, error) {
+	metadata := map[string]string{}
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, encoded, _ := strings.Cut(pair, " ")
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata[key] = string(decoded)
+	}
+
+	return metadata, nil
+}
+
+func formatUploadMetadata(metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+" "+base64.StdEncoding.EncodeToString([]byte(metadata[key])))
+	}
+
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	servicemocks "github.com/diegofsousa/explicAI/internal/application/service/mocks"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *ControllerTestSuite) TestTusUpload() {
+	uploadID := uuid.New()
+	uploadPath := "/uploads/tus/" + uploadID.String()
+
+	tusRequest := func(method, target string, body string) *http.Request {
+		request := httptest.NewRequest(method, target, strings.NewReader(body))
+		request.Header.Set("Tus-Resumable", "1.0.0")
+		return request
+	}
+
+	serve := func(upload *servicemocks.UploadUseCase, request *http.Request) *httptest.ResponseRecorder {
+		e := echo.New()
+		NewTusServer(upload, 100).Register(e)
+
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	s.Run("options advertises the protocol", func() {
+		recorder := serve(new(servicemocks.UploadUseCase), httptest.NewRequest(http.MethodOptions, "/uploads/tus", nil))
+
+		s.Equal(http.StatusNoContent, recorder.Code)
+		s.Equal("1.0.0", recorder.Header().Get("Tus-Version"))
+		s.Equal("creation,termination", recorder.Header().Get("Tus-Extension"))
+		s.Equal("100", recorder.Header().Get("Tus-Max-Size"))
+	})
+
+	s.Run("successful create upload", func() {
+		upload := new(servicemocks.UploadUseCase)
+		upload.EXPECT().
+			CreateUpload(mock.Anything, service.UploadCreateInput{
+				Length:   10,
+				Metadata: map[string]string{"filename": "meeting.mp3", "private": ""},
+			}).
+			Return(&service.UploadOutput{ID: uploadID, Length: 10}, nil)
+
+		request := tusRequest(http.MethodPost, "/uploads/tus", "")
+		request.Header.Set("Upload-Length", "10")
+		request.Header.Set("Upload-Metadata", "filename bWVldGluZy5tcDM=,private")
+		recorder := serve(upload, request)
+
+		s.Equal(http.StatusCreated, recorder.Code)
+		s.Equal(uploadPath, recorder.Header().Get(echo.HeaderLocation))
+		s.Equal("1.0.0", recorder.Header().Get("Tus-Resumable"))
+	})
+
+	s.Run("reject create with invalid file", func() {
+		upload := new(servicemocks.UploadUseCase)
+
+		request := tusRequest(http.MethodPost, "/uploads/tus", "")
+		request.Header.Set("Upload-Length", "10")
+		request.Header.Set("Upload-Metadata", "filename bm90ZXMudHh0")
+		recorder := serve(upload, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+		upload.AssertNotCalled(s.T(), "CreateUpload", mock.Anything, mock.Anything)
+	})
+
+	s.Run("reject create above the maximum size", func() {
+		upload := new(servicemocks.UploadUseCase)
+		upload.EXPECT().
+			CreateUpload(mock.Anything, mock.Anything).
+			Return(nil, application.UploadTooLarge)
+
+		request := tusRequest(http.MethodPost, "/uploads/tus", "")
+		request.Header.Set("Upload-Length", "1000")
+		recorder := serve(upload, request)
+
+		s.Equal(http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+
+	s.Run("reject unsupported protocol version", func() {
+		upload := new(servicemocks.UploadUseCase)
+
+		request := httptest.NewRequest(http.MethodPost, "/uploads/tus", nil)
+		request.Header.Set("Tus-Resumable", "0.2.2")
+		request.Header.Set("Upload-Length", "10")
+		recorder := serve(upload, request)
+
+		s.Equal(http.StatusPreconditionFailed, recorder.Code)
+		s.Equal("1.0.0", recorder.Header().Get("Tus-Version"))
+		upload.AssertNotCalled(s.T(), "CreateUpload", mock.Anything, mock.Anything)
+	})
+
+	s.Run("successful get upload offset", func() {
+		upload := new(servicemocks.UploadUseCase)
+		upload.EXPECT().
+			GetUpload(mock.Anything, uploadID).
+			Return(&service.UploadOutput{ID: uploadID, Length: 10, Offset: 4}, nil)
+
+		recorder := serve(upload, tusRequest(http.MethodHead, uploadPath, ""))
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal("4", recorder.Header().Get("Upload-Offset"))
+		s.Equal("10", recorder.Header().Get("Upload-Length"))
+		s.Equal("no-store", recorder.Header().Get(echo.HeaderCacheControl))
+	})
+
+	s.Run("successful write last chunk", func() {
+		upload := new(servicemocks.UploadUseCase)
+		upload.EXPECT().
+			WriteUploadChunk(mock.Anything, mock.MatchedBy(func(input service.UploadChunkInput) bool {
+				return input.ID == uploadID && input.Offset == 4
+			})).
+			Return(&service.UploadOutput{ID: uploadID, Length: 10, Offset: 10, SummaryExternalID: summaryExternalIDUUID}, nil)
+
+		request := tusRequest(http.MethodPatch, uploadPath, "456789")
+		request.Header.Set(echo.HeaderContentType, "application/offset+octet-stream")
+		request.Header.Set("Upload-Offset", "4")
+		recorder := serve(upload, request)
+
+		s.Equal(http.StatusNoContent, recorder.Code)
+		s.Equal("10", recorder.Header().Get("Upload-Offset"))
+		s.Equal(summaryExternalIDStr, recorder.Header().Get("X-Summary-Id"))
+	})
+
+	s.Run("reject chunk at wrong offset", func() {
+		upload := new(servicemocks.UploadUseCase)
+		upload.EXPECT().
+			WriteUploadChunk(mock.Anything, mock.Anything).
+			Return(nil, application.UploadOffsetMismatch)
+
+		request := tusRequest(http.MethodPatch, uploadPath, "23")
+		request.Header.Set(echo.HeaderContentType, "application/offset+octet-stream")
+		request.Header.Set("Upload-Offset", "2")
+		recorder := serve(upload, request)
+
+		s.Equal(http.StatusConflict, recorder.Code)
+	})
+
+	s.Run("reject chunk with wrong content type", func() {
+		upload := new(servicemocks.UploadUseCase)
+
+		request := tusRequest(http.MethodPatch, uploadPath, "23")
+		request.Header.Set(echo.HeaderContentType, "application/octet-stream")
+		request.Header.Set("Upload-Offset", "2")
+		recorder := serve(upload, request)
+
+		s.Equal(http.StatusUnsupportedMediaType, recorder.Code)
+		upload.AssertNotCalled(s.T(), "WriteUploadChunk", mock.Anything, mock.Anything)
+	})
+
+	s.Run("successful delete upload", func() {
+		upload := new(servicemocks.UploadUseCase)
+		upload.EXPECT().DeleteUpload(mock.Anything, uploadID).Return(nil)
+
+		recorder := serve(upload, tusRequest(http.MethodDelete, uploadPath, ""))
+
+		s.Equal(http.StatusNoContent, recorder.Code)
+	})
+
+	s.Run("upload not found", func() {
+		upload := new(servicemocks.UploadUseCase)
+		upload.EXPECT().GetUpload(mock.Anything, uploadID).Return(nil, application.UploadNotFound)
+
+		recorder := serve(upload, tusRequest(http.MethodHead, uploadPath, ""))
+
+		s.Equal(http.StatusNotFound, recorder.Code)
+	})
+}
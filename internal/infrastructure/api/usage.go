@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/errors"
+	"github.com/labstack/echo/v4"
+)
+
+type UsageServer struct {
+	usage service.UsageUseCase
+}
+
+func NewUsageServer(usage service.UsageUseCase) *UsageServer {
+	return &UsageServer{
+		usage: usage,
+	}
+}
+
+func (api *UsageServer) Register(server *echo.Echo) {
+	server.GET("/usage", api.GetUsage)
+}
+
+func (api *UsageServer) GetUsage(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	from, err := parseFilterDate(c.QueryParam("from"))
+	if err != nil {
+		return errors.Handle(c, application.InvalidUsageFilter)
+	}
+
+	to, err := parseFilterDate(c.QueryParam("to"))
+	if err != nil {
+		return errors.Handle(c, application.InvalidUsageFilter)
+	}
+
+	result, err := api.usage.GetUsage(ctx, service.UsageFilterInput{
+		From: from,
+		To:   to,
+	})
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	servicemocks "github.com/diegofsousa/explicAI/internal/application/service/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *ControllerTestSuite) TestGetUsage() {
+	s.Run("successful get usage", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/usage?from=2025-01-01&to=2025-02-01", nil)
+		recorder := httptest.NewRecorder()
+
+		from, _ := time.Parse(time.DateOnly, "2025-01-01")
+		to, _ := time.Parse(time.DateOnly, "2025-02-01")
+
+		usage := new(servicemocks.UsageUseCase)
+		usage.EXPECT().
+			GetUsage(mock.Anything, service.UsageFilterInput{From: from, To: to}).
+			Return(&service.UsageReportOutput{
+				Total:  service.UsageTotalsOutput{Requests: 3, Cost: 0.25},
+				ByDay:  []service.UsageDayOutput{},
+				ByUser: []service.UsageUserOutput{},
+			}, nil)
+
+		handler := NewUsageServer(usage)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"total":{"requests":3`)
+		s.Contains(recorder.Body.String(), `"cost":0.25`)
+	})
+
+	s.Run("invalid usage period", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/usage?from=yesterday", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := NewUsageServer(new(servicemocks.UsageUseCase))
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("forbidden get usage", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/usage", nil)
+		recorder := httptest.NewRecorder()
+
+		usage := new(servicemocks.UsageUseCase)
+		usage.EXPECT().
+			GetUsage(mock.Anything, service.UsageFilterInput{}).
+			Return(nil, application.Forbidden)
+
+		handler := NewUsageServer(usage)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusForbidden, recorder.Code)
+	})
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/errors"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type WebhookServer struct {
+	webhook service.WebhookUseCase
+}
+
+func NewWebhookServer(webhook service.WebhookUseCase) *WebhookServer {
+	return &WebhookServer{
+		webhook: webhook,
+	}
+}
+
+func (api *WebhookServer) Register(server *echo.Echo) {
+	server.POST("/webhooks", api.CreateWebhook)
+	server.GET("/webhooks", api.ListWebhooks)
+	server.GET("/webhooks/:externalId", api.GetWebhookByExternalID)
+	server.PATCH("/webhooks/:externalId", api.UpdateWebhook)
+	server.DELETE("/webhooks/:externalId", api.DeleteWebhookByExternalID)
+	server.GET("/webhooks/:externalId/deliveries", api.ListWebhookDeliveries)
+}
+
+func (api *WebhookServer) CreateWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var input service.WebhookCreateInput
+	if err := c.Bind(&input); err != nil {
+		return errors.Handle(c, application.InvalidWebhook)
+	}
+
+	result, err := api.webhook.CreateWebhook(ctx, input)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, result)
+}
+
+func (api *WebhookServer) ListWebhooks(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	result, err := api.webhook.ListWebhooks(ctx)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func (api *WebhookServer) GetWebhookByExternalID(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	parsedExternalID, err := uuid.Parse(c.Param("externalId"))
+	if err != nil {
+		return errors.Handle(c, application.ExternalIDIsInvalid)
+	}
+
+	result, err := api.webhook.GetWebhookByExternalID(ctx, parsedExternalID)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func (api *WebhookServer) UpdateWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	parsedExternalID, err := uuid.Parse(c.Param("externalId"))
+	if err != nil {
+		return errors.Handle(c, application.ExternalIDIsInvalid)
+	}
+
+	var input service.WebhookUpdateInput
+	if err = c.Bind(&input); err != nil {
+		return errors.Handle(c, application.InvalidWebhook)
+	}
+
+	result, err := api.webhook.UpdateWebhook(ctx, parsedExternalID, input)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func (api *WebhookServer) DeleteWebhookByExternalID(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	parsedExternalID, err := uuid.Parse(c.Param("externalId"))
+	if err != nil {
+		return errors.Handle(c, application.ExternalIDIsInvalid)
+	}
+
+	err = api.webhook.DeleteWebhookByExternalID(ctx, parsedExternalID)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "webhook has been removed",
+	})
+}
+
+func (api *WebhookServer) ListWebhookDeliveries(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	parsedExternalID, err := uuid.Parse(c.Param("externalId"))
+	if err != nil {
+		return errors.Handle(c, application.ExternalIDIsInvalid)
+	}
+
+	result, err := api.webhook.ListWebhookDeliveries(ctx, parsedExternalID)
+	if err != nil {
+		return errors.Handle(c, err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,223 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/application/service"
+	servicemocks "github.com/diegofsousa/explicAI/internal/application/service/mocks"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	webhookExternalIDStr  = "0b6c7d8e-35e3-4b8b-9d3c-1b4c0d2a9f10"
+	webhookExternalIDUUID = uuid.MustParse(webhookExternalIDStr)
+)
+
+func (s *ControllerTestSuite) TestCreateWebhook() {
+	s.Run("successful create webhook", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodPost, "/webhooks",
+			strings.NewReader(`{"url":"https://hooks.example.com","statuses":["SUMMARIZED"]}`))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		webhook := new(servicemocks.WebhookUseCase)
+		webhook.EXPECT().
+			CreateWebhook(mock.Anything, service.WebhookCreateInput{
+				URL:      "https://hooks.example.com",
+				Statuses: []string{"SUMMARIZED"},
+			}).
+			Return(&service.WebhookOutput{
+				ExternalID: webhookExternalIDUUID,
+				URL:        "https://hooks.example.com",
+				Secret:     "whsec_test",
+				Statuses:   []string{"SUMMARIZED"},
+				Active:     true,
+			}, nil)
+
+		handler := NewWebhookServer(webhook)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusCreated, recorder.Code)
+		s.Contains(recorder.Body.String(), `"secret":"whsec_test"`)
+	})
+
+	s.Run("invalid webhook body", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(`{"url":`))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		handler := NewWebhookServer(new(servicemocks.WebhookUseCase))
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("invalid webhook", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(`{"url":"ftp://x"}`))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		webhook := new(servicemocks.WebhookUseCase)
+		webhook.EXPECT().
+			CreateWebhook(mock.Anything, mock.Anything).
+			Return(nil, application.InvalidWebhook)
+
+		handler := NewWebhookServer(webhook)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func (s *ControllerTestSuite) TestUpdateWebhook() {
+	s.Run("successful update webhook", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodPatch, "/webhooks/"+webhookExternalIDStr,
+			strings.NewReader(`{"active":false}`))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		webhook := new(servicemocks.WebhookUseCase)
+		webhook.EXPECT().
+			UpdateWebhook(mock.Anything, webhookExternalIDUUID, mock.MatchedBy(func(input service.WebhookUpdateInput) bool {
+				return input.URL == nil && input.Active != nil && !*input.Active
+			})).
+			Return(&service.WebhookOutput{ExternalID: webhookExternalIDUUID, Active: false}, nil)
+
+		handler := NewWebhookServer(webhook)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		webhook.AssertExpectations(s.T())
+	})
+
+	s.Run("update webhook not found", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodPatch, "/webhooks/"+webhookExternalIDStr, strings.NewReader(`{}`))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		recorder := httptest.NewRecorder()
+
+		webhook := new(servicemocks.WebhookUseCase)
+		webhook.EXPECT().
+			UpdateWebhook(mock.Anything, webhookExternalIDUUID, mock.Anything).
+			Return(nil, application.WebhookNotFound)
+
+		handler := NewWebhookServer(webhook)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusNotFound, recorder.Code)
+	})
+}
+
+func (s *ControllerTestSuite) TestGetWebhook() {
+	s.Run("successful list webhooks", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+		recorder := httptest.NewRecorder()
+
+		webhook := new(servicemocks.WebhookUseCase)
+		webhook.EXPECT().
+			ListWebhooks(mock.Anything).
+			Return(&service.WebhookListOutput{
+				Data: []service.WebhookOutput{{ExternalID: webhookExternalIDUUID}},
+			}, nil)
+
+		handler := NewWebhookServer(webhook)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), webhookExternalIDStr)
+	})
+
+	s.Run("webhook not found", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/webhooks/"+webhookExternalIDStr, nil)
+		recorder := httptest.NewRecorder()
+
+		webhook := new(servicemocks.WebhookUseCase)
+		webhook.EXPECT().
+			GetWebhookByExternalID(mock.Anything, webhookExternalIDUUID).
+			Return(nil, application.WebhookNotFound)
+
+		handler := NewWebhookServer(webhook)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusNotFound, recorder.Code)
+	})
+
+	s.Run("invalid external id format", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/webhooks/invalid-uuid/deliveries", nil)
+		recorder := httptest.NewRecorder()
+
+		handler := NewWebhookServer(new(servicemocks.WebhookUseCase))
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("successful list webhook deliveries", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodGet, "/webhooks/"+webhookExternalIDStr+"/deliveries", nil)
+		recorder := httptest.NewRecorder()
+
+		webhook := new(servicemocks.WebhookUseCase)
+		webhook.EXPECT().
+			ListWebhookDeliveries(mock.Anything, webhookExternalIDUUID).
+			Return(&service.WebhookDeliveryListOutput{
+				Data: []service.WebhookDeliveryOutput{{Attempt: 1, StatusCode: 200, Delivered: true}},
+			}, nil)
+
+		handler := NewWebhookServer(webhook)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"delivered":true`)
+	})
+}
+
+func (s *ControllerTestSuite) TestDeleteWebhook() {
+	s.Run("successful delete webhook", func() {
+		e := echo.New()
+
+		request := httptest.NewRequest(http.MethodDelete, "/webhooks/"+webhookExternalIDStr, nil)
+		recorder := httptest.NewRecorder()
+
+		webhook := new(servicemocks.WebhookUseCase)
+		webhook.EXPECT().
+			DeleteWebhookByExternalID(mock.Anything, webhookExternalIDUUID).
+			Return(nil)
+
+		handler := NewWebhookServer(webhook)
+		handler.Register(e)
+		e.ServeHTTP(recorder, request)
+
+		s.Equal(http.StatusOK, recorder.Code)
+	})
+}
@@ -0,0 +1,232 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/summarize"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
+)
+
+const (
+	basePath                   = "/v1/messages"
+	apiVersion                 = "2023-06-01"
+	systemPrompt               = "Você é um sistema que recebe um texto transcrito de um áudio e organiza, separando em parágrafos e corrigindo possíveis erros de concordância"
+	resumeUserPrompt           = "Preciso de um objeto com título sugerido, descrição sugerida, resumo breve e médio sobre a seguinte transcrição:"
+	fullTextOrganizeUserPrompt = "Retorne apenas o texto normalizado para a seguinte transcrição: "
+	toolName                   = "resume"
+	toolDescription            = "Registra o título, a descrição e os resumos da transcrição"
+	stopReasonMaxTokens        = "max_tokens"
+)
+
+type (
+	MessagesRequest struct {
+		Model      string      `json:"model"`
+		MaxTokens  int         `json:"max_tokens"`
+		System     string      `json:"system"`
+		Messages   []Message   `json:"messages"`
+		Tools      []Tool      `json:"tools,omitempty"`
+		ToolChoice *ToolChoice `json:"tool_choice,omitempty"`
+	}
+
+	Message struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	}
+
+	Tool struct {
+		Name        string               `json:"name"`
+		Description string               `json:"description"`
+		InputSchema summarize.JSONSchema `json:"input_schema"`
+	}
+
+	// ToolChoice forces the model to answer through the tool, so the resume
+	// always comes as the tool input.
+	ToolChoice struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	}
+
+	MessagesResponse struct {
+		Content    []ContentBlock `json:"content"`
+		StopReason string         `json:"stop_reason"`
+		Usage      Usage          `json:"usage"`
+	}
+
+	ContentBlock struct {
+		Type  string          `json:"type"`
+		Text  string          `json:"text,omitempty"`
+		Name  string          `json:"name,omitempty"`
+		Input json.RawMessage `json:"input,omitempty"`
+	}
+
+	Usage struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	}
+)
+
+// Client limits the full text apart from the resume, as the organized text
+// is as long as the transcription.
+type Client struct {
+	HttpClient        *clients.BaseHTTP
+	ApiKey            string
+	ServiceName       string
+	Model             string
+	MaxTokens         int
+	FullTextMaxTokens int
+}
+
+func NewClient(serviceName, URL, apiKey, model string, maxTokens, fullTextMaxTokens int, timeout int64) *Client {
+	return &Client{
+		ServiceName:       serviceName,
+		HttpClient:        clients.NewHttpClient(URL, timeout),
+		ApiKey:            apiKey,
+		Model:             model,
+		MaxTokens:         maxTokens,
+		FullTextMaxTokens: fullTextMaxTokens,
+	}
+}
+
+func (c *Client) Resume(ctx context.Context, input summarize.Input) (*summarize.ResumeOutput, error) {
+	messagesResponse, err := c.send(c.buildResumeRequest(input))
+	if err != nil {
+		return nil, fmt.Errorf("error on anthropic resume request: %w", err)
+	}
+
+	// a tool input cut by the token limit is not a valid object
+	if messagesResponse.StopReason == stopReasonMaxTokens {
+		return nil, fmt.Errorf("error on anthropic resume request: response truncated by max_tokens=%d", c.MaxTokens)
+	}
+
+	var toolInput json.RawMessage
+	for _, block := range messagesResponse.Content {
+		if block.Type == "tool_use" && block.Name == toolName {
+			toolInput = block.Input
+			break
+		}
+	}
+
+	if toolInput == nil {
+		return nil, fmt.Errorf("error on anthropic resume request: no tool use in response")
+	}
+
+	var response summarize.ResumeOutput
+	if err = json.Unmarshal(toolInput, &response); err != nil {
+		return nil, fmt.Errorf("error on anthropic resume request: error=%s", err.Error())
+	}
+
+	response.Usage = c.tokenUsage(messagesResponse.Usage)
+
+	return &response, nil
+}
+
+func (c *Client) FullTextOrganize(ctx context.Context, input summarize.Input) (*summarize.FullTextOutput, error) {
+	messagesResponse, err := c.send(c.buildFullTextOrganizeRequest(input))
+	if err != nil {
+		return nil, fmt.Errorf("error on anthropic full text organize request: %w", err)
+	}
+
+	if messagesResponse.StopReason == stopReasonMaxTokens {
+		return nil, fmt.Errorf("error on anthropic full text organize request: response truncated by max_tokens=%d", c.FullTextMaxTokens)
+	}
+
+	var text strings.Builder
+	for _, block := range messagesResponse.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+
+	if strings.TrimSpace(text.String()) == "" {
+		return nil, fmt.Errorf("error on anthropic full text organize request: empty response")
+	}
+
+	return &summarize.FullTextOutput{
+		Text:  text.String(),
+		Usage: c.tokenUsage(messagesResponse.Usage),
+	}, nil
+}
+
+func (c *Client) send(request MessagesRequest) (*MessagesResponse, error) {
+	clients.Mutex.Lock()
+
+	req := c.HttpClient.Client.
+		SetHeader("x-api-key", c.ApiKey).
+		SetHeader("anthropic-version", apiVersion).
+		SetHeader("Content-Type", "application/json").
+		SetBody(request)
+
+	res, err := req.Post(basePath)
+	clients.Mutex.Unlock()
+
+	if err != nil {
+		return nil, clients.NewRequestError(0, "error=%s", err.Error())
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, clients.NewRequestError(res.StatusCode(), "response=%s | status=%s", res.Body(), res.Status())
+	}
+
+	var response MessagesResponse
+	if err = json.Unmarshal(res.Body(), &response); err != nil {
+		return nil, fmt.Errorf("error=%s", err.Error())
+	}
+
+	return &response, nil
+}
+
+func (c *Client) tokenUsage(usage Usage) summarize.TokenUsage {
+	return summarize.TokenUsage{
+		Model:            c.Model,
+		Provider:         c.ServiceName,
+		PromptTokens:     usage.InputTokens,
+		CompletionTokens: usage.OutputTokens,
+	}
+}
+
+func (c *Client) buildResumeRequest(input summarize.Input) MessagesRequest {
+	return MessagesRequest{
+		Model:     c.Model,
+		MaxTokens: c.MaxTokens,
+		System:    systemPrompt,
+		Messages: []Message{
+			{
+				Role:    "user",
+				Content: input.Content(resumeUserPrompt),
+			},
+		},
+		Tools: buildResumeTool(),
+		ToolChoice: &ToolChoice{
+			Type: "tool",
+			Name: toolName,
+		},
+	}
+}
+
+func buildResumeTool() []Tool {
+	return []Tool{
+		{
+			Name:        toolName,
+			Description: toolDescription,
+			InputSchema: summarize.Schema(summarize.ResumeOutput{}),
+		},
+	}
+}
+
+func (c *Client) buildFullTextOrganizeRequest(input summarize.Input) MessagesRequest {
+	return MessagesRequest{
+		Model:     c.Model,
+		MaxTokens: c.FullTextMaxTokens,
+		System:    systemPrompt,
+		Messages: []Message{
+			{
+				Role:    "user",
+				Content: input.Content(fullTextOrganizeUserPrompt),
+			},
+		},
+	}
+}
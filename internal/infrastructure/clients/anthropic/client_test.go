@@ -0,0 +1,203 @@
+package anthropic
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/summarize"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	config = viper.New()
+
+	//go:embed embed/anthropic-resume-response.json
+	anthropicResumeResponse string
+
+	//go:embed embed/anthropic-resume-response-no-tool.json
+	anthropicResumeResponseNoTool string
+
+	//go:embed embed/anthropic-resume-response-fail-marshall-input.json
+	anthropicResumeResponseFailMarshallInput string
+
+	//go:embed embed/anthropic-resume-response-max-tokens.json
+	anthropicResumeResponseMaxTokens string
+
+	//go:embed embed/anthropic-fulltext-response.json
+	anthropicFulltextResponse string
+
+	//go:embed embed/anthropic-response-error.json
+	anthropicResponseError string
+)
+
+type AnthropicClientTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestAnthropicClient(t *testing.T) {
+	suite.Run(t, new(AnthropicClientTestSuite))
+}
+
+func (s *AnthropicClientTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	config.AddConfigPath("embed")
+	config.SetConfigName("client_config")
+
+	if err := config.ReadInConfig(); err != nil {
+		panic(fmt.Errorf("failed conf file read: %w", err))
+	}
+}
+
+func (s *AnthropicClientTestSuite) TestAnthropicResume() {
+	s.Run("successful request/response", func() {
+		server := s.startServer(http.StatusOK, anthropicResumeResponse)
+		defer server.Close()
+
+		client := getClientConfig(*config.Sub("anthropic"))
+		output, err := client.Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.Require().NoError(err)
+		s.Equal("title test", output.Title)
+		s.Equal("description test.", output.Description)
+		s.Equal("brief test", output.BriefResume)
+		s.Equal("medium test", output.MediumResume)
+		s.Equal(summarize.TokenUsage{Model: "claude-sonnet-4-5", Provider: "anthropic", PromptTokens: 1200, CompletionTokens: 300}, output.Usage)
+		s.Equal("xpto", client.HttpClient.Client.Header.Get("x-api-key"))
+		s.Equal(apiVersion, client.HttpClient.Client.Header.Get("anthropic-version"))
+	})
+
+	s.Run("fail response with status code error", func() {
+		server := s.startServer(http.StatusTooManyRequests, anthropicResponseError)
+		defer server.Close()
+
+		_, err := getClientConfig(*config.Sub("anthropic")).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, `error on anthropic resume request: response={"error":{"message":"Number of request tokens has exceeded your per-minute rate limit","type":"rate_limit_error"},"type":"error"}`+"\n | status=429 Too Many Requests")
+	})
+
+	s.Run("fail response without tool use", func() {
+		server := s.startServer(http.StatusOK, anthropicResumeResponseNoTool)
+		defer server.Close()
+
+		_, err := getClientConfig(*config.Sub("anthropic")).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, "error on anthropic resume request: no tool use in response")
+	})
+
+	s.Run("fail response with unmarshall input error", func() {
+		server := s.startServer(http.StatusOK, anthropicResumeResponseFailMarshallInput)
+		defer server.Close()
+
+		_, err := getClientConfig(*config.Sub("anthropic")).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, "error on anthropic resume request: error=json: cannot unmarshal string into Go value of type summarize.ResumeOutput")
+	})
+
+	s.Run("fail response truncated by max tokens", func() {
+		server := s.startServer(http.StatusOK, anthropicResumeResponseMaxTokens)
+		defer server.Close()
+
+		_, err := getClientConfig(*config.Sub("anthropic")).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, "error on anthropic resume request: response truncated by max_tokens=4096")
+	})
+}
+
+func (s *AnthropicClientTestSuite) TestAnthropicFullTextOrganize() {
+	s.Run("successful request/response", func() {
+		server := s.startServer(http.StatusOK, anthropicFulltextResponse)
+		defer server.Close()
+
+		output, err := getClientConfig(*config.Sub("anthropic")).FullTextOrganize(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.Require().NoError(err)
+		s.Equal("full text test", output.Text)
+		s.Equal(summarize.TokenUsage{Model: "claude-sonnet-4-5", Provider: "anthropic", PromptTokens: 1000, CompletionTokens: 900}, output.Usage)
+	})
+
+	s.Run("fail response truncated by the full text max tokens", func() {
+		server := s.startServer(http.StatusOK, `{"content":[{"type":"text","text":"full"}],"stop_reason":"max_tokens"}`)
+		defer server.Close()
+
+		_, err := getClientConfig(*config.Sub("anthropic")).FullTextOrganize(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, "error on anthropic full text organize request: response truncated by max_tokens=32000")
+	})
+
+	s.Run("fail response with empty content", func() {
+		server := s.startServer(http.StatusOK, `{"content":[],"stop_reason":"end_turn"}`)
+		defer server.Close()
+
+		_, err := getClientConfig(*config.Sub("anthropic")).FullTextOrganize(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, "error on anthropic full text organize request: empty response")
+	})
+}
+
+func (s *AnthropicClientTestSuite) TestBuildResumeRequest() {
+	client := getClientConfig(*config.Sub("anthropic"))
+
+	body, err := json.Marshal(client.buildResumeRequest(summarize.Input{Transcription: "transcription"}))
+	s.Require().NoError(err)
+
+	var request map[string]any
+	s.Require().NoError(json.Unmarshal(body, &request))
+	s.Equal(float64(4096), request["max_tokens"])
+	s.Equal(systemPrompt, request["system"])
+	s.Equal(map[string]any{"type": "tool", "name": toolName}, request["tool_choice"])
+
+	tool := request["tools"].([]any)[0].(map[string]any)
+	s.Equal(toolName, tool["name"])
+
+	schema, err := json.Marshal(summarize.Schema(summarize.ResumeOutput{}))
+	s.Require().NoError(err)
+	inputSchema, err := json.Marshal(tool["input_schema"])
+	s.Require().NoError(err)
+	s.JSONEq(string(schema), string(inputSchema))
+}
+
+func (s *AnthropicClientTestSuite) TestBuildFullTextOrganizeRequest() {
+	client := getClientConfig(*config.Sub("anthropic"))
+
+	body, err := json.Marshal(client.buildFullTextOrganizeRequest(summarize.Input{Transcription: "transcription"}))
+	s.Require().NoError(err)
+
+	var request map[string]any
+	s.Require().NoError(json.Unmarshal(body, &request))
+	s.Equal(float64(32000), request["max_tokens"])
+	s.Nil(request["tools"])
+}
+
+// startServer is called for every request with a new client, as the
+// connection kept by a previous client would point to a closed server.
+func (s *AnthropicClientTestSuite) startServer(status int, response string) *httptest.Server {
+	var responseObject any
+	s.Require().NoError(json.Unmarshal([]byte(response), &responseObject))
+
+	return clients.StartMockServer(clients.HttpServerMockParams{
+		ExpectedPath:   basePath,
+		ExpectedMethod: http.MethodPost,
+		ResponseStatus: status,
+		ResponseObject: responseObject,
+	}, config.Sub("anthropic").GetString("host"))
+}
+
+func getClientConfig(viper viper.Viper) *Client {
+	return NewClient(
+		viper.GetString("name"),
+		viper.GetString("url"),
+		viper.GetString("apiKey"),
+		viper.GetString("model"),
+		viper.GetInt("maxTokens"),
+		viper.GetInt("fullTextMaxTokens"),
+		viper.GetInt64("timeout"),
+	)
+}
@@ -16,14 +16,20 @@ const (
 	resumeUserPrompt           = "Preciso de um objeto com título sugerido, descrição sugerida, resumo breve e médio sobre a seguinte transcrição:"
 	fullTextOrganizeUserPrompt = "Retorne apenas o texto normalizado para a seguinte transcrição: "
 	functionCallName           = "resume"
+	functionDescription        = "Registra o título, a descrição e os resumos da transcrição"
+	finishReasonLength         = "length"
 )
 
 type (
-	ChatgptFunctionCallRequest struct {
-		Model        string       `json:"model"`
-		Messages     []Message    `json:"messages"`
-		Functions    []Function   `json:"functions,omitempty"`
-		FunctionCall FunctionCall `json:"function_call,omitempty"`
+	// ChatgptToolRequest forces the resume tool, whose arguments follow its
+	// schema exactly in strict mode. Parallel calls are disabled, as they are
+	// not covered by strict mode.
+	ChatgptToolRequest struct {
+		Model             string     `json:"model"`
+		Messages          []Message  `json:"messages"`
+		Tools             []Tool     `json:"tools"`
+		ToolChoice        ToolChoice `json:"tool_choice"`
+		ParallelToolCalls bool       `json:"parallel_tool_calls"`
 	}
 
 	ChatgptSimpleRequest struct {
@@ -31,45 +37,48 @@ type (
 		Messages []Message `json:"messages"`
 	}
 
-	FunctionCall struct {
-		Name string `json:"name"`
-	}
-
 	Message struct {
 		Role    string `json:"role"`
 		Content string `json:"content"`
 	}
 
-	Function struct {
-		Name       string         `json:"name"`
-		Parameters FunctionParams `json:"parameters"`
+	Tool struct {
+		Type     string   `json:"type"`
+		Function Function `json:"function"`
 	}
 
-	FunctionParams struct {
-		Type       string         `json:"type"`
-		Properties FunctionFields `json:"properties"`
+	Function struct {
+		Name        string               `json:"name"`
+		Description string               `json:"description"`
+		Strict      bool                 `json:"strict"`
+		Parameters  summarize.JSONSchema `json:"parameters"`
 	}
 
-	FunctionFields struct {
-		Title        FieldSpec `json:"title"`
-		Description  FieldSpec `json:"description"`
-		BriefResume  FieldSpec `json:"briefResume"`
-		MediumResume FieldSpec `json:"mediumResume"`
+	ToolChoice struct {
+		Type     string       `json:"type"`
+		Function FunctionName `json:"function"`
 	}
 
-	FieldSpec struct {
-		Type        string `json:"type"`
-		Description string `json:"description"`
+	FunctionName struct {
+		Name string `json:"name"`
 	}
 
 	ChatResumeCompletionResponse struct {
 		Choices []struct {
 			Message struct {
-				FunctionCall struct {
-					Arguments string `json:"arguments"`
-				} `json:"function_call"`
+				Refusal   string     `json:"refusal,omitempty"`
+				ToolCalls []ToolCall `json:"tool_calls"`
 			} `json:"message"`
+			FinishReason string `json:"finish_reason"`
 		} `json:"choices"`
+		Usage Usage `json:"usage"`
+	}
+
+	ToolCall struct {
+		Function struct {
+			Name      string `json:"name"`
+			Arguments string `json:"arguments"`
+		} `json:"function"`
 	}
 
 	ChatFullTextCompletionResponse struct {
@@ -78,6 +87,12 @@ type (
 				Content string `json:"content"`
 			} `json:"message"`
 		} `json:"choices"`
+		Usage Usage `json:"usage"`
+	}
+
+	Usage struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
 	}
 )
 
@@ -86,6 +101,7 @@ type Client struct {
 	ApiKey      string
 	ServiceName string
 	Model       string
+	Azure       *clients.Azure
 }
 
 func NewClient(serviceName, URL, apiKey, model string, timeout int64) *Client {
@@ -97,20 +113,29 @@ func NewClient(serviceName, URL, apiKey, model string, timeout int64) *Client {
 	}
 }
 
-func (c *Client) Resume(ctx context.Context, transcription string) (
+func NewAzureClient(serviceName, URL, apiKey, deployment, apiVersion, model string, timeout int64) *Client {
+	client := NewClient(serviceName, URL, apiKey, model, timeout)
+	client.Azure = &clients.Azure{
+		Deployment: deployment,
+		ApiVersion: apiVersion,
+	}
+
+	return client
+}
+
+func (c *Client) Resume(ctx context.Context, input summarize.Input) (
 	*summarize.ResumeOutput, error,
 ) {
 	clients.Mutex.Lock()
-	req := c.HttpClient.Client.
-		SetHeader("Authorization", "Bearer "+c.ApiKey).
+	req := clients.OpenAIAuth(c.HttpClient.Client, c.ApiKey, c.Azure).
 		SetHeader("Content-Type", "application/json").
-		SetBody(c.buildResumeRequest(transcription))
+		SetBody(c.buildResumeRequest(input))
 
-	res, err := req.Post(basePath)
+	res, err := req.Post(clients.OpenAIPath(basePath, c.Azure))
 	clients.Mutex.Unlock()
 
 	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("error on chatgpt resume request: response=%s | status=%s", res.Body(), res.Status())
+		return nil, clients.NewRequestError(res.StatusCode(), "error on chatgpt resume request: response=%s | status=%s", res.Body(), res.Status())
 	}
 
 	if err != nil {
@@ -127,27 +152,50 @@ func (c *Client) Resume(ctx context.Context, transcription string) (
 		return nil, fmt.Errorf("error on chatgpt resume request: no choices in response")
 	}
 
+	message := choices[0].Message
+	if message.Refusal != "" {
+		return nil, fmt.Errorf("error on chatgpt resume request: refusal=%s", message.Refusal)
+	}
+
+	// arguments cut by the token limit are not a valid object
+	if choices[0].FinishReason == finishReasonLength {
+		return nil, fmt.Errorf("error on chatgpt resume request: response truncated by the token limit")
+	}
+
+	var arguments *string
+	for _, call := range message.ToolCalls {
+		if call.Function.Name == functionCallName {
+			arguments = &call.Function.Arguments
+			break
+		}
+	}
+
+	if arguments == nil {
+		return nil, fmt.Errorf("error on chatgpt resume request: no tool call in response")
+	}
+
 	var response summarize.ResumeOutput
-	if err = json.Unmarshal([]byte(choices[0].Message.FunctionCall.Arguments), &response); err != nil {
+	if err = json.Unmarshal([]byte(*arguments), &response); err != nil {
 		return nil, fmt.Errorf("error on chatgpt resume request: error=%s", err.Error())
 	}
 
+	response.Usage = c.tokenUsage(chatResponse.Usage)
+
 	return &response, nil
 }
 
-func (c *Client) FullTextOrganize(ctx context.Context, transcription string) (*string, error) {
+func (c *Client) FullTextOrganize(ctx context.Context, input summarize.Input) (*summarize.FullTextOutput, error) {
 	clients.Mutex.Lock()
 
-	req := c.HttpClient.Client.
-		SetHeader("Authorization", "Bearer "+c.ApiKey).
+	req := clients.OpenAIAuth(c.HttpClient.Client, c.ApiKey, c.Azure).
 		SetHeader("Content-Type", "application/json").
-		SetBody(c.buildFullTextOrganizeRequest(transcription))
+		SetBody(c.buildFullTextOrganizeRequest(input))
 
-	res, err := req.Post(basePath)
+	res, err := req.Post(clients.OpenAIPath(basePath, c.Azure))
 	clients.Mutex.Unlock()
 
 	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("error on chatgpt full text organize request: response=%s | status%s", res.Body(), res.Status())
+		return nil, clients.NewRequestError(res.StatusCode(), "error on chatgpt full text organize request: response=%s | status%s", res.Body(), res.Status())
 	}
 
 	if err != nil {
@@ -163,14 +211,23 @@ func (c *Client) FullTextOrganize(ctx context.Context, transcription string) (*s
 		return nil, fmt.Errorf("error on chatgpt full text organize request: empty response")
 	}
 
-	responseText := response.Choices[0].Message.Content
-	return &responseText, nil
+	return &summarize.FullTextOutput{
+		Text:  response.Choices[0].Message.Content,
+		Usage: c.tokenUsage(response.Usage),
+	}, nil
 }
 
-func (c *Client) buildResumeRequest(transcription string) ChatgptFunctionCallRequest {
-	fullResumeUserPrompt := resumeUserPrompt + "\n" + transcription
+func (c *Client) tokenUsage(usage Usage) summarize.TokenUsage {
+	return summarize.TokenUsage{
+		Model:            c.Model,
+		Provider:         c.ServiceName,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+	}
+}
 
-	return ChatgptFunctionCallRequest{
+func (c *Client) buildResumeRequest(input summarize.Input) ChatgptToolRequest {
+	return ChatgptToolRequest{
 		Model: c.Model,
 		Messages: []Message{
 			{
@@ -179,48 +236,32 @@ func (c *Client) buildResumeRequest(transcription string) ChatgptFunctionCallReq
 			},
 			{
 				Role:    "user",
-				Content: fullResumeUserPrompt,
+				Content: input.Content(resumeUserPrompt),
 			},
 		},
-		Functions: buildFunctionCallRequest(),
-		FunctionCall: FunctionCall{
-			Name: functionCallName,
+		Tools: buildResumeTool(),
+		ToolChoice: ToolChoice{
+			Type:     "function",
+			Function: FunctionName{Name: functionCallName},
 		},
 	}
 }
 
-func buildFunctionCallRequest() []Function {
-	return []Function{
+func buildResumeTool() []Tool {
+	return []Tool{
 		{
-			Name: functionCallName,
-			Parameters: FunctionParams{
-				Type: "object",
-				Properties: FunctionFields{
-					Title: FieldSpec{
-						Type:        "string",
-						Description: "Título de até 60 caracteres",
-					},
-					Description: FieldSpec{
-						Type:        "string",
-						Description: "Descrição de até 300 caracteres",
-					},
-					BriefResume: FieldSpec{
-						Type:        "string",
-						Description: "Resumo breve de até 5 linhas",
-					},
-					MediumResume: FieldSpec{
-						Type:        "string",
-						Description: "Resumo médio de até 15 linhas",
-					},
-				},
+			Type: "function",
+			Function: Function{
+				Name:        functionCallName,
+				Description: functionDescription,
+				Strict:      true,
+				Parameters:  summarize.Schema(summarize.ResumeOutput{}),
 			},
 		},
 	}
 }
 
-func (c *Client) buildFullTextOrganizeRequest(transcription string) ChatgptSimpleRequest {
-	fullTextOrganizePrompt := fullTextOrganizeUserPrompt + "\n" + transcription
-
+func (c *Client) buildFullTextOrganizeRequest(input summarize.Input) ChatgptSimpleRequest {
 	return ChatgptSimpleRequest{
 		Model: c.Model,
 		Messages: []Message{
@@ -230,7 +271,7 @@ func (c *Client) buildFullTextOrganizeRequest(transcription string) ChatgptSimpl
 			},
 			{
 				Role:    "user",
-				Content: fullTextOrganizePrompt,
+				Content: input.Content(fullTextOrganizeUserPrompt),
 			},
 		},
 	}
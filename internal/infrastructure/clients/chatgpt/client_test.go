@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/diegofsousa/explicAI/internal/gateway/summarize"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/mock"
@@ -29,6 +30,12 @@ var (
 	//go:embed embed/chatgpt-resume-response-no-choices.json
 	chatgptResumeResponseNoChoices string
 
+	//go:embed embed/chatgpt-resume-response-refusal.json
+	chatgptResumeResponseRefusal string
+
+	//go:embed embed/chatgpt-resume-response-truncated.json
+	chatgptResumeResponseTruncated string
+
 	//go:embed embed/chatgpt-fulltext-response.json
 	chatgptFulltextResponse string
 
@@ -83,12 +90,14 @@ func (s *ChatgptClientTestSuite) TestChatgptResume() {
 
 		defer server.Close()
 
-		result, err := s.chatgptClient.Resume(s.ctx, "xpto")
+		result, err := s.chatgptClient.Resume(s.ctx, summarize.Input{Transcription: "xpto"})
 		s.NoError(err)
 		s.Equal("title test", result.Title)
 		s.Equal("description test.", result.Description)
 		s.Equal("brief test", result.BriefResume)
 		s.Equal("medium test", result.MediumResume)
+		s.Equal(1200, result.Usage.PromptTokens)
+		s.Equal(300, result.Usage.CompletionTokens)
 	})
 
 	s.Run("fail response with status code error", func() {
@@ -106,7 +115,7 @@ func (s *ChatgptClientTestSuite) TestChatgptResume() {
 
 		defer server.Close()
 
-		_, err := s.chatgptClient.Resume(s.ctx, "xpto")
+		_, err := s.chatgptClient.Resume(s.ctx, summarize.Input{Transcription: "xpto"})
 		s.Error(err)
 		s.EqualError(err, "error on chatgpt resume request: response= | status=500 Internal Server Error")
 	})
@@ -128,7 +137,7 @@ func (s *ChatgptClientTestSuite) TestChatgptResume() {
 
 		defer server.Close()
 
-		_, err := s.chatgptClient.Resume(s.ctx, "xpto")
+		_, err := s.chatgptClient.Resume(s.ctx, summarize.Input{Transcription: "xpto"})
 		s.Error(err)
 		s.EqualError(err, "error on chatgpt resume request: error=json: cannot unmarshal array into Go value of type chatgpt.ChatResumeCompletionResponse")
 	})
@@ -150,7 +159,7 @@ func (s *ChatgptClientTestSuite) TestChatgptResume() {
 
 		defer server.Close()
 
-		_, err := s.chatgptClient.Resume(s.ctx, "xpto")
+		_, err := s.chatgptClient.Resume(s.ctx, summarize.Input{Transcription: "xpto"})
 		s.Error(err)
 		s.EqualError(err, "error on chatgpt resume request: no choices in response")
 	})
@@ -172,12 +181,109 @@ func (s *ChatgptClientTestSuite) TestChatgptResume() {
 
 		defer server.Close()
 
-		_, err := s.chatgptClient.Resume(s.ctx, "xpto")
+		_, err := s.chatgptClient.Resume(s.ctx, summarize.Input{Transcription: "xpto"})
 		s.Error(err)
 		s.EqualError(err, "error on chatgpt resume request: error=invalid character 'x' looking for beginning of value")
 	})
 }
 
+func (s *ChatgptClientTestSuite) TestChatgptResumeRejected() {
+	cases := map[string]struct {
+		response string
+		err      string
+	}{
+		"refusal": {
+			response: chatgptResumeResponseRefusal,
+			err:      "error on chatgpt resume request: refusal=I'm sorry, I cannot help with that request.",
+		},
+		"arguments truncated by the token limit": {
+			response: chatgptResumeResponseTruncated,
+			err:      "error on chatgpt resume request: response truncated by the token limit",
+		},
+	}
+
+	for name, c := range cases {
+		s.Run(name, func() {
+			var response ChatResumeCompletionResponse
+			json.Unmarshal([]byte(c.response), &response)
+
+			server := clients.StartMockServer(clients.HttpServerMockParams{
+				ExpectedPath:   basePath,
+				ExpectedMethod: http.MethodPost,
+				ResponseStatus: http.StatusOK,
+				ResponseObject: response,
+			}, config.Sub("chatgpt").GetString("host"))
+
+			defer server.Close()
+
+			client := getClientConfig(*config.Sub("chatgpt"))
+			_, err := client.Resume(s.ctx, summarize.Input{Transcription: "xpto"})
+			s.EqualError(err, c.err)
+		})
+	}
+}
+
+func (s *ChatgptClientTestSuite) TestBuildResumeRequest() {
+	body, err := json.Marshal(s.chatgptClient.buildResumeRequest(summarize.Input{Transcription: "xpto"}))
+	s.Require().NoError(err)
+
+	var request map[string]any
+	s.Require().NoError(json.Unmarshal(body, &request))
+	s.NotContains(request, "functions")
+	s.Equal(false, request["parallel_tool_calls"])
+	s.Equal(map[string]any{"type": "function", "function": map[string]any{"name": "resume"}}, request["tool_choice"])
+
+	function := request["tools"].([]any)[0].(map[string]any)["function"].(map[string]any)
+	s.Equal(true, function["strict"])
+
+	parameters := function["parameters"].(map[string]any)
+	s.Equal(false, parameters["additionalProperties"])
+	s.Equal([]any{"title", "description", "briefResume", "mediumResume"}, parameters["required"])
+	s.Equal(map[string]any{"type": "string", "description": "Título de até 60 caracteres"},
+		parameters["properties"].(map[string]any)["title"])
+
+	s.Run("send back the problems of a rejected response", func() {
+		request := s.chatgptClient.buildResumeRequest(summarize.Input{
+			Transcription: "xpto",
+			Rejected:      &summarize.Rejected{Problems: []string{"title has 83 characters, more than 60"}},
+		})
+
+		s.Len(request.Messages, 2)
+		s.Contains(request.Messages[1].Content, "xpto")
+		s.Contains(request.Messages[1].Content, "title has 83 characters, more than 60")
+	})
+}
+
+func (s *ChatgptClientTestSuite) TestChatgptAzure() {
+	s.Run("successful request/response on the deployment", func() {
+		var response ChatResumeCompletionResponse
+		json.Unmarshal([]byte(chatgptResumeResponse), &response)
+
+		httpServerMockParams := clients.HttpServerMockParams{
+			ExpectedPath:   "/openai/deployments/gpt-4o-prod/chat/completions",
+			ExpectedMethod: http.MethodPost,
+			ResponseStatus: http.StatusOK,
+			ResponseObject: response,
+		}
+
+		server := clients.StartMockServer(httpServerMockParams,
+			config.Sub("chatgpt").GetString("host"),
+		)
+
+		defer server.Close()
+
+		client := NewAzureClient("chatgpt", config.Sub("chatgpt").GetString("url"), "xpto", "gpt-4o-prod", "2024-06-01", "gpt-4o", 10000)
+
+		result, err := client.Resume(s.ctx, summarize.Input{Transcription: "xpto"})
+		s.Require().NoError(err)
+		s.Equal("title test", result.Title)
+		s.Equal("gpt-4o", result.Usage.Model)
+		s.Equal("xpto", client.HttpClient.Client.Header.Get("api-key"))
+		s.Empty(client.HttpClient.Client.Header.Get("Authorization"))
+		s.Equal("2024-06-01", client.HttpClient.Client.QueryParam.Get("api-version"))
+	})
+}
+
 func (s *ChatgptClientTestSuite) TestChatgptFulltext() {
 	s.Run("successful request/response", func() {
 		var response ChatFullTextCompletionResponse
@@ -196,9 +302,11 @@ func (s *ChatgptClientTestSuite) TestChatgptFulltext() {
 
 		defer server.Close()
 
-		result, err := s.chatgptClient.FullTextOrganize(s.ctx, "xpto")
+		result, err := s.chatgptClient.FullTextOrganize(s.ctx, summarize.Input{Transcription: "xpto"})
 		s.NoError(err)
-		s.Equal("text", *result)
+		s.Equal("text", result.Text)
+		s.Equal(1100, result.Usage.PromptTokens)
+		s.Equal(900, result.Usage.CompletionTokens)
 	})
 
 	s.Run("fail response with unmarshall error", func() {
@@ -218,7 +326,7 @@ func (s *ChatgptClientTestSuite) TestChatgptFulltext() {
 
 		defer server.Close()
 
-		_, err := s.chatgptClient.FullTextOrganize(s.ctx, "xpto")
+		_, err := s.chatgptClient.FullTextOrganize(s.ctx, summarize.Input{Transcription: "xpto"})
 		s.Error(err)
 		s.EqualError(err, "error on chatgpt full text organize request: error=json: cannot unmarshal array into Go value of type chatgpt.ChatFullTextCompletionResponse")
 	})
@@ -240,7 +348,7 @@ func (s *ChatgptClientTestSuite) TestChatgptFulltext() {
 
 		defer server.Close()
 
-		_, err := s.chatgptClient.FullTextOrganize(s.ctx, "xpto")
+		_, err := s.chatgptClient.FullTextOrganize(s.ctx, summarize.Input{Transcription: "xpto"})
 		s.Error(err)
 		s.EqualError(err, "error on chatgpt full text organize request: empty response")
 	})
@@ -0,0 +1,116 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/download"
+)
+
+// allowedContentTypes are the media types accepted from file servers, which
+// often serve recordings as a generic binary stream.
+var allowedContentTypes = map[string]bool{
+	"video/mp4":                true,
+	"video/mpeg":               true,
+	"video/webm":               true,
+	"application/octet-stream": true,
+}
+
+type Client struct {
+	HttpClient  *http.Client
+	ServiceName string
+	MaxSize     int64
+}
+
+func NewClient(serviceName string, maxSize, timeout int64) *Client {
+	return &Client{
+		HttpClient:  &http.Client{Timeout: time.Duration(timeout) * time.Millisecond},
+		ServiceName: serviceName,
+		MaxSize:     maxSize,
+	}
+}
+
+func (c *Client) Download(ctx context.Context, rawURL string) (*download.DownloadOutput, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error on %s request: error=%s", c.ServiceName, err.Error())
+	}
+
+	res, err := c.HttpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error on %s request: error=%s", c.ServiceName, err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error on %s request: status=%d", c.ServiceName, res.StatusCode)
+	}
+
+	contentType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if err != nil || !(strings.HasPrefix(contentType, "audio/") || allowedContentTypes[contentType]) {
+		return nil, fmt.Errorf("error on %s request: unsupported content type=%s", c.ServiceName, res.Header.Get("Content-Type"))
+	}
+
+	if res.ContentLength > c.MaxSize {
+		return nil, fmt.Errorf("error on %s request: file too large size=%d max=%d", c.ServiceName, res.ContentLength, c.MaxSize)
+	}
+
+	file, err := os.CreateTemp("", "explicai-download-*")
+	if err != nil {
+		return nil, fmt.Errorf("error on %s response read: error=%s", c.ServiceName, err.Error())
+	}
+
+	content := &tempFile{File: file}
+
+	// copies one byte past the limit to detect bodies without content length
+	size, err := io.Copy(file, io.LimitReader(res.Body, c.MaxSize+1))
+	if err != nil {
+		content.Close()
+		return nil, fmt.Errorf("error on %s response read: error=%s", c.ServiceName, err.Error())
+	}
+
+	if size > c.MaxSize {
+		content.Close()
+		return nil, fmt.Errorf("error on %s request: file too large max=%d", c.ServiceName, c.MaxSize)
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		content.Close()
+		return nil, fmt.Errorf("error on %s response read: error=%s", c.ServiceName, err.Error())
+	}
+
+	return &download.DownloadOutput{
+		Content:     content,
+		Size:        size,
+		ContentType: contentType,
+		Filename:    filename(res.Request.URL),
+	}, nil
+}
+
+// tempFile removes the downloaded file once it is closed.
+type tempFile struct {
+	*os.File
+}
+
+func (f *tempFile) Close() error {
+	err := f.File.Close()
+	os.Remove(f.Name())
+	return err
+}
+
+func filename(u *url.URL) string {
+	name := path.Base(u.Path)
+	if name == "/" || name == "." {
+		return ""
+	}
+
+	return name
+}
@@ -0,0 +1,119 @@
+package download
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DownloadClientTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	server *httptest.Server
+	client *Client
+	tmpDir string
+}
+
+func TestDownloadClient(t *testing.T) {
+	suite.Run(t, new(DownloadClientTestSuite))
+}
+
+func (s *DownloadClientTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.tmpDir = s.T().TempDir()
+	s.T().Setenv("TMPDIR", s.tmpDir)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/meeting.mp3", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.Write([]byte("0123456789"))
+	})
+	mux.HandleFunc("/recording", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("0123"))
+	})
+	mux.HandleFunc("/page.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html></html>"))
+	})
+	mux.HandleFunc("/large.mp3", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.Write([]byte(strings.Repeat("0", 32)))
+	})
+	mux.HandleFunc("/chunked.mp3", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "audio/mpeg")
+		for i := 0; i < 4; i++ {
+			w.Write([]byte(strings.Repeat("0", 8)))
+			w.(http.Flusher).Flush()
+		}
+	})
+	mux.HandleFunc("/slow.mp3", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	})
+
+	s.server = httptest.NewServer(mux)
+	s.client = NewClient("download", 16, 100)
+}
+
+func (s *DownloadClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *DownloadClientTestSuite) TestDownload() {
+	s.Run("successful download", func() {
+		output, err := s.client.Download(s.ctx, s.server.URL+"/meeting.mp3")
+		s.Require().NoError(err)
+
+		content, err := io.ReadAll(output.Content)
+		s.Require().NoError(err)
+		s.Equal("0123456789", string(content))
+		s.Equal(int64(10), output.Size)
+		s.Equal("audio/mpeg", output.ContentType)
+		s.Equal("meeting.mp3", output.Filename)
+
+		s.Require().NoError(output.Content.Close())
+		s.Empty(s.tempFiles())
+	})
+
+	s.Run("accept generic binary content", func() {
+		output, err := s.client.Download(s.ctx, s.server.URL+"/recording")
+		s.Require().NoError(err)
+		defer output.Content.Close()
+		s.Equal("application/octet-stream", output.ContentType)
+	})
+
+	s.Run("reject unsupported content type", func() {
+		_, err := s.client.Download(s.ctx, s.server.URL+"/page.html")
+		s.Require().ErrorContains(err, "unsupported content type")
+	})
+
+	s.Run("reject files above the size limit", func() {
+		_, err := s.client.Download(s.ctx, s.server.URL+"/large.mp3")
+		s.Require().ErrorContains(err, "file too large")
+
+		_, err = s.client.Download(s.ctx, s.server.URL+"/chunked.mp3")
+		s.Require().ErrorContains(err, "file too large")
+		s.Empty(s.tempFiles())
+	})
+
+	s.Run("fail on status and timeout", func() {
+		_, err := s.client.Download(s.ctx, s.server.URL+"/missing.mp3")
+		s.Require().ErrorContains(err, "status=404")
+
+		_, err = s.client.Download(s.ctx, s.server.URL+"/slow.mp3")
+		s.Require().Error(err)
+	})
+}
+
+func (s *DownloadClientTestSuite) tempFiles() []os.DirEntry {
+	entries, err := os.ReadDir(s.tmpDir)
+	s.Require().NoError(err)
+	return entries
+}
@@ -0,0 +1,38 @@
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// RequestError is returned when the provider could not be reached or answered
+// with an error status. It keeps the status, so callers can tell an outage
+// from a bad request.
+type RequestError struct {
+	StatusCode int
+	Message    string
+}
+
+func NewRequestError(statusCode int, format string, args ...any) error {
+	return &RequestError{
+		StatusCode: statusCode,
+		Message:    fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *RequestError) Error() string {
+	return e.Message
+}
+
+// Retryable tells whether another provider may serve the call: the request got
+// no answer, as on timeouts, or the provider is rate limited or failing.
+func Retryable(err error) bool {
+	var requestError *RequestError
+	if !errors.As(err, &requestError) {
+		return false
+	}
+
+	status := requestError.StatusCode
+	return status == 0 || status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
@@ -0,0 +1,89 @@
+package failover
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"go.uber.org/zap"
+)
+
+// Provider is a client the router may call. Weight is the share of the calls
+// it serves first; providers without weight are only tried on failover.
+type Provider[T any] struct {
+	Name   string
+	Weight int
+	Client T
+}
+
+type router[T any] struct {
+	providers []Provider[T]
+	random    func(n int) int
+}
+
+func newRouter[T any](providers []Provider[T]) router[T] {
+	return router[T]{
+		providers: providers,
+		random:    rand.Intn,
+	}
+}
+
+// order returns the providers in the order they are tried. The first one is
+// drawn by weight and the others follow the configured order; without
+// weights the configured order is kept.
+func (r router[T]) order() []Provider[T] {
+	total := 0
+	for _, provider := range r.providers {
+		total += max(provider.Weight, 0)
+	}
+
+	if total == 0 {
+		return r.providers
+	}
+
+	first, draw := 0, r.random(total)
+	for i, provider := range r.providers {
+		draw -= max(provider.Weight, 0)
+		if draw < 0 {
+			first = i
+			break
+		}
+	}
+
+	ordered := make([]Provider[T], 0, len(r.providers))
+	ordered = append(ordered, r.providers[first])
+	ordered = append(ordered, r.providers[:first]...)
+	return append(ordered, r.providers[first+1:]...)
+}
+
+// call tries the providers until one serves the call. It moves to the next
+// provider only when the error is retryable, so a bad request is not sent to
+// every provider.
+func call[T, O any](ctx context.Context, r router[T], operation string, fn func(T) (O, error)) (O, error) {
+	var (
+		output O
+		err    error
+	)
+
+	providers := r.order()
+	for i, provider := range providers {
+		output, err = fn(provider.Client)
+		if err == nil {
+			if i > 0 {
+				log.LogInfo(ctx, "call served by failover provider",
+					zap.String("operation", operation), zap.String("provider", provider.Name))
+			}
+			return output, nil
+		}
+
+		if !clients.Retryable(err) || ctx.Err() != nil || i == len(providers)-1 {
+			break
+		}
+
+		log.LogWarn(ctx, "provider failed, trying the next one",
+			zap.String("operation", operation), zap.String("provider", provider.Name), zap.Error(err))
+	}
+
+	return output, err
+}
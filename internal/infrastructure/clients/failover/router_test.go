@@ -0,0 +1,187 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/audiotranscript"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/diegofsousa/explicAI/internal/gateway/summarize"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FailoverTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestFailover(t *testing.T) {
+	suite.Run(t, new(FailoverTestSuite))
+}
+
+func (s *FailoverTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *FailoverTestSuite) TestOrder() {
+	providers := []Provider[string]{
+		{Name: "chatgpt", Weight: 90},
+		{Name: "ollama"},
+		{Name: "anthropic", Weight: 10},
+	}
+
+	cases := map[string]struct {
+		draw  int
+		order []string
+	}{
+		"first weighted provider":  {draw: 89, order: []string{"chatgpt", "ollama", "anthropic"}},
+		"second weighted provider": {draw: 90, order: []string{"anthropic", "chatgpt", "ollama"}},
+	}
+
+	for name, c := range cases {
+		s.Run(name, func() {
+			r := newRouter(providers)
+			r.random = func(n int) int {
+				s.Equal(100, n)
+				return c.draw
+			}
+
+			s.Equal(c.order, names(r.order()))
+		})
+	}
+
+	s.Run("configured order without weights", func() {
+		r := newRouter([]Provider[string]{{Name: "chatgpt"}, {Name: "anthropic"}})
+		s.Equal([]string{"chatgpt", "anthropic"}, names(r.order()))
+	})
+}
+
+func (s *FailoverTestSuite) TestSummarize() {
+	s.Run("fail over on a provider outage", func() {
+		primary, secondary := new(gatewaymocks.Summarize), new(gatewaymocks.Summarize)
+		primary.EXPECT().Resume(mock.Anything, summarize.Input{Transcription: "transcription"}).
+			Return(nil, clients.NewRequestError(http.StatusServiceUnavailable, "unavailable"))
+		secondary.EXPECT().Resume(mock.Anything, summarize.Input{Transcription: "transcription"}).
+			Return(resumeOutput("anthropic"), nil)
+
+		output, err := NewSummarize(summarizeProviders(primary, secondary)).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.Require().NoError(err)
+		s.Equal("anthropic", output.Usage.Provider)
+		primary.AssertExpectations(s.T())
+		secondary.AssertExpectations(s.T())
+	})
+
+	s.Run("fail over on rate limits and timeouts", func() {
+		primary, secondary, tertiary := new(gatewaymocks.Summarize), new(gatewaymocks.Summarize), new(gatewaymocks.Summarize)
+		primary.EXPECT().FullTextOrganize(mock.Anything, summarize.Input{Transcription: "transcription"}).
+			Return(nil, clients.NewRequestError(http.StatusTooManyRequests, "rate limited"))
+		secondary.EXPECT().FullTextOrganize(mock.Anything, summarize.Input{Transcription: "transcription"}).
+			Return(nil, clients.NewRequestError(0, "context deadline exceeded"))
+		tertiary.EXPECT().FullTextOrganize(mock.Anything, summarize.Input{Transcription: "transcription"}).
+			Return(&summarize.FullTextOutput{Text: "text"}, nil)
+
+		output, err := NewSummarize(summarizeProviders(primary, secondary, tertiary)).FullTextOrganize(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.Require().NoError(err)
+		s.Equal("text", output.Text)
+	})
+
+	s.Run("keep the error of a bad request", func() {
+		primary, secondary := new(gatewaymocks.Summarize), new(gatewaymocks.Summarize)
+		primary.EXPECT().Resume(mock.Anything, summarize.Input{Transcription: "transcription"}).
+			Return(nil, clients.NewRequestError(http.StatusBadRequest, "context length exceeded"))
+
+		_, err := NewSummarize(summarizeProviders(primary, secondary)).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, "context length exceeded")
+		secondary.AssertNotCalled(s.T(), "Resume", mock.Anything, mock.Anything)
+	})
+
+	s.Run("return the error of the last provider", func() {
+		primary, secondary := new(gatewaymocks.Summarize), new(gatewaymocks.Summarize)
+		primary.EXPECT().Resume(mock.Anything, summarize.Input{Transcription: "transcription"}).
+			Return(nil, clients.NewRequestError(http.StatusBadGateway, "bad gateway"))
+		secondary.EXPECT().Resume(mock.Anything, summarize.Input{Transcription: "transcription"}).
+			Return(nil, clients.NewRequestError(http.StatusServiceUnavailable, "unavailable"))
+
+		_, err := NewSummarize(summarizeProviders(primary, secondary)).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, "unavailable")
+	})
+}
+
+func (s *FailoverTestSuite) TestAudioTranscript() {
+	s.Run("send the whole audio to the next provider", func() {
+		primary, secondary := new(gatewaymocks.AudioTranscript), new(gatewaymocks.AudioTranscript)
+		primary.EXPECT().Transcribe(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, input audiotranscript.TranscribeInput) (*audiotranscript.TranscribeOutput, error) {
+				io.CopyN(io.Discard, input.Audio, 3)
+				return nil, clients.NewRequestError(http.StatusInternalServerError, "internal error")
+			})
+		secondary.EXPECT().Transcribe(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, input audiotranscript.TranscribeInput) (*audiotranscript.TranscribeOutput, error) {
+				content, _ := io.ReadAll(input.Audio)
+				return &audiotranscript.TranscribeOutput{Text: string(content), Provider: "local-whisper"}, nil
+			})
+
+		transcript := NewAudioTranscript([]Provider[audiotranscript.AudioTranscript]{
+			{Name: "openai", Client: primary},
+			{Name: "local", Client: secondary},
+		})
+
+		// a reader that cannot seek, as the body of an object storage download
+		output, err := transcript.Transcribe(s.ctx, audiotranscript.TranscribeInput{
+			Audio: io.MultiReader(strings.NewReader("RIFF audio")),
+		})
+
+		s.Require().NoError(err)
+		s.Equal("RIFF audio", output.Text)
+		s.Equal("local-whisper", output.Provider)
+	})
+
+	s.Run("keep errors that are not from the provider", func() {
+		primary, secondary := new(gatewaymocks.AudioTranscript), new(gatewaymocks.AudioTranscript)
+		primary.EXPECT().Transcribe(mock.Anything, mock.Anything).
+			Return(nil, errors.New("error on whisper request: error=empty response"))
+
+		transcript := NewAudioTranscript([]Provider[audiotranscript.AudioTranscript]{
+			{Name: "openai", Client: primary},
+			{Name: "local", Client: secondary},
+		})
+
+		_, err := transcript.Transcribe(s.ctx, audiotranscript.TranscribeInput{Audio: strings.NewReader("RIFF")})
+
+		s.EqualError(err, "error on whisper request: error=empty response")
+		secondary.AssertNotCalled(s.T(), "Transcribe", mock.Anything, mock.Anything)
+	})
+}
+
+func names[T any](providers []Provider[T]) []string {
+	var names []string
+	for _, provider := range providers {
+		names = append(names, provider.Name)
+	}
+	return names
+}
+
+func summarizeProviders(clients ...summarize.Summarize) []Provider[summarize.Summarize] {
+	var providers []Provider[summarize.Summarize]
+	for _, client := range clients {
+		providers = append(providers, Provider[summarize.Summarize]{Name: "provider", Client: client})
+	}
+	return providers
+}
+
+func resumeOutput(provider string) *summarize.ResumeOutput {
+	return &summarize.ResumeOutput{
+		Title: "title",
+		Usage: summarize.TokenUsage{Model: "model", Provider: provider},
+	}
+}
@@ -0,0 +1,31 @@
+package failover
+
+import (
+	"context"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/summarize"
+)
+
+// Summarize spreads the summaries among several providers and fails over to
+// the next one when a provider is down.
+type Summarize struct {
+	router router[summarize.Summarize]
+}
+
+func NewSummarize(providers []Provider[summarize.Summarize]) *Summarize {
+	return &Summarize{
+		router: newRouter(providers),
+	}
+}
+
+func (s *Summarize) Resume(ctx context.Context, input summarize.Input) (*summarize.ResumeOutput, error) {
+	return call(ctx, s.router, "resume", func(provider summarize.Summarize) (*summarize.ResumeOutput, error) {
+		return provider.Resume(ctx, input)
+	})
+}
+
+func (s *Summarize) FullTextOrganize(ctx context.Context, input summarize.Input) (*summarize.FullTextOutput, error) {
+	return call(ctx, s.router, "fulltext", func(provider summarize.Summarize) (*summarize.FullTextOutput, error) {
+		return provider.FullTextOrganize(ctx, input)
+	})
+}
@@ -0,0 +1,63 @@
+package failover
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/audiotranscript"
+)
+
+// AudioTranscript spreads the transcriptions among several providers and
+// fails over to the next one when a provider is down.
+type AudioTranscript struct {
+	router router[audiotranscript.AudioTranscript]
+}
+
+func NewAudioTranscript(providers []Provider[audiotranscript.AudioTranscript]) *AudioTranscript {
+	return &AudioTranscript{
+		router: newRouter(providers),
+	}
+}
+
+// Transcribe rewinds the audio before each attempt. Audio that cannot seek,
+// such as an object storage stream, is first copied to a temporary file.
+func (t *AudioTranscript) Transcribe(ctx context.Context, input audiotranscript.TranscribeInput) (*audiotranscript.TranscribeOutput, error) {
+	audio, ok := input.Audio.(io.ReadSeeker)
+	if !ok && len(t.router.providers) > 1 {
+		file, err := spool(input.Audio)
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(file.Name())
+		defer file.Close()
+
+		audio = file
+	}
+
+	return call(ctx, t.router, "transcription", func(provider audiotranscript.AudioTranscript) (*audiotranscript.TranscribeOutput, error) {
+		if audio != nil {
+			if _, err := audio.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+			input.Audio = audio
+		}
+
+		return provider.Transcribe(ctx, input)
+	})
+}
+
+func spool(content io.Reader) (*os.File, error) {
+	file, err := os.CreateTemp("", "explicai-transcribe-*")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = io.Copy(file, content); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+
+	return file, nil
+}
@@ -0,0 +1,170 @@
+package localwhisper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/audiotranscript"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
+)
+
+const (
+	ServerWhisperCpp    = "whispercpp"
+	ServerFasterWhisper = "faster-whisper"
+	whisperCppPath      = "/inference"
+	fasterWhisperPath   = "/v1/audio/transcriptions"
+	responseFormat      = "verbose_json"
+	defaultFilename     = "audio.wav"
+	defaultContentType  = "application/octet-stream"
+	errorMessagePrefix  = "error on local whisper request"
+)
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// Client transcribes with a Whisper server running in our own infrastructure,
+// either the whisper.cpp server or faster-whisper-server, so the audio never
+// leaves the network.
+type Client struct {
+	HttpClient  *clients.BaseHTTP
+	ServiceName string
+	Server      string
+	ApiKey      string
+	Model       string
+	Language    string
+}
+
+type Segment struct {
+	End float64 `json:"end"`
+}
+
+type Response struct {
+	Text     string    `json:"text,omitempty"`
+	Duration float64   `json:"duration,omitempty"`
+	Segments []Segment `json:"segments,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+func NewClient(serviceName, URL, server, apiKey, model, language string, timeout int64) *Client {
+	return &Client{
+		ServiceName: serviceName,
+		HttpClient:  clients.NewHttpClient(URL, timeout),
+		Server:      server,
+		ApiKey:      apiKey,
+		Model:       model,
+		Language:    language,
+	}
+}
+
+func (c *Client) Transcribe(ctx context.Context, input audiotranscript.TranscribeInput) (*audiotranscript.TranscribeOutput, error) {
+	body, pipe := io.Pipe()
+	defer body.Close()
+
+	writer := multipart.NewWriter(pipe)
+	go func() {
+		pipe.CloseWithError(c.writeForm(writer, input))
+	}()
+
+	clients.Mutex.Lock()
+
+	req := c.HttpClient.Client.
+		SetHeader("Content-Type", writer.FormDataContentType()).
+		SetBody(body)
+
+	if c.ApiKey != "" {
+		req.SetHeader("Authorization", "Bearer "+c.ApiKey)
+	}
+
+	res, err := req.Post(c.path())
+	clients.Mutex.Unlock()
+
+	if err != nil {
+		return nil, clients.NewRequestError(0, "%s: error=%s", errorMessagePrefix, err.Error())
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, clients.NewRequestError(res.StatusCode(), "%s: response=%s | status=%s",
+			errorMessagePrefix, res.Body(), res.Status(),
+		)
+	}
+
+	var response Response
+	if err = json.Unmarshal(res.Body(), &response); err != nil {
+		return nil, fmt.Errorf("%s: error=%s", errorMessagePrefix, err.Error())
+	}
+
+	// whisper.cpp reports decoding failures in the body of a 200 response
+	if response.Error != "" {
+		return nil, fmt.Errorf("%s: error=%s", errorMessagePrefix, response.Error)
+	}
+
+	text := strings.TrimSpace(response.Text)
+	if text == "" {
+		return nil, fmt.Errorf("%s: error=empty response", errorMessagePrefix)
+	}
+
+	return &audiotranscript.TranscribeOutput{
+		Text:         text,
+		Model:        c.Model,
+		Provider:     c.ServiceName,
+		AudioSeconds: response.duration(),
+	}, nil
+}
+
+func (c *Client) path() string {
+	if c.Server == ServerFasterWhisper {
+		return fasterWhisperPath
+	}
+	return whisperCppPath
+}
+
+// writeForm only sends the model to faster-whisper-server, as whisper.cpp
+// loads it when the server starts.
+func (c *Client) writeForm(writer *multipart.Writer, input audiotranscript.TranscribeInput) error {
+	filename := input.Filename
+	if filename == "" {
+		filename = defaultFilename
+	}
+
+	contentType := input.ContentType
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(filename)))
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(part, input.Audio); err != nil {
+		return err
+	}
+
+	if c.Server == ServerFasterWhisper && c.Model != "" {
+		_ = writer.WriteField("model", c.Model)
+	}
+	if c.Language != "" {
+		_ = writer.WriteField("language", c.Language)
+	}
+	_ = writer.WriteField("response_format", responseFormat)
+
+	return writer.Close()
+}
+
+// duration falls back to the end of the last segment, as older whisper.cpp
+// servers do not report the audio duration.
+func (r Response) duration() float64 {
+	if r.Duration > 0 || len(r.Segments) == 0 {
+		return r.Duration
+	}
+	return r.Segments[len(r.Segments)-1].End
+}
@@ -0,0 +1,156 @@
+package localwhisper
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/audiotranscript"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	config = viper.New()
+
+	//go:embed embed/whispercpp-response.json
+	whisperCppResponse string
+
+	//go:embed embed/whispercpp-response-error.json
+	whisperCppResponseError string
+
+	//go:embed embed/faster-whisper-response.json
+	fasterWhisperResponse string
+)
+
+type LocalWhisperClientTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestLocalWhisperClient(t *testing.T) {
+	suite.Run(t, new(LocalWhisperClientTestSuite))
+}
+
+func (s *LocalWhisperClientTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	config.AddConfigPath("embed")
+	config.SetConfigName("client_config")
+
+	if err := config.ReadInConfig(); err != nil {
+		panic(fmt.Errorf("failed conf file read: %w", err))
+	}
+}
+
+func (s *LocalWhisperClientTestSuite) TestTranscribe() {
+	s.Run("successful whisper.cpp request/response", func() {
+		output, err := s.transcribe(ServerWhisperCpp, whisperCppPath, http.StatusOK, whisperCppResponse)
+
+		s.Require().NoError(err)
+		s.Equal("xpto", output.Text)
+		s.Equal(12.5, output.AudioSeconds)
+		s.Equal("ggml-large-v3", output.Model)
+	})
+
+	s.Run("successful faster-whisper request/response", func() {
+		output, err := s.transcribe(ServerFasterWhisper, fasterWhisperPath, http.StatusOK, fasterWhisperResponse)
+
+		s.Require().NoError(err)
+		s.Equal("xpto", output.Text)
+		s.Equal(93.5, output.AudioSeconds)
+	})
+
+	s.Run("fail response with status code error", func() {
+		_, err := s.transcribe(ServerWhisperCpp, whisperCppPath, http.StatusInternalServerError, "")
+
+		s.EqualError(err, "error on local whisper request: response= | status=500 Internal Server Error")
+	})
+
+	s.Run("fail response with error in the body", func() {
+		_, err := s.transcribe(ServerWhisperCpp, whisperCppPath, http.StatusOK, whisperCppResponseError)
+
+		s.EqualError(err, "error on local whisper request: error=failed to read audio data")
+	})
+
+	s.Run("fail response with empty text", func() {
+		_, err := s.transcribe(ServerFasterWhisper, fasterWhisperPath, http.StatusOK, `{"text":"\n"}`)
+
+		s.EqualError(err, "error on local whisper request: error=empty response")
+	})
+}
+
+func (s *LocalWhisperClientTestSuite) TestWriteForm() {
+	s.Run("whisper.cpp form without model", func() {
+		form := s.writeForm(ServerWhisperCpp, audiotranscript.TranscribeInput{
+			Audio:       strings.NewReader("RIFF"),
+			Filename:    "meeting.wav",
+			ContentType: "audio/wav",
+		})
+
+		file := form.File["file"][0]
+		s.Equal("meeting.wav", file.Filename)
+		s.Equal("audio/wav", file.Header.Get("Content-Type"))
+		s.Equal([]string{"pt"}, form.Value["language"])
+		s.Equal([]string{"verbose_json"}, form.Value["response_format"])
+		s.NotContains(form.Value, "model")
+	})
+
+	s.Run("faster-whisper form with model", func() {
+		form := s.writeForm(ServerFasterWhisper, audiotranscript.TranscribeInput{Audio: strings.NewReader("RIFF")})
+
+		s.Equal("audio.wav", form.File["file"][0].Filename)
+		s.Equal([]string{"ggml-large-v3"}, form.Value["model"])
+	})
+}
+
+// transcribe builds a client for every call, as the connection kept by a
+// previous client would point to a closed server.
+func (s *LocalWhisperClientTestSuite) transcribe(server, path string, status int, response string) (*audiotranscript.TranscribeOutput, error) {
+	var responseObject any
+	if response != "" {
+		s.Require().NoError(json.Unmarshal([]byte(response), &responseObject))
+	}
+
+	mockServer := clients.StartMockServer(clients.HttpServerMockParams{
+		ExpectedPath:   path,
+		ExpectedMethod: http.MethodPost,
+		ResponseStatus: status,
+		ResponseObject: responseObject,
+	}, config.Sub("localwhisper").GetString("host"))
+	defer mockServer.Close()
+
+	client := getClientConfig(*config.Sub("localwhisper"), server)
+	return client.Transcribe(s.ctx, audiotranscript.TranscribeInput{Audio: bytes.NewReader(nil)})
+}
+
+func (s *LocalWhisperClientTestSuite) writeForm(server string, input audiotranscript.TranscribeInput) *multipart.Form {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	client := getClientConfig(*config.Sub("localwhisper"), server)
+	s.Require().NoError(client.writeForm(writer, input))
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+	s.Require().NoError(err)
+
+	return form
+}
+
+func getClientConfig(viper viper.Viper, server string) *Client {
+	return NewClient(
+		viper.GetString("name"),
+		viper.GetString("url"),
+		server,
+		viper.GetString("apiKey"),
+		viper.GetString("model"),
+		viper.GetString("language"),
+		viper.GetInt64("timeout"),
+	)
+}
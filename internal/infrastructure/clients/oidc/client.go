@@ -0,0 +1,367 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/identity"
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	discoveryPath      = "/.well-known/openid-configuration"
+	minRefreshInterval = 30 * time.Second
+	clockSkew          = time.Minute
+)
+
+var algorithms = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+	"ES256": crypto.SHA256,
+	"ES384": crypto.SHA384,
+	"ES512": crypto.SHA512,
+}
+
+type (
+	Client struct {
+		HttpClient      *resty.Client
+		ServiceName     string
+		Issuer          string
+		Audience        string
+		JWKSURL         string
+		RefreshInterval time.Duration
+
+		mutex     sync.Mutex
+		keys      map[string]crypto.PublicKey
+		fetchedAt time.Time
+		now       func() time.Time
+	}
+
+	header struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}
+
+	discovery struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	jwk struct {
+		KeyType string `json:"kty"`
+		KeyID   string `json:"kid"`
+		Use     string `json:"use"`
+		N       string `json:"n"`
+		E       string `json:"e"`
+		Curve   string `json:"crv"`
+		X       string `json:"x"`
+		Y       string `json:"y"`
+	}
+)
+
+func NewClient(serviceName, issuer, audience, jwksURL string, refreshInterval, timeout int64) *Client {
+	return &Client{
+		ServiceName:     serviceName,
+		HttpClient:      resty.New().SetTimeout(time.Duration(timeout) * time.Millisecond),
+		Issuer:          strings.TrimSuffix(issuer, "/"),
+		Audience:        audience,
+		JWKSURL:         jwksURL,
+		RefreshInterval: time.Duration(refreshInterval) * time.Millisecond,
+		now:             time.Now,
+	}
+}
+
+// Verify checks the signature of a compact JWT against the issuer's JWKS and
+// validates its registered claims, returning every claim of the token.
+func (c *Client) Verify(ctx context.Context, token string) (*identity.Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, fmt.Errorf("malformed token header: error=%s", err.Error())
+	}
+
+	key, err := c.key(ctx, h.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature: error=%s", err.Error())
+	}
+
+	if err = verifySignature(h.Algorithm, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, err
+	}
+
+	var values map[string]any
+	if err = decodeSegment(parts[1], &values); err != nil {
+		return nil, fmt.Errorf("malformed token claims: error=%s", err.Error())
+	}
+
+	if err = c.validate(values); err != nil {
+		return nil, err
+	}
+
+	issuer, _ := values["iss"].(string)
+	subject, _ := values["sub"].(string)
+
+	return &identity.Claims{
+		Issuer:  issuer,
+		Subject: subject,
+		Values:  values,
+	}, nil
+}
+
+// key returns the cached signing key, refreshing the JWKS when the cache is
+// stale or the key id is unknown. Unknown key ids usually mean the provider
+// rotated its keys, so they trigger a refresh, throttled to keep forged
+// tokens from flooding the provider.
+func (c *Client) key(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	age := c.now().Sub(c.fetchedAt)
+	key, ok := c.keys[keyID]
+	if ok && age < c.RefreshInterval {
+		return key, nil
+	}
+
+	if !ok && c.keys != nil && age < minRefreshInterval {
+		return nil, fmt.Errorf("unknown signing key: kid=%s", keyID)
+	}
+
+	if err := c.refresh(ctx); err != nil {
+		if ok {
+			// keeps serving the cached key while the provider is unavailable
+			return key, nil
+		}
+		return nil, err
+	}
+
+	if key, ok = c.keys[keyID]; !ok {
+		return nil, fmt.Errorf("unknown signing key: kid=%s", keyID)
+	}
+
+	return key, nil
+}
+
+func (c *Client) refresh(ctx context.Context) error {
+	if c.JWKSURL == "" {
+		var config discovery
+		if err := c.getJSON(ctx, c.Issuer+discoveryPath, &config); err != nil {
+			return err
+		}
+
+		if config.JWKSURI == "" {
+			return errors.New("error on oidc discovery: missing jwks_uri")
+		}
+
+		c.JWKSURL = config.JWKSURI
+	}
+
+	var set jwks
+	if err := c.getJSON(ctx, c.JWKSURL, &set); err != nil {
+		return err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+
+		keys[k.KeyID] = key
+	}
+
+	c.keys = keys
+	c.fetchedAt = c.now()
+
+	return nil
+}
+
+func (c *Client) getJSON(ctx context.Context, url string, result any) error {
+	res, err := c.HttpClient.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		Get(url)
+
+	if err != nil {
+		return fmt.Errorf("error on %s request: error=%s", c.ServiceName, err.Error())
+	}
+
+	if res.IsError() {
+		return fmt.Errorf("error on %s request: url=%s status=%d", c.ServiceName, url, res.StatusCode())
+	}
+
+	if err = json.Unmarshal(res.Body(), result); err != nil {
+		return fmt.Errorf("error on %s response unmarshal: error=%s", c.ServiceName, err.Error())
+	}
+
+	return nil
+}
+
+func (c *Client) validate(values map[string]any) error {
+	now := c.now()
+
+	if issuer, _ := values["iss"].(string); strings.TrimSuffix(issuer, "/") != c.Issuer {
+		return fmt.Errorf("invalid token issuer: iss=%s", issuer)
+	}
+
+	if c.Audience != "" && !hasAudience(values["aud"], c.Audience) {
+		return errors.New("invalid token audience")
+	}
+
+	expiresAt, ok := values["exp"].(float64)
+	if !ok || now.After(time.Unix(int64(expiresAt), 0).Add(clockSkew)) {
+		return errors.New("token is expired")
+	}
+
+	if notBefore, ok := values["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(notBefore), 0)) {
+		return errors.New("token is not valid yet")
+	}
+
+	return nil
+}
+
+func hasAudience(value any, audience string) bool {
+	switch aud := value.(type) {
+	case string:
+		return aud == audience
+	case []any:
+		for _, item := range aud {
+			if item == audience {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func verifySignature(algorithm string, key crypto.PublicKey, signed, signature []byte) error {
+	hash, ok := algorithms[algorithm]
+	if !ok {
+		return fmt.Errorf("unsupported token algorithm: alg=%s", algorithm)
+	}
+
+	hasher := hash.New()
+	hasher.Write(signed)
+	digest := hasher.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(algorithm, "RS") {
+			return fmt.Errorf("token algorithm does not match key: alg=%s", algorithm)
+		}
+
+		if err := rsa.VerifyPKCS1v15(key, hash, digest, signature); err != nil {
+			return errors.New("invalid token signature")
+		}
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(algorithm, "ES") {
+			return fmt.Errorf("token algorithm does not match key: alg=%s", algorithm)
+		}
+
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid token signature")
+		}
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errors.New("invalid token signature")
+		}
+	default:
+		return errors.New("unsupported signing key")
+	}
+
+	return nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curves := map[string]elliptic.Curve{
+			"P-256": elliptic.P256(),
+			"P-384": elliptic.P384(),
+			"P-521": elliptic.P521(),
+		}
+
+		curve, ok := curves[k.Curve]
+		if !ok {
+			return nil, fmt.Errorf("unsupported curve: crv=%s", k.Curve)
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type: kty=%s", k.KeyType)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(raw), nil
+}
+
+func decodeSegment(segment string, target any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, target)
+}
@@ -0,0 +1,241 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const audience = "explicai"
+
+type (
+	OIDCClientTestSuite struct {
+		suite.Suite
+		ctx context.Context
+
+		jwks   *jwksStandIn
+		server *httptest.Server
+		client *Client
+		now    time.Time
+	}
+
+	// jwksStandIn plays the identity provider, serving the discovery
+	// document and the current signing keys.
+	jwksStandIn struct {
+		mutex    sync.Mutex
+		keys     []jwk
+		requests int
+	}
+)
+
+func TestOIDCClient(t *testing.T) {
+	suite.Run(t, new(OIDCClientTestSuite))
+}
+
+func (s *OIDCClientTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.jwks = &jwksStandIn{}
+
+	mux := http.NewServeMux()
+	s.server = httptest.NewServer(mux)
+	mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(discovery{JWKSURI: s.server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		s.jwks.mutex.Lock()
+		defer s.jwks.mutex.Unlock()
+		s.jwks.requests++
+		json.NewEncoder(w).Encode(jwks{Keys: s.jwks.keys})
+	})
+
+	s.now = time.Now()
+	s.client = NewClient("oidc", s.server.URL, audience, "", 3600000, 1000)
+	s.client.now = func() time.Time { return s.now }
+}
+
+func (s *OIDCClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *OIDCClientTestSuite) TestVerify() {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+
+	s.jwks.keys = []jwk{rsaJWK("rsa-1", &rsaKey.PublicKey), ecJWK("ec-1", &ecKey.PublicKey)}
+
+	s.Run("successful verify rs256 token", func() {
+		token := signRS256(rsaKey, "rsa-1", s.validClaims())
+
+		claims, err := s.client.Verify(s.ctx, token)
+		s.Require().NoError(err)
+		s.Equal("user-1", claims.Subject)
+		s.Equal(s.server.URL, claims.Issuer)
+		s.Equal("9b1d4f6a-2c3e-4a5b-8d7f-0e1a2b3c4d5e", claims.Values["workspace_id"])
+	})
+
+	s.Run("successful verify es256 token", func() {
+		token := signES256(ecKey, "ec-1", s.validClaims())
+
+		_, err := s.client.Verify(s.ctx, token)
+		s.Require().NoError(err)
+	})
+
+	s.Run("keys are cached between verifications", func() {
+		requests := s.jwks.requests
+		_, err := s.client.Verify(s.ctx, signRS256(rsaKey, "rsa-1", s.validClaims()))
+		s.Require().NoError(err)
+		s.Equal(requests, s.jwks.requests)
+	})
+
+	s.Run("reject tampered signature", func() {
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		s.Require().NoError(err)
+
+		_, err = s.client.Verify(s.ctx, signRS256(other, "rsa-1", s.validClaims()))
+		s.Require().ErrorContains(err, "invalid token signature")
+	})
+
+	s.Run("reject unsigned token", func() {
+		token := encodeSegment(map[string]any{"alg": "none", "kid": "rsa-1"}) + "." + encodeSegment(s.validClaims()) + "."
+
+		_, err := s.client.Verify(s.ctx, token)
+		s.Require().ErrorContains(err, "unsupported token algorithm")
+	})
+
+	s.Run("reject invalid claims", func() {
+		claims := s.validClaims()
+		claims["iss"] = "https://other.example.com"
+		_, err := s.client.Verify(s.ctx, signRS256(rsaKey, "rsa-1", claims))
+		s.Require().ErrorContains(err, "invalid token issuer")
+
+		claims = s.validClaims()
+		claims["aud"] = []string{"other"}
+		_, err = s.client.Verify(s.ctx, signRS256(rsaKey, "rsa-1", claims))
+		s.Require().ErrorContains(err, "invalid token audience")
+
+		claims = s.validClaims()
+		claims["exp"] = s.now.Add(-2 * time.Minute).Unix()
+		_, err = s.client.Verify(s.ctx, signRS256(rsaKey, "rsa-1", claims))
+		s.Require().ErrorContains(err, "token is expired")
+	})
+
+	s.Run("malformed token", func() {
+		_, err := s.client.Verify(s.ctx, "eai_key")
+		s.Require().ErrorContains(err, "malformed token")
+	})
+}
+
+func (s *OIDCClientTestSuite) TestKeyRotation() {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+
+	s.jwks.keys = []jwk{rsaJWK("old", &oldKey.PublicKey)}
+
+	_, err = s.client.Verify(s.ctx, signRS256(oldKey, "old", s.validClaims()))
+	s.Require().NoError(err)
+	s.Equal(1, s.jwks.requests)
+
+	s.jwks.keys = []jwk{rsaJWK("new", &newKey.PublicKey)}
+	token := signRS256(newKey, "new", s.validClaims())
+
+	s.Run("throttle refresh on unknown key id", func() {
+		_, err := s.client.Verify(s.ctx, token)
+		s.Require().ErrorContains(err, "unknown signing key")
+		s.Equal(1, s.jwks.requests)
+	})
+
+	s.Run("refresh keys on unknown key id", func() {
+		s.now = s.now.Add(minRefreshInterval)
+
+		_, err := s.client.Verify(s.ctx, signRS256(newKey, "new", s.validClaims()))
+		s.Require().NoError(err)
+		s.Equal(2, s.jwks.requests)
+	})
+
+	s.Run("refresh stale keys", func() {
+		s.now = s.now.Add(2 * time.Hour)
+
+		_, err := s.client.Verify(s.ctx, signRS256(newKey, "new", s.validClaims()))
+		s.Require().NoError(err)
+		s.Equal(3, s.jwks.requests)
+	})
+}
+
+func (s *OIDCClientTestSuite) validClaims() map[string]any {
+	return map[string]any{
+		"iss":          s.server.URL,
+		"sub":          "user-1",
+		"aud":          audience,
+		"exp":          s.now.Add(time.Hour).Unix(),
+		"nbf":          s.now.Add(-time.Minute).Unix(),
+		"workspace_id": "9b1d4f6a-2c3e-4a5b-8d7f-0e1a2b3c4d5e",
+	}
+}
+
+func rsaJWK(keyID string, key *rsa.PublicKey) jwk {
+	return jwk{
+		KeyType: "RSA",
+		KeyID:   keyID,
+		Use:     "sig",
+		N:       base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(keyID string, key *ecdsa.PublicKey) jwk {
+	return jwk{
+		KeyType: "EC",
+		KeyID:   keyID,
+		Curve:   "P-256",
+		X:       base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		Y:       base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+func signRS256(key *rsa.PrivateKey, keyID string, claims map[string]any) string {
+	signed := encodeSegment(map[string]any{"alg": "RS256", "kid": keyID}) + "." + encodeSegment(claims)
+	digest := sha256.Sum256([]byte(signed))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func signES256(key *ecdsa.PrivateKey, keyID string, claims map[string]any) string {
+	signed := encodeSegment(map[string]any{"alg": "ES256", "kid": keyID}) + "." + encodeSegment(claims)
+	digest := sha256.Sum256([]byte(signed))
+
+	r, sig, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		panic(err)
+	}
+
+	signature := append(r.FillBytes(make([]byte, 32)), sig.FillBytes(make([]byte, 32))...)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encodeSegment(value any) string {
+	raw, _ := json.Marshal(value)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
@@ -0,0 +1,172 @@
+package ollama
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/summarize"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
+)
+
+const (
+	basePath                   = "/api/chat"
+	systemPrompt               = "Você é um sistema que recebe um texto transcrito de um áudio e organiza, separando em parágrafos e corrigindo possíveis erros de concordância"
+	resumeUserPrompt           = "Preciso de um objeto JSON com os campos title (título sugerido de até 60 caracteres), description (descrição sugerida de até 300 caracteres), briefResume (resumo breve de até 5 linhas) e mediumResume (resumo médio de até 15 linhas) sobre a seguinte transcrição:"
+	fullTextOrganizeUserPrompt = "Retorne apenas o texto normalizado para a seguinte transcrição: "
+)
+
+type (
+	ChatRequest struct {
+		Model    string                `json:"model"`
+		Messages []Message             `json:"messages"`
+		Stream   bool                  `json:"stream"`
+		Format   *summarize.JSONSchema `json:"format,omitempty"`
+		Options  *Options              `json:"options,omitempty"`
+	}
+
+	Message struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	}
+
+	// Options replaces the context window of the model, whose default is too
+	// short for the transcription of a meeting.
+	Options struct {
+		NumCtx int `json:"num_ctx,omitempty"`
+	}
+
+	ChatResponse struct {
+		Message struct {
+			Content string `json:"content"`
+		} `json:"message"`
+		PromptEvalCount int    `json:"prompt_eval_count"`
+		EvalCount       int    `json:"eval_count"`
+		Error           string `json:"error,omitempty"`
+	}
+)
+
+type Client struct {
+	HttpClient  *clients.BaseHTTP
+	ServiceName string
+	Model       string
+	ContextSize int
+}
+
+func NewClient(serviceName, URL, model string, contextSize int, timeout int64) *Client {
+	return &Client{
+		ServiceName: serviceName,
+		HttpClient:  clients.NewHttpClient(URL, timeout),
+		Model:       model,
+		ContextSize: contextSize,
+	}
+}
+
+func (c *Client) Resume(ctx context.Context, input summarize.Input) (*summarize.ResumeOutput, error) {
+	chatResponse, err := c.chat(c.buildRequest(input.Content(resumeUserPrompt), resumeSchema()))
+	if err != nil {
+		return nil, fmt.Errorf("error on ollama resume request: %w", err)
+	}
+
+	var response summarize.ResumeOutput
+	if err = json.Unmarshal([]byte(chatResponse.Message.Content), &response); err != nil {
+		return nil, fmt.Errorf("error on ollama resume request: error=%s", err.Error())
+	}
+
+	if response.Title == "" {
+		return nil, fmt.Errorf("error on ollama resume request: incomplete response=%s", chatResponse.Message.Content)
+	}
+
+	response.Usage = c.tokenUsage(chatResponse)
+
+	return &response, nil
+}
+
+func (c *Client) FullTextOrganize(ctx context.Context, input summarize.Input) (*summarize.FullTextOutput, error) {
+	chatResponse, err := c.chat(c.buildRequest(input.Content(fullTextOrganizeUserPrompt), nil))
+	if err != nil {
+		return nil, fmt.Errorf("error on ollama full text organize request: %w", err)
+	}
+
+	text := strings.TrimSpace(chatResponse.Message.Content)
+	if text == "" {
+		return nil, fmt.Errorf("error on ollama full text organize request: empty response")
+	}
+
+	return &summarize.FullTextOutput{
+		Text:  text,
+		Usage: c.tokenUsage(chatResponse),
+	}, nil
+}
+
+func (c *Client) chat(request ChatRequest) (*ChatResponse, error) {
+	clients.Mutex.Lock()
+
+	req := c.HttpClient.Client.
+		SetHeader("Content-Type", "application/json").
+		SetBody(request)
+
+	res, err := req.Post(basePath)
+	clients.Mutex.Unlock()
+
+	if err != nil {
+		return nil, clients.NewRequestError(0, "error=%s", err.Error())
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, clients.NewRequestError(res.StatusCode(), "response=%s | status=%s", res.Body(), res.Status())
+	}
+
+	var response ChatResponse
+	if err = json.Unmarshal(res.Body(), &response); err != nil {
+		return nil, fmt.Errorf("error=%s", err.Error())
+	}
+
+	if response.Error != "" {
+		return nil, fmt.Errorf("error=%s", response.Error)
+	}
+
+	return &response, nil
+}
+
+func (c *Client) tokenUsage(response *ChatResponse) summarize.TokenUsage {
+	return summarize.TokenUsage{
+		Model:            c.Model,
+		Provider:         c.ServiceName,
+		PromptTokens:     response.PromptEvalCount,
+		CompletionTokens: response.EvalCount,
+	}
+}
+
+func (c *Client) buildRequest(content string, format *summarize.JSONSchema) ChatRequest {
+	request := ChatRequest{
+		Model: c.Model,
+		Messages: []Message{
+			{
+				Role:    "system",
+				Content: systemPrompt,
+			},
+			{
+				Role:    "user",
+				Content: content,
+			},
+		},
+		Format: format,
+	}
+
+	if c.ContextSize > 0 {
+		request.Options = &Options{NumCtx: c.ContextSize}
+	}
+
+	return request
+}
+
+// resumeSchema is sent as the format of the response, which makes ollama
+// constrain the generation to the resume object. It replaces the function
+// call used with ChatGPT, which most local models do not support.
+func resumeSchema() *summarize.JSONSchema {
+	schema := summarize.Schema(summarize.ResumeOutput{})
+	return &schema
+}
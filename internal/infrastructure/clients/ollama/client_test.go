@@ -0,0 +1,168 @@
+package ollama
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/summarize"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	config = viper.New()
+
+	//go:embed embed/ollama-resume-response.json
+	ollamaResumeResponse string
+
+	//go:embed embed/ollama-resume-response-incomplete.json
+	ollamaResumeResponseIncomplete string
+
+	//go:embed embed/ollama-fulltext-response.json
+	ollamaFulltextResponse string
+
+	//go:embed embed/ollama-response-error.json
+	ollamaResponseError string
+)
+
+type OllamaClientTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestOllamaClient(t *testing.T) {
+	suite.Run(t, new(OllamaClientTestSuite))
+}
+
+func (s *OllamaClientTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	config.AddConfigPath("embed")
+	config.SetConfigName("client_config")
+
+	if err := config.ReadInConfig(); err != nil {
+		panic(fmt.Errorf("failed conf file read: %w", err))
+	}
+}
+
+func (s *OllamaClientTestSuite) TestOllamaResume() {
+	s.Run("successful request/response", func() {
+		server := s.startServer(http.StatusOK, ollamaResumeResponse)
+		defer server.Close()
+
+		output, err := getClientConfig(*config.Sub("ollama")).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.Require().NoError(err)
+		s.Equal("title test", output.Title)
+		s.Equal("description test.", output.Description)
+		s.Equal("brief test", output.BriefResume)
+		s.Equal("medium test", output.MediumResume)
+		s.Equal(summarize.TokenUsage{Model: "llama3.1", Provider: "ollama", PromptTokens: 1200, CompletionTokens: 300}, output.Usage)
+	})
+
+	s.Run("fail response with model not found", func() {
+		server := s.startServer(http.StatusNotFound, ollamaResponseError)
+		defer server.Close()
+
+		_, err := getClientConfig(*config.Sub("ollama")).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, `error on ollama resume request: response={"error":"model \"llama3.1\" not found, try pulling it first"}`+"\n | status=404 Not Found")
+	})
+
+	s.Run("fail response without the required fields", func() {
+		server := s.startServer(http.StatusOK, ollamaResumeResponseIncomplete)
+		defer server.Close()
+
+		_, err := getClientConfig(*config.Sub("ollama")).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, `error on ollama resume request: incomplete response={"description":"description test."}`)
+	})
+
+	s.Run("fail response with content that is not json", func() {
+		server := s.startServer(http.StatusOK, `{"message":{"content":"Claro! Aqui está o resumo"}}`)
+		defer server.Close()
+
+		_, err := getClientConfig(*config.Sub("ollama")).Resume(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, "error on ollama resume request: error=invalid character 'C' looking for beginning of value")
+	})
+}
+
+func (s *OllamaClientTestSuite) TestOllamaFullTextOrganize() {
+	s.Run("successful request/response", func() {
+		server := s.startServer(http.StatusOK, ollamaFulltextResponse)
+		defer server.Close()
+
+		output, err := getClientConfig(*config.Sub("ollama")).FullTextOrganize(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.Require().NoError(err)
+		s.Equal("full text test", output.Text)
+		s.Equal(summarize.TokenUsage{Model: "llama3.1", Provider: "ollama", PromptTokens: 1000, CompletionTokens: 900}, output.Usage)
+	})
+
+	s.Run("fail response with empty content", func() {
+		server := s.startServer(http.StatusOK, `{"message":{"content":" "}}`)
+		defer server.Close()
+
+		_, err := getClientConfig(*config.Sub("ollama")).FullTextOrganize(s.ctx, summarize.Input{Transcription: "transcription"})
+
+		s.EqualError(err, "error on ollama full text organize request: empty response")
+	})
+}
+
+func (s *OllamaClientTestSuite) TestBuildRequest() {
+	s.Run("resume constrained to the schema", func() {
+		client := getClientConfig(*config.Sub("ollama"))
+
+		body, err := json.Marshal(client.buildRequest(resumeUserPrompt+"\ntranscription", resumeSchema()))
+		s.Require().NoError(err)
+
+		var request map[string]any
+		s.Require().NoError(json.Unmarshal(body, &request))
+		s.Equal(false, request["stream"])
+		s.Equal(map[string]any{"num_ctx": float64(16384)}, request["options"])
+		s.Equal("object", request["format"].(map[string]any)["type"])
+		s.ElementsMatch([]any{"title", "description", "briefResume", "mediumResume"}, request["format"].(map[string]any)["required"])
+		s.Equal(false, request["format"].(map[string]any)["additionalProperties"])
+		s.Equal("Título de até 60 caracteres",
+			request["format"].(map[string]any)["properties"].(map[string]any)["title"].(map[string]any)["description"])
+	})
+
+	s.Run("full text without format and default context", func() {
+		client := NewClient("ollama", "", "llama3.1", 0, 1000)
+
+		body, err := json.Marshal(client.buildRequest(fullTextOrganizeUserPrompt+"\ntranscription", nil))
+		s.Require().NoError(err)
+		s.NotContains(string(body), "format")
+		s.NotContains(string(body), "options")
+	})
+}
+
+// startServer is called for every request with a new client, as the
+// connection kept by a previous client would point to a closed server.
+func (s *OllamaClientTestSuite) startServer(status int, response string) *httptest.Server {
+	var responseObject any
+	s.Require().NoError(json.Unmarshal([]byte(response), &responseObject))
+
+	return clients.StartMockServer(clients.HttpServerMockParams{
+		ExpectedPath:   basePath,
+		ExpectedMethod: http.MethodPost,
+		ResponseStatus: status,
+		ResponseObject: responseObject,
+	}, config.Sub("ollama").GetString("host"))
+}
+
+func getClientConfig(viper viper.Viper) *Client {
+	return NewClient(
+		viper.GetString("name"),
+		viper.GetString("url"),
+		viper.GetString("model"),
+		viper.GetInt("contextSize"),
+		viper.GetInt64("timeout"),
+	)
+}
@@ -0,0 +1,41 @@
+package clients
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	openAIVersionPrefix = "/v1"
+	azureDeploymentPath = "/openai/deployments/"
+)
+
+// Azure addresses a deployment of Azure OpenAI instead of the OpenAI API. The
+// deployment takes the place of the model in the path, and the key is sent in
+// the api-key header along with the api-version parameter.
+type Azure struct {
+	Deployment string
+	ApiVersion string
+}
+
+// OpenAIPath maps a path of the OpenAI API, such as /v1/chat/completions, to
+// the same operation of the Azure deployment.
+func OpenAIPath(path string, azure *Azure) string {
+	if azure == nil {
+		return path
+	}
+
+	return azureDeploymentPath + url.PathEscape(azure.Deployment) + strings.TrimPrefix(path, openAIVersionPrefix)
+}
+
+func OpenAIAuth(req *resty.Request, apiKey string, azure *Azure) *resty.Request {
+	if azure == nil {
+		return req.SetHeader("Authorization", "Bearer "+apiKey)
+	}
+
+	return req.
+		SetHeader("api-key", apiKey).
+		SetQueryParam("api-version", azure.ApiVersion)
+}
@@ -0,0 +1,242 @@
+package s3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	amzDateLayout    = "20060102T150405Z"
+)
+
+// Client talks to S3 compatible object storages, such as AWS S3 and MinIO,
+// using path style addressing and AWS signature version 4.
+type Client struct {
+	HttpClient  *http.Client
+	ServiceName string
+	Endpoint    string
+	Region      string
+	Bucket      string
+	AccessKey   string
+	SecretKey   string
+
+	now func() time.Time
+}
+
+func NewClient(serviceName, endpoint, region, bucket, accessKey, secretKey string, timeout int64) *Client {
+	return &Client{
+		HttpClient:  &http.Client{Timeout: time.Duration(timeout) * time.Millisecond},
+		ServiceName: serviceName,
+		Endpoint:    strings.TrimSuffix(endpoint, "/"),
+		Region:      region,
+		Bucket:      bucket,
+		AccessKey:   accessKey,
+		SecretKey:   secretKey,
+		now:         time.Now,
+	}
+}
+
+// Put streams the content to the bucket. The payload is not hashed, so the
+// content is never buffered in memory.
+func (c *Client) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	req, err := c.request(ctx, http.MethodPut, key, content)
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := c.do(req, unsignedPayload)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return c.check(res, key)
+}
+
+func (c *Client) Size(ctx context.Context, key string) (int64, error) {
+	req, err := c.request(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if err = c.check(res, key); err != nil {
+		return 0, err
+	}
+
+	return res.ContentLength, nil
+}
+
+func (c *Client) Get(ctx context.Context, key string, offset int64) (io.ReadCloser, error) {
+	req, err := c.request(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+
+	res, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.check(res, key); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+func (c *Client) Delete(ctx context.Context, key string) error {
+	req, err := c.request(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return c.check(res, key)
+}
+
+func (c *Client) createBucket(ctx context.Context) error {
+	req, err := c.request(ctx, http.MethodPut, "", nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return c.check(res, "")
+}
+
+func (c *Client) request(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	path := "/" + c.Bucket
+	if key != "" {
+		path += "/" + key
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.Endpoint+encodePath(path), body)
+	if err != nil {
+		return nil, fmt.Errorf("error on %s request: error=%s", c.ServiceName, err.Error())
+	}
+
+	return req, nil
+}
+
+func (c *Client) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	c.sign(req, payloadHash)
+
+	res, err := c.HttpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error on %s request: error=%s", c.ServiceName, err.Error())
+	}
+
+	return res, nil
+}
+
+func (c *Client) check(res *http.Response, key string) error {
+	if res.StatusCode == http.StatusNotFound {
+		return application.AudioNotFound
+	}
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("error on %s request: key=%s status=%d response=%s", c.ServiceName, key, res.StatusCode, body)
+	}
+
+	return nil
+}
+
+func (c *Client) sign(req *http.Request, payloadHash string) {
+	now := c.now().UTC()
+	amzDate := now.Format(amzDateLayout)
+	scope := now.Format("20060102") + "/" + c.Region + "/s3/aws4_request"
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	hashedRequest := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(hashedRequest[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.SecretKey), now.Format("20060102"))
+	key = hmacSHA256(key, c.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, c.AccessKey, scope, signedHeaders, hex.EncodeToString(hmacSHA256(key, stringToSign))))
+}
+
+func hmacSHA256(key []byte, value string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+// encodePath escapes every byte of the path but the unreserved characters
+// and the slashes, as required by the canonical request.
+func encodePath(path string) string {
+	var encoded strings.Builder
+	for _, b := range []byte(path) {
+		if b == '/' || b == '-' || b == '_' || b == '.' || b == '~' ||
+			('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9') {
+			encoded.WriteByte(b)
+			continue
+		}
+
+		encoded.WriteString(fmt.Sprintf("%%%02X", b))
+	}
+
+	return encoded.String()
+}
@@ -0,0 +1,110 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	minioDockerImage = "minio/minio:RELEASE.2024-12-18T13-15-44Z"
+	accessKey        = "minioadmin"
+	secretKey        = "minioadmin"
+)
+
+type S3ClientTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	container testcontainers.Container
+	client    *Client
+}
+
+func TestS3Client(t *testing.T) {
+	suite.Run(t, new(S3ClientTestSuite))
+}
+
+func (s *S3ClientTestSuite) SetupSuite() {
+	var err error
+	s.ctx = context.Background()
+
+	s.container, err = testcontainers.GenericContainer(s.ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        minioDockerImage,
+			Cmd:          []string{"server", "/data"},
+			ExposedPorts: []string{"9000/tcp"},
+			Env: map[string]string{
+				"MINIO_ROOT_USER":     accessKey,
+				"MINIO_ROOT_PASSWORD": secretKey,
+			},
+			WaitingFor: wait.ForHTTP("/minio/health/live").
+				WithPort("9000/tcp").
+				WithStartupTimeout(30 * time.Second),
+		},
+		Started: true,
+	})
+	s.Require().NoError(err)
+
+	endpoint, err := s.container.Endpoint(s.ctx, "http")
+	s.Require().NoError(err)
+
+	s.client = NewClient("s3", endpoint, "us-east-1", "explicai", accessKey, secretKey, 5000)
+	s.Require().NoError(s.client.createBucket(s.ctx))
+}
+
+func (s *S3ClientTestSuite) TearDownSuite() {
+	s.NoError(s.container.Terminate(s.ctx))
+}
+
+func (s *S3ClientTestSuite) TestObjects() {
+	key := "audio/9b1d4f6a-2c3e-4a5b-8d7f-0e1a2b3c4d5e/meeting"
+
+	s.Run("successful put and get", func() {
+		err := s.client.Put(s.ctx, key, strings.NewReader("0123456789"), 10, "audio/mpeg")
+		s.Require().NoError(err)
+
+		size, err := s.client.Size(s.ctx, key)
+		s.Require().NoError(err)
+		s.Equal(int64(10), size)
+
+		body, err := s.client.Get(s.ctx, key, 0)
+		s.Require().NoError(err)
+		content, err := io.ReadAll(body)
+		body.Close()
+		s.Require().NoError(err)
+		s.Equal("0123456789", string(content))
+	})
+
+	s.Run("successful get from offset", func() {
+		body, err := s.client.Get(s.ctx, key, 6)
+		s.Require().NoError(err)
+		defer body.Close()
+
+		content, err := io.ReadAll(body)
+		s.Require().NoError(err)
+		s.Equal("6789", string(content))
+	})
+
+	s.Run("successful delete", func() {
+		s.Require().NoError(s.client.Delete(s.ctx, key))
+
+		_, err := s.client.Size(s.ctx, key)
+		s.Require().ErrorIs(err, application.AudioNotFound)
+
+		_, err = s.client.Get(s.ctx, key, 0)
+		s.Require().ErrorIs(err, application.AudioNotFound)
+	})
+
+	s.Run("invalid credentials", func() {
+		client := NewClient("s3", s.client.Endpoint, "us-east-1", "explicai", accessKey, "wrong", 5000)
+
+		err := client.Put(s.ctx, key, strings.NewReader("0"), 1, "audio/mpeg")
+		s.Require().ErrorContains(err, "status=403")
+	})
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/webhook"
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	HttpClient  *resty.Client
+	ServiceName string
+}
+
+func NewClient(serviceName string, timeout int64) *Client {
+	return &Client{
+		ServiceName: serviceName,
+		HttpClient:  resty.New().SetTimeout(time.Duration(timeout) * time.Millisecond),
+	}
+}
+
+func (c *Client) Send(ctx context.Context, input webhook.SendInput) (*webhook.SendOutput, error) {
+	res, err := c.HttpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeaders(input.Headers).
+		SetBody(input.Payload).
+		Post(input.URL)
+
+	if err != nil {
+		return nil, fmt.Errorf("error on webhook request: error=%s", err.Error())
+	}
+
+	return &webhook.SendOutput{
+		StatusCode: res.StatusCode(),
+		Body:       res.Body(),
+	}, nil
+}
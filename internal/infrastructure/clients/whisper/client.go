@@ -1,28 +1,39 @@
 package whisper
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"strings"
 
+	"github.com/diegofsousa/explicAI/internal/gateway/audiotranscript"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
 )
 
-const basePath = "/v1/audio/transcriptions"
+const (
+	basePath = "/v1/audio/transcriptions"
+	// verbose_json also reports the audio duration, used to account its cost
+	responseFormat  = "verbose_json"
+	defaultFilename = "audio.mp3"
+)
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
 
 type Client struct {
 	HttpClient  *clients.BaseHTTP
 	ApiKey      string
 	ServiceName string
 	Model       string
+	Azure       *clients.Azure
 }
 
 type Response struct {
-	Text string `json:"text,omitempty"`
+	Text     string  `json:"text,omitempty"`
+	Duration float64 `json:"duration,omitempty"`
 }
 
 func NewClient(serviceName, URL, apiKey, model string, timeout int64) *Client {
@@ -34,37 +45,38 @@ func NewClient(serviceName, URL, apiKey, model string, timeout int64) *Client {
 	}
 }
 
-func (c *Client) Transcribe(ctx context.Context, audio []byte) (*string, error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-	part, err := writer.CreateFormFile("file", "audio.mp3")
-	if err != nil {
-		return nil, fmt.Errorf("error on whisper request: error=%s", err.Error())
+func NewAzureClient(serviceName, URL, apiKey, deployment, apiVersion, model string, timeout int64) *Client {
+	client := NewClient(serviceName, URL, apiKey, model, timeout)
+	client.Azure = &clients.Azure{
+		Deployment: deployment,
+		ApiVersion: apiVersion,
 	}
 
-	_, err = io.Copy(part, bytes.NewReader(audio))
-	if err != nil {
-		return nil, fmt.Errorf("error on whisper request: error=%s", err.Error())
-	}
+	return client
+}
 
-	_ = writer.WriteField("model", c.Model)
-	err = writer.Close()
-	if err != nil {
-		return nil, fmt.Errorf("error on whisper request: error=%s", err.Error())
-	}
+// Transcribe streams the audio into the multipart body while it is sent, so
+// the whole file is never held in memory.
+func (c *Client) Transcribe(ctx context.Context, input audiotranscript.TranscribeInput) (*audiotranscript.TranscribeOutput, error) {
+	body, pipe := io.Pipe()
+	defer body.Close()
+
+	writer := multipart.NewWriter(pipe)
+	go func() {
+		pipe.CloseWithError(c.writeForm(writer, input))
+	}()
 
 	clients.Mutex.Lock()
 
-	req := c.HttpClient.Client.
-		SetHeader("Authorization", "Bearer "+c.ApiKey).
+	req := clients.OpenAIAuth(c.HttpClient.Client, c.ApiKey, c.Azure).
 		SetHeader("Content-Type", writer.FormDataContentType()).
-		SetBody(body.Bytes())
+		SetBody(body)
 
-	res, err := req.Post(basePath)
+	res, err := req.Post(clients.OpenAIPath(basePath, c.Azure))
 	clients.Mutex.Unlock()
 
 	if res.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("error on whisper request: response=%s | status=%s",
+		return nil, clients.NewRequestError(res.StatusCode(), "error on whisper request: response=%s | status=%s",
 			res.Body(), res.Status(),
 		)
 	}
@@ -82,6 +94,43 @@ func (c *Client) Transcribe(ctx context.Context, audio []byte) (*string, error)
 		return nil, fmt.Errorf("error on whisper request: error=empty response")
 	}
 
-	return &response.Text, nil
+	return &audiotranscript.TranscribeOutput{
+		Text:         response.Text,
+		Model:        c.Model,
+		Provider:     c.ServiceName,
+		AudioSeconds: response.Duration,
+	}, nil
+
+}
+
+// writeForm sends the real filename and content type of the audio, as the
+// provider picks the decoder by the file extension.
+func (c *Client) writeForm(writer *multipart.Writer, input audiotranscript.TranscribeInput) error {
+	filename := input.Filename
+	if filename == "" {
+		filename = defaultFilename
+	}
+
+	contentType := input.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(filename)))
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(part, input.Audio); err != nil {
+		return err
+	}
+
+	_ = writer.WriteField("model", c.Model)
+	_ = writer.WriteField("response_format", responseFormat)
 
+	return writer.Close()
 }
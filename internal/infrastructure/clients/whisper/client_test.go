@@ -1,13 +1,17 @@
 package whisper
 
 import (
+	"bytes"
 	"context"
 	_ "embed"
 	"encoding/json"
 	"fmt"
+	"mime/multipart"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/diegofsousa/explicAI/internal/gateway/audiotranscript"
 	"github.com/diegofsousa/explicAI/internal/infrastructure/clients"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/mock"
@@ -73,10 +77,12 @@ func (s *WhisperClientTestSuite) TestWhisper() {
 
 		defer server.Close()
 
-		text, err := s.whisperClient.Transcribe(s.ctx, []byte{})
+		output, err := s.whisperClient.Transcribe(s.ctx, audiotranscript.TranscribeInput{Audio: bytes.NewReader(nil)})
 
 		s.Require().NoError(err)
-		s.Equal("xpto", *text)
+		s.Equal("xpto", output.Text)
+		s.Equal(93.5, output.AudioSeconds)
+		s.Equal(s.whisperClient.Model, output.Model)
 	})
 
 	s.Run("fail response with status code error", func() {
@@ -96,7 +102,7 @@ func (s *WhisperClientTestSuite) TestWhisper() {
 
 		defer server.Close()
 
-		_, err := s.whisperClient.Transcribe(s.ctx, []byte{})
+		_, err := s.whisperClient.Transcribe(s.ctx, audiotranscript.TranscribeInput{Audio: bytes.NewReader(nil)})
 
 		s.Require().Error(err)
 		s.EqualError(err, "error on whisper request: response= | status=500 Internal Server Error")
@@ -119,7 +125,7 @@ func (s *WhisperClientTestSuite) TestWhisper() {
 
 		defer server.Close()
 
-		_, err := s.whisperClient.Transcribe(s.ctx, []byte{})
+		_, err := s.whisperClient.Transcribe(s.ctx, audiotranscript.TranscribeInput{Audio: bytes.NewReader(nil)})
 
 		s.Require().Error(err)
 		s.EqualError(err, "error on whisper request: error=json: cannot unmarshal array into Go value of type whisper.Response")
@@ -142,13 +148,77 @@ func (s *WhisperClientTestSuite) TestWhisper() {
 
 		defer server.Close()
 
-		_, err := s.whisperClient.Transcribe(s.ctx, []byte{})
+		_, err := s.whisperClient.Transcribe(s.ctx, audiotranscript.TranscribeInput{Audio: bytes.NewReader(nil)})
 
 		s.Require().Error(err)
 		s.EqualError(err, "error on whisper request: error=empty response")
 	})
 }
 
+func (s *WhisperClientTestSuite) TestWhisperAzure() {
+	s.Run("successful request/response on the deployment", func() {
+		var response Response
+		json.Unmarshal([]byte(whisperResponse), &response)
+
+		httpServerMockParams := clients.HttpServerMockParams{
+			ExpectedPath:   "/openai/deployments/whisper/audio/transcriptions",
+			ExpectedMethod: http.MethodPost,
+			ResponseStatus: http.StatusOK,
+			ResponseObject: response,
+		}
+
+		server := clients.StartMockServer(httpServerMockParams,
+			config.Sub("whisper").GetString("host"),
+		)
+
+		defer server.Close()
+
+		client := NewAzureClient("whisper", config.Sub("whisper").GetString("url"), "xpto", "whisper", "2024-06-01", "whisper-1", 10000)
+
+		output, err := client.Transcribe(s.ctx, audiotranscript.TranscribeInput{Audio: bytes.NewReader(nil)})
+
+		s.Require().NoError(err)
+		s.Equal("xpto", output.Text)
+		s.Equal("whisper-1", output.Model)
+		s.Equal("xpto", client.HttpClient.Client.Header.Get("api-key"))
+		s.Equal("2024-06-01", client.HttpClient.Client.QueryParam.Get("api-version"))
+	})
+}
+
+func (s *WhisperClientTestSuite) TestWriteForm() {
+	s.Run("forward filename and content type", func() {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+
+		err := s.whisperClient.writeForm(writer, audiotranscript.TranscribeInput{
+			Audio:       strings.NewReader("RIFF"),
+			Filename:    "meeting.wav",
+			ContentType: "audio/wav",
+		})
+		s.Require().NoError(err)
+
+		form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+		s.Require().NoError(err)
+
+		file := form.File["file"][0]
+		s.Equal("meeting.wav", file.Filename)
+		s.Equal("audio/wav", file.Header.Get("Content-Type"))
+		s.Equal([]string{s.whisperClient.Model}, form.Value["model"])
+	})
+
+	s.Run("default filename", func() {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+
+		err := s.whisperClient.writeForm(writer, audiotranscript.TranscribeInput{Audio: strings.NewReader("ID3")})
+		s.Require().NoError(err)
+
+		form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+		s.Require().NoError(err)
+		s.Equal("audio.mp3", form.File["file"][0].Filename)
+	})
+}
+
 func getClientConfig(viper viper.Viper) Client {
 	client := NewClient(
 		viper.GetString("name"),
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type APIKey struct {
+	database *PgConnection
+}
+
+func NewAPIKey(databaseUrl string) *APIKey {
+	return &APIKey{
+		database: NewPgConnection(databaseUrl),
+	}
+}
+
+func (a *APIKey) CreateAPIKey(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	input repository.APIKeyCreateInput,
+) (*repository.APIKeyOutput, error) {
+	conn, err := a.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer a.database.Close(ctx, conn)
+
+	query := `
+		insert into api_keys (external_id, workspace_id, created_at, name, prefix, key_hash, role)
+		values ($1, $2, $3, $4, $5, $6, $7)
+		returning external_id, workspace_id, created_at, name, prefix, role, revoked_at;
+	`
+
+	row := conn.QueryRow(ctx, query, uuid.New(), workspaceID, time.Now(), input.Name, input.Prefix, input.KeyHash, input.Role)
+
+	return scanAPIKey(row)
+}
+
+func (a *APIKey) GetAPIKeys(ctx context.Context, workspaceID uuid.UUID) ([]repository.APIKeyOutput, error) {
+	conn, err := a.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer a.database.Close(ctx, conn)
+
+	query := `
+		select k.external_id, k.workspace_id, k.created_at, k.name, k.prefix, k.role, k.revoked_at
+		from api_keys k
+		where k.workspace_id = $1
+		order by k.created_at desc;
+	`
+
+	rows, err := conn.Query(ctx, query, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var keys []repository.APIKeyOutput
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, *key)
+	}
+
+	return keys, rows.Err()
+}
+
+func (a *APIKey) GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*repository.APIKeyOutput, error) {
+	conn, err := a.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer a.database.Close(ctx, conn)
+
+	query := `
+		select k.external_id, k.workspace_id, k.created_at, k.name, k.prefix, k.role, k.revoked_at
+		from api_keys k
+		where k.key_hash = $1 and k.revoked_at is null;
+	`
+
+	return scanAPIKey(conn.QueryRow(ctx, query, keyHash))
+}
+
+func (a *APIKey) RevokeAPIKeyByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) error {
+	conn, err := a.database.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer a.database.Close(ctx, conn)
+
+	query := `
+		update api_keys set revoked_at = $3
+		where external_id = $1 and workspace_id = $2 and revoked_at is null
+	`
+
+	row, err := conn.Exec(ctx, query, externalID, workspaceID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if row.RowsAffected() == 0 {
+		return application.APIKeyNotFound
+	}
+
+	return nil
+}
+
+func scanAPIKey(row pgx.Row) (*repository.APIKeyOutput, error) {
+	var key repository.APIKeyOutput
+
+	err := row.Scan(
+		&key.ExternalID,
+		&key.WorkspaceID,
+		&key.CreatedAt,
+		&key.Name,
+		&key.Prefix,
+		&key.Role,
+		&key.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, application.APIKeyNotFound
+		}
+		return nil, err
+	}
+
+	return &key, nil
+}
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+)
+
+func (s *SummaryDBTestSuite) TestAPIKeyDBOperations() {
+	s.Run("successful creation and lookup of api key", func() {
+		created, err := s.apiKeyDB.CreateAPIKey(s.ctx, workspaceID, repository.APIKeyCreateInput{
+			Name:    "ci",
+			Prefix:  "eai_0123abcd",
+			KeyHash: "hash-ci",
+			Role:    "admin",
+		})
+		s.NoError(err)
+		s.NotEmpty(created.ExternalID)
+		s.Equal("ci", created.Name)
+		s.Equal("eai_0123abcd", created.Prefix)
+		s.Equal("admin", created.Role)
+		s.False(created.RevokedAt.Valid)
+
+		found, err := s.apiKeyDB.GetActiveAPIKeyByHash(s.ctx, "hash-ci")
+		s.NoError(err)
+		s.Equal(created.ExternalID, found.ExternalID)
+		s.Equal(workspaceID, found.WorkspaceID)
+
+		_, err = s.apiKeyDB.GetActiveAPIKeyByHash(s.ctx, "unknown")
+		s.Equal(application.APIKeyNotFound, err)
+	})
+
+	s.Run("revoked api keys do not authenticate", func() {
+		created, err := s.apiKeyDB.CreateAPIKey(s.ctx, workspaceID, repository.APIKeyCreateInput{
+			Name:    "revoked",
+			Prefix:  "eai_4567efgh",
+			KeyHash: "hash-revoked",
+			Role:    "viewer",
+		})
+		s.NoError(err)
+
+		s.NoError(s.apiKeyDB.RevokeAPIKeyByExternalID(s.ctx, workspaceID, created.ExternalID))
+		s.Equal(application.APIKeyNotFound, s.apiKeyDB.RevokeAPIKeyByExternalID(s.ctx, workspaceID, created.ExternalID))
+
+		_, err = s.apiKeyDB.GetActiveAPIKeyByHash(s.ctx, "hash-revoked")
+		s.Equal(application.APIKeyNotFound, err)
+
+		keys, err := s.apiKeyDB.GetAPIKeys(s.ctx, workspaceID)
+		s.NoError(err)
+		s.NotEmpty(keys)
+		for _, key := range keys {
+			if key.ExternalID == created.ExternalID {
+				s.True(key.RevokedAt.Valid)
+			}
+		}
+	})
+
+	s.Run("api keys are isolated by workspace", func() {
+		created, err := s.apiKeyDB.CreateAPIKey(s.ctx, uuid.New(), repository.APIKeyCreateInput{
+			Name:    "other",
+			Prefix:  "eai_89abijkl",
+			KeyHash: "hash-other",
+		})
+		s.NoError(err)
+
+		keys, err := s.apiKeyDB.GetAPIKeys(s.ctx, workspaceID)
+		s.NoError(err)
+		for _, key := range keys {
+			s.NotEqual(created.ExternalID, key.ExternalID)
+		}
+
+		err = s.apiKeyDB.RevokeAPIKeyByExternalID(s.ctx, workspaceID, created.ExternalID)
+		s.Equal(application.APIKeyNotFound, err)
+	})
+
+	s.Run("revoke unknown api key", func() {
+		err := s.apiKeyDB.RevokeAPIKeyByExternalID(s.ctx, workspaceID, uuid.New())
+		s.Equal(application.APIKeyNotFound, err)
+	})
+}
@@ -3,6 +3,8 @@ package db
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/diegofsousa/explicAI/internal/application"
@@ -21,7 +23,11 @@ func NewSummary(databaseUrl string) *Summary {
 	}
 }
 
-func (s *Summary) CreateSummary(ctx context.Context, status repository.Status) (*repository.SummaryCreateOutput, error) {
+func (s *Summary) CreateSummary(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	input repository.SummaryCreateInput,
+) (*repository.SummaryCreateOutput, error) {
 	conn, err := s.database.Connect(ctx)
 	if err != nil {
 		return nil, err
@@ -33,15 +39,21 @@ func (s *Summary) CreateSummary(ctx context.Context, status repository.Status) (
 	now := time.Now()
 
 	query := `
-		insert into summaries (external_id, created_at, updated_at, status, progress)
-		values ($1, $2, $3, $4, $5)
+		insert into summaries (external_id, created_at, updated_at, status, progress, workspace_id,
+			audio_key, audio_content_type, audio_duration_seconds, audio_codec, audio_bitrate,
+			audio_sample_rate, audio_channels, audio_sha256)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, nullif($9::float8, 0), nullif($10::text, ''), nullif($11::int, 0),
+			nullif($12::int, 0), nullif($13::int, 0), nullif($14::text, ''))
 		returning external_id, created_at, status, progress;
 	`
 
 	var output repository.SummaryCreateOutput
 
 	err = conn.QueryRow(ctx, query, externalId, now, now,
-		repository.StatusToString[status].Status, repository.StatusToString[status].Percentage).
+		repository.StatusToString[input.Status].Status, repository.StatusToString[input.Status].Percentage,
+		workspaceID, input.AudioKey, input.AudioContentType,
+		input.Audio.DurationSeconds, input.Audio.Codec, input.Audio.Bitrate,
+		input.Audio.SampleRate, input.Audio.Channels, input.AudioHash).
 		Scan(&output.ExternalID, &output.CreatedAt, &output.Status, &output.Progress)
 
 	if err != nil {
@@ -51,7 +63,66 @@ func (s *Summary) CreateSummary(ctx context.Context, status repository.Status) (
 	return &output, nil
 }
 
-func (s *Summary) UpdateSummaryTranscribed(ctx context.Context, input repository.SummaryUpdateTranscribedInput) error {
+func (s *Summary) UpdateSummaryDownloaded(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	input repository.SummaryUpdateDownloadedInput,
+) error {
+	conn, err := s.database.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer s.database.Close(ctx, conn)
+
+	query := `
+		update summaries
+		set progress = $3, status = $4, updated_at = $5,
+			audio_key = coalesce(nullif($6, ''), audio_key),
+			audio_content_type = coalesce(nullif($7, ''), audio_content_type),
+			audio_duration_seconds = coalesce(nullif($8::float8, 0), audio_duration_seconds),
+			audio_codec = coalesce(nullif($9::text, ''), audio_codec),
+			audio_bitrate = coalesce(nullif($10::int, 0), audio_bitrate),
+			audio_sample_rate = coalesce(nullif($11::int, 0), audio_sample_rate),
+			audio_channels = coalesce(nullif($12::int, 0), audio_channels),
+			audio_sha256 = coalesce(nullif($13::text, ''), audio_sha256)
+		where external_id = $1 and workspace_id = $2;
+	`
+
+	command, err := conn.Exec(
+		ctx,
+		query,
+		input.ExternalID,
+		workspaceID,
+		repository.StatusToString[input.Status].Percentage,
+		repository.StatusToString[input.Status].Status,
+		time.Now(),
+		input.AudioKey,
+		input.AudioContentType,
+		input.Audio.DurationSeconds,
+		input.Audio.Codec,
+		input.Audio.Bitrate,
+		input.Audio.SampleRate,
+		input.Audio.Channels,
+		input.AudioHash,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if command.RowsAffected() == 0 {
+		return errors.New("register not found")
+	}
+
+	return nil
+}
+
+func (s *Summary) UpdateSummaryTranscribed(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	input repository.SummaryUpdateTranscribedInput,
+) error {
 	conn, err := s.database.Connect(ctx)
 	if err != nil {
 		return err
@@ -61,12 +132,13 @@ func (s *Summary) UpdateSummaryTranscribed(ctx context.Context, input repository
 
 	now := time.Now()
 
-	query := `update summaries set progress = $2, status = $3, updated_at = $4 where external_id = $1;`
+	query := `update summaries set progress = $3, status = $4, updated_at = $5 where external_id = $1 and workspace_id = $2;`
 
 	command, err := conn.Exec(
 		ctx,
 		query,
 		input.ExternalID,
+		workspaceID,
 		repository.StatusToString[input.Status].Percentage,
 		repository.StatusToString[input.Status].Status,
 		now,
@@ -83,7 +155,11 @@ func (s *Summary) UpdateSummaryTranscribed(ctx context.Context, input repository
 	return nil
 }
 
-func (s *Summary) UpdateSummarySummarized(ctx context.Context, input repository.SummaryUpdateSummarizedInput) error {
+func (s *Summary) UpdateSummarySummarized(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	input repository.SummaryUpdateSummarizedInput,
+) error {
 	conn, err := s.database.Connect(ctx)
 	if err != nil {
 		return err
@@ -93,12 +169,13 @@ func (s *Summary) UpdateSummarySummarized(ctx context.Context, input repository.
 
 	now := time.Now()
 
-	query := `update summaries set progress = $2, status = $3, updated_at = $4, title = $5, description = $6, brief_resume = $7, medium_resume = $8, fulltext = $9 where external_id = $1;`
+	query := `update summaries set progress = $3, status = $4, updated_at = $5, title = $6, description = $7, brief_resume = $8, medium_resume = $9, fulltext = $10 where external_id = $1 and workspace_id = $2;`
 
 	command, err := conn.Exec(
 		ctx,
 		query,
 		input.ExternalID,
+		workspaceID,
 		repository.StatusToString[input.Status].Percentage,
 		repository.StatusToString[input.Status].Status,
 		now,
@@ -120,38 +197,64 @@ func (s *Summary) UpdateSummarySummarized(ctx context.Context, input repository.
 	return nil
 }
 
-func (s *Summary) GetSummaries(ctx context.Context) ([]repository.SummaryOutput, error) {
-	conn, err := s.database.Connect(ctx)
+func (s *Summary) GetSummaries(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	filter repository.SummaryFilter,
+) ([]repository.SummaryOutput, error) {
+	var summaries []repository.SummaryOutput
+
+	err := s.StreamSummaries(ctx, workspaceID, filter, func(summary repository.SummaryOutput) error {
+		summaries = append(summaries, summary)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	return summaries, nil
+}
+
+func (s *Summary) StreamSummaries(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	filter repository.SummaryFilter,
+	fn func(repository.SummaryOutput) error,
+) error {
+	conn, err := s.database.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
 	defer s.database.Close(ctx, conn)
 
-	var summaries []repository.SummaryOutput
+	where, args := buildSummaryFilter(workspaceID, filter)
 
 	query := `
-			select 
-				s.external_id,
-				s.created_at,
-				s.updated_at,
-				s.status,
-				s.title,
-				s.description,
-				s.brief_resume,
-				s.medium_resume,
-				s.fulltext,
-				s.progress
-			from summaries s
-			order by s.updated_at desc;
+		select
+			s.external_id,
+			s.created_at,
+			s.updated_at,
+			s.status,
+			s.title,
+			s.description,
+			s.brief_resume,
+			s.medium_resume,
+			s.fulltext,
+			s.progress
+		from summaries s
+		` + where + `
+		order by s.updated_at desc;
 	`
 
-	rows, err := conn.Query(ctx, query)
+	rows, err := conn.Query(ctx, query, args...)
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
+	defer rows.Close()
+
 	for rows.Next() {
 		var summary repository.SummaryOutput
 
@@ -168,15 +271,38 @@ func (s *Summary) GetSummaries(ctx context.Context) ([]repository.SummaryOutput,
 			&summary.Progress,
 		)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		summaries = append(summaries, summary)
+		if err = fn(summary); err != nil {
+			return err
+		}
 	}
-	return summaries, nil
+
+	return rows.Err()
+}
+
+func (s *Summary) GetSummaryByExternalID(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	externalID uuid.UUID,
+) (*repository.SummaryOutput, error) {
+	return s.getSummary(ctx, "s.external_id = $1 and s.workspace_id = $2", "", externalID, workspaceID)
+}
+
+// GetSummaryByAudioHash returns the latest summary of the workspace created
+// from the same audio content.
+func (s *Summary) GetSummaryByAudioHash(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	audioHash string,
+) (*repository.SummaryOutput, error) {
+	return s.getSummary(ctx, "s.workspace_id = $1 and s.audio_sha256 = $2", "s.created_at desc, s.id desc", workspaceID, audioHash)
 }
 
-func (s *Summary) GetSummaryByExternalID(ctx context.Context, externalID uuid.UUID) (*repository.SummaryOutput, error) {
+// getSummary returns the first summary matching the condition, in the order
+// given when the condition can match more than one.
+func (s *Summary) getSummary(ctx context.Context, condition, order string, args ...any) (*repository.SummaryOutput, error) {
 	conn, err := s.database.Connect(ctx)
 	if err != nil {
 		return nil, err
@@ -189,20 +315,35 @@ func (s *Summary) GetSummaryByExternalID(ctx context.Context, externalID uuid.UU
 	query := `
 		select
 			s.external_id,
-				s.created_at,
-				s.updated_at,
-				s.status,
-				s.title,
-				s.description,
-				s.brief_resume,
-				s.medium_resume,
-				s.fulltext,
-				s.progress
-			from summaries s
-			where s.external_id = $1;
+			s.created_at,
+			s.updated_at,
+			s.status,
+			s.title,
+			s.description,
+			s.brief_resume,
+			s.medium_resume,
+			s.fulltext,
+			s.progress,
+			s.audio_key,
+			s.audio_content_type,
+			s.audio_duration_seconds,
+			s.audio_codec,
+			s.audio_bitrate,
+			s.audio_sample_rate,
+			s.audio_channels
+		from summaries s
+		where ` + condition
+
+	if order != "" {
+		query += `
+		order by ` + order
+	}
+
+	query += `
+		limit 1;
 	`
 
-	row := conn.QueryRow(ctx, query, externalID)
+	row := conn.QueryRow(ctx, query, args...)
 
 	err = row.Scan(
 		&summary.ExternalID,
@@ -215,6 +356,13 @@ func (s *Summary) GetSummaryByExternalID(ctx context.Context, externalID uuid.UU
 		&summary.MediumResume,
 		&summary.FullText,
 		&summary.Progress,
+		&summary.AudioKey,
+		&summary.AudioContentType,
+		&summary.AudioDuration,
+		&summary.AudioCodec,
+		&summary.AudioBitrate,
+		&summary.AudioSampleRate,
+		&summary.AudioChannels,
 	)
 
 	if err != nil {
@@ -227,7 +375,7 @@ func (s *Summary) GetSummaryByExternalID(ctx context.Context, externalID uuid.UU
 	return &summary, nil
 }
 
-func (s *Summary) DeleteSummaryByExternalID(ctx context.Context, externalID uuid.UUID) error {
+func (s *Summary) DeleteSummaryByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) error {
 	conn, err := s.database.Connect(ctx)
 	if err != nil {
 		return err
@@ -237,10 +385,10 @@ func (s *Summary) DeleteSummaryByExternalID(ctx context.Context, externalID uuid
 
 	query := `
 		delete from summaries
-		where external_id = $1
+		where external_id = $1 and workspace_id = $2
 	`
 
-	row, err := conn.Exec(ctx, query, externalID)
+	row, err := conn.Exec(ctx, query, externalID, workspaceID)
 
 	if err != nil {
 		return err
@@ -252,3 +400,25 @@ func (s *Summary) DeleteSummaryByExternalID(ctx context.Context, externalID uuid
 
 	return nil
 }
+
+func buildSummaryFilter(workspaceID uuid.UUID, filter repository.SummaryFilter) (string, []any) {
+	conditions := []string{"s.workspace_id = $1"}
+	args := []any{workspaceID}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("s.status = $%d", len(args)))
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		args = append(args, filter.CreatedFrom)
+		conditions = append(conditions, fmt.Sprintf("s.created_at >= $%d", len(args)))
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		args = append(args, filter.CreatedTo)
+		conditions = append(conditions, fmt.Sprintf("s.created_at < $%d", len(args)))
+	}
+
+	return "where " + strings.Join(conditions, " and "), args
+}
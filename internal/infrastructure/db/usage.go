@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+)
+
+type Usage struct {
+	database *PgConnection
+}
+
+func NewUsage(databaseUrl string) *Usage {
+	return &Usage{
+		database: NewPgConnection(databaseUrl),
+	}
+}
+
+func (u *Usage) CreateUsage(ctx context.Context, workspaceID uuid.UUID, input repository.UsageCreateInput) error {
+	conn, err := u.database.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer u.database.Close(ctx, conn)
+
+	query := `
+		insert into usage_records (external_id, summary_id, workspace_id, user_id, created_at, operation, model,
+			provider, prompt_tokens, completion_tokens, audio_seconds, cost, validation_error)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+	`
+
+	_, err = conn.Exec(ctx, query,
+		uuid.New(),
+		input.SummaryExternalID,
+		workspaceID,
+		input.UserID,
+		time.Now(),
+		input.Operation,
+		input.Model,
+		input.Provider,
+		input.PromptTokens,
+		input.CompletionTokens,
+		input.AudioSeconds,
+		input.Cost,
+		input.ValidationError,
+	)
+
+	return err
+}
+
+func (u *Usage) GetUsage(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	filter repository.UsageFilter,
+) ([]repository.UsageAggregateOutput, error) {
+	conn, err := u.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer u.database.Close(ctx, conn)
+
+	where, args := buildUsageFilter(workspaceID, filter)
+
+	query := `
+		select date_trunc('day', r.created_at) as day, r.user_id, count(*),
+			coalesce(sum(r.prompt_tokens), 0), coalesce(sum(r.completion_tokens), 0),
+			coalesce(sum(r.audio_seconds), 0)::float8, coalesce(sum(r.cost), 0)::float8,
+			count(*) filter (where r.validation_error <> '')
+		from usage_records r
+		` + where + `
+		group by day, r.user_id
+		order by day, r.user_id;
+	`
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var usage []repository.UsageAggregateOutput
+	for rows.Next() {
+		var row repository.UsageAggregateOutput
+
+		if err := rows.Scan(
+			&row.Day,
+			&row.UserID,
+			&row.Requests,
+			&row.PromptTokens,
+			&row.CompletionTokens,
+			&row.AudioSeconds,
+			&row.Cost,
+			&row.Rejected,
+		); err != nil {
+			return nil, err
+		}
+
+		usage = append(usage, row)
+	}
+
+	return usage, rows.Err()
+}
+
+func buildUsageFilter(workspaceID uuid.UUID, filter repository.UsageFilter) (string, []any) {
+	conditions := []string{"r.workspace_id = $1"}
+	args := []any{workspaceID}
+
+	if !filter.From.IsZero() {
+		args = append(args, filter.From)
+		conditions = append(conditions, fmt.Sprintf("r.created_at >= $%d", len(args)))
+	}
+
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		conditions = append(conditions, fmt.Sprintf("r.created_at < $%d", len(args)))
+	}
+
+	return "where " + strings.Join(conditions, " and "), args
+}
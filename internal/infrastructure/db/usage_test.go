@@ -0,0 +1,57 @@
+package db
+
+import (
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+)
+
+func (s *SummaryDBTestSuite) TestUsageDBOperations() {
+	summaryID := uuid.New()
+
+	s.Run("successful aggregation of usage by day and user", func() {
+		s.truncate()
+
+		s.NoError(s.usageDB.CreateUsage(s.ctx, workspaceID, repository.UsageCreateInput{
+			SummaryExternalID: summaryID,
+			UserID:            ownerID,
+			Operation:         "transcription",
+			Model:             "whisper-1",
+			Provider:          "whisper",
+			AudioSeconds:      90,
+			Cost:              0.009,
+		}))
+		s.NoError(s.usageDB.CreateUsage(s.ctx, workspaceID, repository.UsageCreateInput{
+			SummaryExternalID: summaryID,
+			UserID:            ownerID,
+			Operation:         "resume",
+			Model:             "gpt-4o",
+			PromptTokens:      1000,
+			CompletionTokens:  200,
+			Cost:              0.0045,
+		}))
+
+		usage, err := s.usageDB.GetUsage(s.ctx, workspaceID, repository.UsageFilter{})
+		s.NoError(err)
+		s.Require().Len(usage, 1)
+		s.Equal(ownerID, usage[0].UserID)
+		s.Equal(int64(2), usage[0].Requests)
+		s.Equal(int64(1000), usage[0].PromptTokens)
+		s.Equal(int64(200), usage[0].CompletionTokens)
+		s.InDelta(90, usage[0].AudioSeconds, 0.001)
+		s.InDelta(0.0135, usage[0].Cost, 0.000001)
+	})
+
+	s.Run("usage is isolated by workspace and period", func() {
+		usage, err := s.usageDB.GetUsage(s.ctx, uuid.New(), repository.UsageFilter{})
+		s.NoError(err)
+		s.Empty(usage)
+
+		usage, err = s.usageDB.GetUsage(s.ctx, workspaceID, repository.UsageFilter{
+			From: time.Now().Add(time.Hour),
+		})
+		s.NoError(err)
+		s.Empty(usage)
+	})
+}
@@ -0,0 +1,312 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const webhookDeliveriesLimit = 100
+
+type Webhook struct {
+	database *PgConnection
+}
+
+func NewWebhook(databaseUrl string) *Webhook {
+	return &Webhook{
+		database: NewPgConnection(databaseUrl),
+	}
+}
+
+func (w *Webhook) CreateWebhook(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	input repository.WebhookCreateInput,
+) (*repository.WebhookOutput, error) {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	externalId := uuid.New()
+	now := time.Now()
+
+	query := `
+		insert into webhooks (external_id, created_at, updated_at, url, secret, statuses, active, workspace_id)
+		values ($1, $2, $3, $4, $5, $6, true, $7)
+		returning external_id, created_at, updated_at, url, secret, statuses, active;
+	`
+
+	row := conn.QueryRow(ctx, query, externalId, now, now, input.URL, input.Secret, nonNilStatuses(input.Statuses), workspaceID)
+
+	return scanWebhook(row)
+}
+
+func (w *Webhook) UpdateWebhook(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	input repository.WebhookUpdateInput,
+) (*repository.WebhookOutput, error) {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	query := `
+		update webhooks set url = $3, statuses = $4, active = $5, updated_at = $6
+		where external_id = $1 and workspace_id = $2
+		returning external_id, created_at, updated_at, url, secret, statuses, active;
+	`
+
+	row := conn.QueryRow(ctx, query, input.ExternalID, workspaceID, input.URL, nonNilStatuses(input.Statuses), input.Active, time.Now())
+
+	return scanWebhook(row)
+}
+
+func (w *Webhook) GetWebhooks(ctx context.Context, workspaceID uuid.UUID) ([]repository.WebhookOutput, error) {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	query := `
+		select w.external_id, w.created_at, w.updated_at, w.url, w.secret, w.statuses, w.active
+		from webhooks w
+		where w.workspace_id = $1
+		order by w.created_at desc;
+	`
+
+	rows, err := conn.Query(ctx, query, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectWebhooks(rows)
+}
+
+func (w *Webhook) GetActiveWebhooksByStatus(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	status string,
+) ([]repository.WebhookOutput, error) {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	query := `
+		select w.external_id, w.created_at, w.updated_at, w.url, w.secret, w.statuses, w.active
+		from webhooks w
+		where w.workspace_id = $1 and w.active and (cardinality(w.statuses) = 0 or $2 = any(w.statuses));
+	`
+
+	rows, err := conn.Query(ctx, query, workspaceID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectWebhooks(rows)
+}
+
+func (w *Webhook) GetWebhookByExternalID(
+	ctx context.Context,
+	workspaceID uuid.UUID,
+	externalID uuid.UUID,
+) (*repository.WebhookOutput, error) {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	query := `
+		select w.external_id, w.created_at, w.updated_at, w.url, w.secret, w.statuses, w.active
+		from webhooks w
+		where w.external_id = $1 and w.workspace_id = $2;
+	`
+
+	return scanWebhook(conn.QueryRow(ctx, query, externalID, workspaceID))
+}
+
+func (w *Webhook) DeleteWebhookByExternalID(ctx context.Context, workspaceID uuid.UUID, externalID uuid.UUID) error {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	query := `
+		delete from webhooks
+		where external_id = $1 and workspace_id = $2
+	`
+
+	row, err := conn.Exec(ctx, query, externalID, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	if row.RowsAffected() == 0 {
+		return application.WebhookNotFound
+	}
+
+	return nil
+}
+
+func (w *Webhook) CreateWebhookDelivery(ctx context.Context, input repository.WebhookDeliveryCreateInput) error {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	query := `
+		insert into webhook_deliveries
+			(external_id, webhook_id, created_at, event_id, event_type, attempt, payload, status_code, error, delivered)
+		select $1, w.id, $3, $4, $5, $6, $7, $8, $9, $10
+		from webhooks w
+		where w.external_id = $2;
+	`
+
+	command, err := conn.Exec(
+		ctx,
+		query,
+		uuid.New(),
+		input.WebhookExternalID,
+		time.Now(),
+		input.EventID,
+		input.EventType,
+		input.Attempt,
+		input.Payload,
+		input.StatusCode,
+		input.Error,
+		input.Delivered,
+	)
+	if err != nil {
+		return err
+	}
+
+	if command.RowsAffected() == 0 {
+		return application.WebhookNotFound
+	}
+
+	return nil
+}
+
+func (w *Webhook) GetWebhookDeliveries(ctx context.Context, webhookExternalID uuid.UUID) ([]repository.WebhookDeliveryOutput, error) {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	query := `
+		select
+			d.external_id,
+			d.created_at,
+			d.event_id,
+			d.event_type,
+			d.attempt,
+			d.payload,
+			d.status_code,
+			d.error,
+			d.delivered
+		from webhook_deliveries d
+		join webhooks w on w.id = d.webhook_id
+		where w.external_id = $1
+		order by d.created_at desc, d.id desc
+		limit $2;
+	`
+
+	rows, err := conn.Query(ctx, query, webhookExternalID, webhookDeliveriesLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var deliveries []repository.WebhookDeliveryOutput
+	for rows.Next() {
+		var delivery repository.WebhookDeliveryOutput
+
+		err = rows.Scan(
+			&delivery.ExternalID,
+			&delivery.CreatedAt,
+			&delivery.EventID,
+			&delivery.EventType,
+			&delivery.Attempt,
+			&delivery.Payload,
+			&delivery.StatusCode,
+			&delivery.Error,
+			&delivery.Delivered,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func scanWebhook(row pgx.Row) (*repository.WebhookOutput, error) {
+	var webhook repository.WebhookOutput
+
+	err := row.Scan(
+		&webhook.ExternalID,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+		&webhook.URL,
+		&webhook.Secret,
+		&webhook.Statuses,
+		&webhook.Active,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, application.WebhookNotFound
+		}
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func collectWebhooks(rows pgx.Rows) ([]repository.WebhookOutput, error) {
+	defer rows.Close()
+
+	var webhooks []repository.WebhookOutput
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, *webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+func nonNilStatuses(statuses []string) []string {
+	if statuses == nil {
+		return []string{}
+	}
+	return statuses
+}
@@ -0,0 +1,149 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+)
+
+func (s *SummaryDBTestSuite) TestWebhookDBOperations() {
+	s.Run("successful creation of webhook", func() {
+		output, err := s.webhookDB.CreateWebhook(s.ctx, workspaceID, repository.WebhookCreateInput{
+			URL:      "https://hooks.example.com/a",
+			Secret:   "whsec_a",
+			Statuses: []string{"SUMMARIZED"},
+		})
+		s.NoError(err)
+		s.NotEmpty(output.ExternalID)
+		s.Equal("https://hooks.example.com/a", output.URL)
+		s.Equal("whsec_a", output.Secret)
+		s.Equal([]string{"SUMMARIZED"}, output.Statuses)
+		s.True(output.Active)
+	})
+
+	s.Run("successful update of webhook", func() {
+		created, err := s.webhookDB.CreateWebhook(s.ctx, workspaceID, repository.WebhookCreateInput{
+			URL:    "https://hooks.example.com/b",
+			Secret: "whsec_b",
+		})
+		s.NoError(err)
+		s.Empty(created.Statuses)
+
+		updated, err := s.webhookDB.UpdateWebhook(s.ctx, workspaceID, repository.WebhookUpdateInput{
+			ExternalID: created.ExternalID,
+			URL:        "https://hooks.example.com/c",
+			Statuses:   []string{"TRANSCRIBED", "SUMMARIZED"},
+			Active:     false,
+		})
+		s.NoError(err)
+		s.Equal("https://hooks.example.com/c", updated.URL)
+		s.Equal([]string{"TRANSCRIBED", "SUMMARIZED"}, updated.Statuses)
+		s.False(updated.Active)
+		s.Equal("whsec_b", updated.Secret)
+	})
+
+	s.Run("get active webhooks by status", func() {
+		s.truncate()
+
+		all, err := s.webhookDB.CreateWebhook(s.ctx, workspaceID, repository.WebhookCreateInput{URL: "https://hooks.example.com/all", Secret: "s"})
+		s.NoError(err)
+		summarized, err := s.webhookDB.CreateWebhook(s.ctx, workspaceID, repository.WebhookCreateInput{
+			URL: "https://hooks.example.com/summarized", Secret: "s", Statuses: []string{"SUMMARIZED"},
+		})
+		s.NoError(err)
+		inactive, err := s.webhookDB.CreateWebhook(s.ctx, workspaceID, repository.WebhookCreateInput{URL: "https://hooks.example.com/inactive", Secret: "s"})
+		s.NoError(err)
+		_, err = s.webhookDB.UpdateWebhook(s.ctx, workspaceID, repository.WebhookUpdateInput{
+			ExternalID: inactive.ExternalID, URL: inactive.URL, Active: false,
+		})
+		s.NoError(err)
+
+		result, err := s.webhookDB.GetActiveWebhooksByStatus(s.ctx, workspaceID, "SUMMARIZED")
+		s.NoError(err)
+		s.ElementsMatch([]uuid.UUID{all.ExternalID, summarized.ExternalID},
+			[]uuid.UUID{result[0].ExternalID, result[1].ExternalID})
+
+		result, err = s.webhookDB.GetActiveWebhooksByStatus(s.ctx, workspaceID, "TRANSCRIBED")
+		s.NoError(err)
+		s.Len(result, 1)
+		s.Equal(all.ExternalID, result[0].ExternalID)
+
+		webhooks, err := s.webhookDB.GetWebhooks(s.ctx, workspaceID)
+		s.NoError(err)
+		s.Len(webhooks, 3)
+	})
+
+	s.Run("successful creation of webhook deliveries", func() {
+		created, err := s.webhookDB.CreateWebhook(s.ctx, workspaceID, repository.WebhookCreateInput{URL: "https://hooks.example.com/d", Secret: "s"})
+		s.NoError(err)
+
+		err = s.webhookDB.CreateWebhookDelivery(s.ctx, repository.WebhookDeliveryCreateInput{
+			WebhookExternalID: created.ExternalID,
+			EventID:           uuid.New(),
+			EventType:         "summary.status_changed",
+			Attempt:           1,
+			Payload:           `{"type":"summary.status_changed"}`,
+			Error:             sql.NullString{String: "connection refused", Valid: true},
+		})
+		s.NoError(err)
+
+		err = s.webhookDB.CreateWebhookDelivery(s.ctx, repository.WebhookDeliveryCreateInput{
+			WebhookExternalID: created.ExternalID,
+			EventID:           uuid.New(),
+			EventType:         "summary.status_changed",
+			Attempt:           2,
+			Payload:           `{"type":"summary.status_changed"}`,
+			StatusCode:        sql.NullInt32{Int32: 200, Valid: true},
+			Delivered:         true,
+		})
+		s.NoError(err)
+
+		deliveries, err := s.webhookDB.GetWebhookDeliveries(s.ctx, created.ExternalID)
+		s.NoError(err)
+		s.Len(deliveries, 2)
+	})
+
+	s.Run("delivery for unknown webhook", func() {
+		err := s.webhookDB.CreateWebhookDelivery(s.ctx, repository.WebhookDeliveryCreateInput{
+			WebhookExternalID: uuid.New(),
+			EventID:           uuid.New(),
+			EventType:         "summary.status_changed",
+			Attempt:           1,
+		})
+		s.Equal(application.WebhookNotFound, err)
+	})
+
+	s.Run("webhooks are isolated by workspace", func() {
+		otherWorkspaceID := uuid.New()
+
+		created, err := s.webhookDB.CreateWebhook(s.ctx, otherWorkspaceID, repository.WebhookCreateInput{URL: "https://hooks.example.com/f", Secret: "s"})
+		s.NoError(err)
+
+		_, err = s.webhookDB.GetWebhookByExternalID(s.ctx, workspaceID, created.ExternalID)
+		s.Equal(application.WebhookNotFound, err)
+
+		_, err = s.webhookDB.UpdateWebhook(s.ctx, workspaceID, repository.WebhookUpdateInput{ExternalID: created.ExternalID, URL: created.URL})
+		s.Equal(application.WebhookNotFound, err)
+
+		s.Equal(application.WebhookNotFound, s.webhookDB.DeleteWebhookByExternalID(s.ctx, workspaceID, created.ExternalID))
+
+		result, err := s.webhookDB.GetActiveWebhooksByStatus(s.ctx, otherWorkspaceID, "SUMMARIZED")
+		s.NoError(err)
+		s.Len(result, 1)
+	})
+
+	s.Run("successful deletion of webhook", func() {
+		created, err := s.webhookDB.CreateWebhook(s.ctx, workspaceID, repository.WebhookCreateInput{URL: "https://hooks.example.com/e", Secret: "s"})
+		s.NoError(err)
+
+		s.NoError(s.webhookDB.DeleteWebhookByExternalID(s.ctx, workspaceID, created.ExternalID))
+
+		_, err = s.webhookDB.GetWebhookByExternalID(s.ctx, workspaceID, created.ExternalID)
+		s.Equal(application.WebhookNotFound, err)
+
+		err = s.webhookDB.DeleteWebhookByExternalID(s.ctx, workspaceID, created.ExternalID)
+		s.Equal(application.WebhookNotFound, err)
+	})
+}
@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type Workspace struct {
+	database *PgConnection
+}
+
+func NewWorkspace(databaseUrl string) *Workspace {
+	return &Workspace{
+		database: NewPgConnection(databaseUrl),
+	}
+}
+
+func (w *Workspace) CreateWorkspace(ctx context.Context, name string) (*repository.WorkspaceOutput, error) {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	query := `
+		insert into workspaces (external_id, created_at, name)
+		values ($1, $2, $3)
+		returning external_id, created_at, name;
+	`
+
+	return scanWorkspace(conn.QueryRow(ctx, query, uuid.New(), time.Now(), name))
+}
+
+func (w *Workspace) GetWorkspaces(ctx context.Context) ([]repository.WorkspaceOutput, error) {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	query := `
+		select ws.external_id, ws.created_at, ws.name
+		from workspaces ws
+		order by ws.created_at desc;
+	`
+
+	rows, err := conn.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var workspaces []repository.WorkspaceOutput
+	for rows.Next() {
+		workspace, err := scanWorkspace(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		workspaces = append(workspaces, *workspace)
+	}
+
+	return workspaces, rows.Err()
+}
+
+func (w *Workspace) GetWorkspaceByExternalID(ctx context.Context, externalID uuid.UUID) (*repository.WorkspaceOutput, error) {
+	conn, err := w.database.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer w.database.Close(ctx, conn)
+
+	query := `
+		select ws.external_id, ws.created_at, ws.name
+		from workspaces ws
+		where ws.external_id = $1;
+	`
+
+	return scanWorkspace(conn.QueryRow(ctx, query, externalID))
+}
+
+func scanWorkspace(row pgx.Row) (*repository.WorkspaceOutput, error) {
+	var workspace repository.WorkspaceOutput
+
+	err := row.Scan(
+		&workspace.ExternalID,
+		&workspace.CreatedAt,
+		&workspace.Name,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, application.WorkspaceNotFound
+		}
+		return nil, err
+	}
+
+	return &workspace, nil
+}
@@ -0,0 +1,30 @@
+package db
+
+import (
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/google/uuid"
+)
+
+func (s *SummaryDBTestSuite) TestWorkspaceDBOperations() {
+	s.Run("successful creation and lookup of workspace", func() {
+		s.truncate()
+
+		created, err := s.workspaceDB.CreateWorkspace(s.ctx, "acme")
+		s.NoError(err)
+		s.NotEmpty(created.ExternalID)
+		s.Equal("acme", created.Name)
+
+		found, err := s.workspaceDB.GetWorkspaceByExternalID(s.ctx, created.ExternalID)
+		s.NoError(err)
+		s.Equal(created.ExternalID, found.ExternalID)
+
+		workspaces, err := s.workspaceDB.GetWorkspaces(s.ctx)
+		s.NoError(err)
+		s.Len(workspaces, 1)
+	})
+
+	s.Run("get unknown workspace", func() {
+		_, err := s.workspaceDB.GetWorkspaceByExternalID(s.ctx, uuid.New())
+		s.Equal(application.WorkspaceNotFound, err)
+	})
+}
@@ -1,6 +1,8 @@
 package errors
 
 import (
+	"net/http"
+
 	"github.com/pkg/errors"
 
 	"github.com/diegofsousa/explicAI/internal/application"
@@ -9,10 +11,28 @@ import (
 
 func Handle(c echo.Context, err error) error {
 	switch errors.Cause(err) {
-	case application.MissingFile, application.InvalidFile, application.ExternalIDIsInvalid:
+	case application.MissingFile, application.InvalidFile, application.ExternalIDIsInvalid,
+		application.InvalidExportFormat, application.InvalidSummaryFilter, application.InvalidWebhook,
+		application.InvalidAPIKey, application.InvalidWorkspace, application.InvalidUsageFilter,
+		application.InvalidDownloadURL, application.InvalidUpload:
 		return echo.ErrBadRequest
-	case application.SummaryNotFound:
+	case application.UnsupportedAudioFormat, application.AudioFormatMismatch, application.AudioTooLong:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case application.SummaryNotFound, application.WebhookNotFound, application.APIKeyNotFound,
+		application.WorkspaceNotFound, application.AudioNotFound, application.UploadNotFound:
 		return echo.ErrNotFound
+	case application.Unauthorized:
+		return echo.ErrUnauthorized
+	case application.Forbidden:
+		return echo.ErrForbidden
+	case application.RateLimitExceeded:
+		return echo.ErrTooManyRequests
+	case application.BudgetExceeded:
+		return echo.NewHTTPError(http.StatusPaymentRequired, application.BudgetExceeded.Error())
+	case application.UploadOffsetMismatch:
+		return echo.ErrConflict
+	case application.UploadTooLarge:
+		return echo.ErrStatusRequestEntityTooLarge
 	case application.FailedReadFile:
 		return echo.ErrUnprocessableEntity
 	default:
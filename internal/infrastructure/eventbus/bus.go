@@ -0,0 +1,66 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
+	"github.com/diegofsousa/explicAI/internal/infrastructure/log"
+	"go.uber.org/zap"
+)
+
+type Bus struct {
+	mu          sync.RWMutex
+	bufferSize  int
+	handlers    []events.Publisher
+	subscribers map[chan events.Event]struct{}
+}
+
+func NewBus(bufferSize int, handlers ...events.Publisher) *Bus {
+	return &Bus{
+		bufferSize:  bufferSize,
+		handlers:    handlers,
+		subscribers: make(map[chan events.Event]struct{}),
+	}
+}
+
+// Publish forwards the event to every handler and delivers it to the current
+// subscribers. A subscriber whose buffer is full misses the event instead of
+// blocking the publisher.
+func (b *Bus) Publish(ctx context.Context, event events.Event) {
+	for _, handler := range b.handlers {
+		handler.Publish(ctx, event)
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for subscriber := range b.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			log.LogWarn(ctx, "event dropped for slow subscriber",
+				zap.String("event_id", event.ID.String()))
+		}
+	}
+}
+
+func (b *Bus) Subscribe(ctx context.Context) (<-chan events.Event, func()) {
+	subscriber := make(chan events.Event, b.bufferSize)
+
+	b.mu.Lock()
+	b.subscribers[subscriber] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, subscriber)
+			close(subscriber)
+			b.mu.Unlock()
+		})
+	}
+
+	return subscriber, unsubscribe
+}
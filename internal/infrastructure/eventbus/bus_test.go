@@ -0,0 +1,70 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/events"
+	gatewaymocks "github.com/diegofsousa/explicAI/internal/gateway/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type BusTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestBus(t *testing.T) {
+	suite.Run(t, new(BusTestSuite))
+}
+
+func (s *BusTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *BusTestSuite) TestPublish() {
+	s.Run("deliver event to handlers and subscribers", func() {
+		event := events.Event{ID: uuid.New(), Type: events.SummaryStatusChanged}
+
+		handler := new(gatewaymocks.Publisher)
+		handler.EXPECT().Publish(s.ctx, event).Once()
+
+		bus := NewBus(1, handler)
+		first, unsubscribeFirst := bus.Subscribe(s.ctx)
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := bus.Subscribe(s.ctx)
+		defer unsubscribeSecond()
+
+		bus.Publish(s.ctx, event)
+
+		s.Equal(event, <-first)
+		s.Equal(event, <-second)
+		handler.AssertExpectations(s.T())
+	})
+
+	s.Run("drop events for full subscribers", func() {
+		bus := NewBus(1)
+		subscriber, unsubscribe := bus.Subscribe(s.ctx)
+		defer unsubscribe()
+
+		first := events.Event{ID: uuid.New()}
+		bus.Publish(s.ctx, first)
+		bus.Publish(s.ctx, events.Event{ID: uuid.New()})
+
+		s.Equal(first, <-subscriber)
+		s.Empty(subscriber)
+	})
+
+	s.Run("stop delivering after unsubscribe", func() {
+		bus := NewBus(1)
+		subscriber, unsubscribe := bus.Subscribe(s.ctx)
+
+		unsubscribe()
+		unsubscribe()
+		bus.Publish(s.ctx, events.Event{ID: uuid.New()})
+
+		_, open := <-subscriber
+		s.False(open)
+	})
+}
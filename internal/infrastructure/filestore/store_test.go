@@ -0,0 +1,67 @@
+package filestore
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/stretchr/testify/suite"
+)
+
+type FileStoreTestSuite struct {
+	suite.Suite
+	ctx   context.Context
+	store *Store
+}
+
+func TestFileStore(t *testing.T) {
+	suite.Run(t, new(FileStoreTestSuite))
+}
+
+func (s *FileStoreTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.store = NewStore(s.T().TempDir())
+}
+
+func (s *FileStoreTestSuite) TestStore() {
+	s.Run("successful put and get", func() {
+		err := s.store.Put(s.ctx, "audio/workspace/meeting", strings.NewReader("0123456789"), 10, "audio/mpeg")
+		s.Require().NoError(err)
+
+		size, err := s.store.Size(s.ctx, "audio/workspace/meeting")
+		s.Require().NoError(err)
+		s.Equal(int64(10), size)
+
+		body, err := s.store.Get(s.ctx, "audio/workspace/meeting", 4)
+		s.Require().NoError(err)
+		defer body.Close()
+
+		content, err := io.ReadAll(body)
+		s.Require().NoError(err)
+		s.Equal("456789", string(content))
+	})
+
+	s.Run("reject truncated content", func() {
+		err := s.store.Put(s.ctx, "audio/workspace/truncated", strings.NewReader("0123"), 10, "audio/mpeg")
+		s.Require().Error(err)
+
+		_, err = s.store.Size(s.ctx, "audio/workspace/truncated")
+		s.Require().ErrorIs(err, application.AudioNotFound)
+	})
+
+	s.Run("successful delete", func() {
+		s.Require().NoError(s.store.Put(s.ctx, "audio/workspace/delete", strings.NewReader("0"), 1, "audio/mpeg"))
+		s.Require().NoError(s.store.Delete(s.ctx, "audio/workspace/delete"))
+		s.Require().NoError(s.store.Delete(s.ctx, "audio/workspace/delete"))
+
+		_, err := s.store.Get(s.ctx, "audio/workspace/delete", 0)
+		s.Require().ErrorIs(err, application.AudioNotFound)
+	})
+
+	s.Run("reject keys outside the directory", func() {
+		err := s.store.Put(s.ctx, "../escape", strings.NewReader("0"), 1, "audio/mpeg")
+		s.Require().ErrorContains(err, "invalid blob key")
+	})
+}
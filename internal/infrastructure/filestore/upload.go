@@ -0,0 +1,182 @@
+package filestore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/upload"
+	"github.com/google/uuid"
+)
+
+// UploadStore assembles resumable uploads below a base directory, keeping
+// the received bytes in <id> and the upload info in <id>.info.
+type UploadStore struct {
+	Dir   string
+	locks sync.Map
+}
+
+func NewUploadStore(dir string) *UploadStore {
+	return &UploadStore{
+		Dir: dir,
+	}
+}
+
+func (s *UploadStore) CreateUpload(ctx context.Context, info upload.UploadInfo) error {
+	if err := os.MkdirAll(s.Dir, 0o750); err != nil {
+		return fmt.Errorf("error on create upload dir: error=%s", err.Error())
+	}
+
+	file, err := os.OpenFile(s.contentPath(info.ID), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("error on create upload: error=%s", err.Error())
+	}
+
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("error on create upload: error=%s", err.Error())
+	}
+
+	return s.UpdateUpload(ctx, info)
+}
+
+func (s *UploadStore) GetUpload(ctx context.Context, id uuid.UUID) (*upload.UploadInfo, error) {
+	content, err := os.ReadFile(s.infoPath(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, application.UploadNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var info upload.UploadInfo
+	if err = json.Unmarshal(content, &info); err != nil {
+		return nil, fmt.Errorf("error on decode upload info: error=%s", err.Error())
+	}
+
+	// the content is removed once the upload is handed to the summary
+	if info.SummaryExternalID != uuid.Nil {
+		info.Offset = info.Length
+		return &info, nil
+	}
+
+	stat, err := os.Stat(s.contentPath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	info.Offset = stat.Size()
+
+	return &info, nil
+}
+
+func (s *UploadStore) UpdateUpload(ctx context.Context, info upload.UploadInfo) error {
+	content, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	// writes to a temporary file first so readers never see a partial info
+	tmp, err := os.CreateTemp(s.Dir, ".info-*")
+	if err != nil {
+		return fmt.Errorf("error on write upload info: error=%s", err.Error())
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("error on write upload info: error=%s", err.Error())
+	}
+
+	return os.Rename(tmp.Name(), s.infoPath(info.ID))
+}
+
+// WriteChunk appends the content when offset matches the bytes received so
+// far. Whatever arrives before the content fails is kept, so the client can
+// resume from the returned offset.
+func (s *UploadStore) WriteChunk(ctx context.Context, id uuid.UUID, offset int64, content io.Reader) (int64, error) {
+	lock := s.lock(id)
+	lock.Lock()
+	defer lock.Unlock()
+
+	file, err := os.OpenFile(s.contentPath(id), os.O_WRONLY|os.O_APPEND, 0)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, application.UploadNotFound
+	}
+
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	if stat.Size() != offset {
+		return stat.Size(), application.UploadOffsetMismatch
+	}
+
+	written, err := io.Copy(file, content)
+	if err != nil {
+		return offset + written, fmt.Errorf("error on write upload chunk: error=%s", err.Error())
+	}
+
+	return offset + written, nil
+}
+
+func (s *UploadStore) ReadUpload(ctx context.Context, id uuid.UUID) (io.ReadSeekCloser, error) {
+	file, err := os.Open(s.contentPath(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, application.UploadNotFound
+	}
+
+	return file, err
+}
+
+func (s *UploadStore) DeleteUploadContent(ctx context.Context, id uuid.UUID) error {
+	if err := os.Remove(s.contentPath(id)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *UploadStore) DeleteUpload(ctx context.Context, id uuid.UUID) error {
+	if err := s.DeleteUploadContent(ctx, id); err != nil {
+		return err
+	}
+
+	if err := os.Remove(s.infoPath(id)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	s.locks.Delete(id)
+
+	return nil
+}
+
+func (s *UploadStore) lock(id uuid.UUID) *sync.Mutex {
+	lock, _ := s.locks.LoadOrStore(id, new(sync.Mutex))
+	return lock.(*sync.Mutex)
+}
+
+func (s *UploadStore) contentPath(id uuid.UUID) string {
+	return filepath.Join(s.Dir, id.String())
+}
+
+func (s *UploadStore) infoPath(id uuid.UUID) string {
+	return filepath.Join(s.Dir, id.String()+".info")
+}
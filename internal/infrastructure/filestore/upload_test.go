@@ -0,0 +1,90 @@
+package filestore
+
+import (
+	"io"
+	"strings"
+
+	"github.com/diegofsousa/explicAI/internal/application"
+	"github.com/diegofsousa/explicAI/internal/gateway/upload"
+	"github.com/google/uuid"
+)
+
+func (s *FileStoreTestSuite) TestUploadStore() {
+	store := NewUploadStore(s.T().TempDir())
+
+	newUpload := func() upload.UploadInfo {
+		info := upload.UploadInfo{
+			ID:          uuid.New(),
+			WorkspaceID: uuid.New(),
+			Length:      10,
+			Metadata:    map[string]string{"filename": "meeting.mp3"},
+		}
+		s.Require().NoError(store.CreateUpload(s.ctx, info))
+		return info
+	}
+
+	s.Run("successful resume upload", func() {
+		info := newUpload()
+
+		offset, err := store.WriteChunk(s.ctx, info.ID, 0, strings.NewReader("0123"))
+		s.Require().NoError(err)
+		s.Equal(int64(4), offset)
+
+		got, err := store.GetUpload(s.ctx, info.ID)
+		s.Require().NoError(err)
+		s.Equal(int64(4), got.Offset)
+		s.Equal(info.WorkspaceID, got.WorkspaceID)
+		s.Equal("meeting.mp3", got.Metadata["filename"])
+
+		offset, err = store.WriteChunk(s.ctx, info.ID, 4, strings.NewReader("456789"))
+		s.Require().NoError(err)
+		s.Equal(int64(10), offset)
+
+		content, err := store.ReadUpload(s.ctx, info.ID)
+		s.Require().NoError(err)
+		defer content.Close()
+
+		body, err := io.ReadAll(content)
+		s.Require().NoError(err)
+		s.Equal("0123456789", string(body))
+	})
+
+	s.Run("reject chunk at wrong offset", func() {
+		info := newUpload()
+
+		_, err := store.WriteChunk(s.ctx, info.ID, 0, strings.NewReader("0123"))
+		s.Require().NoError(err)
+
+		offset, err := store.WriteChunk(s.ctx, info.ID, 2, strings.NewReader("23"))
+		s.Require().ErrorIs(err, application.UploadOffsetMismatch)
+		s.Equal(int64(4), offset)
+	})
+
+	s.Run("completed upload keeps its info", func() {
+		info := newUpload()
+		info.SummaryExternalID = uuid.New()
+
+		s.Require().NoError(store.UpdateUpload(s.ctx, info))
+		s.Require().NoError(store.DeleteUploadContent(s.ctx, info.ID))
+
+		got, err := store.GetUpload(s.ctx, info.ID)
+		s.Require().NoError(err)
+		s.Equal(info.Length, got.Offset)
+		s.Equal(info.SummaryExternalID, got.SummaryExternalID)
+
+		_, err = store.ReadUpload(s.ctx, info.ID)
+		s.Require().ErrorIs(err, application.UploadNotFound)
+	})
+
+	s.Run("successful delete", func() {
+		info := newUpload()
+
+		s.Require().NoError(store.DeleteUpload(s.ctx, info.ID))
+
+		_, err := store.GetUpload(s.ctx, info.ID)
+		s.Require().ErrorIs(err, application.UploadNotFound)
+
+		_, err = store.WriteChunk(s.ctx, info.ID, 0, strings.NewReader("0"))
+		s.Require().ErrorIs(err, application.UploadNotFound)
+	})
+}
@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often buckets that refilled completely are dropped,
+// keeping memory bounded to the clients seen recently.
+const sweepInterval = time.Minute
+
+type (
+	Limiter struct {
+		mu        sync.Mutex
+		capacity  float64
+		perSecond float64
+		buckets   map[string]*bucket
+		lastSweep time.Time
+		now       func() time.Time
+	}
+
+	bucket struct {
+		tokens    float64
+		updatedAt time.Time
+	}
+
+	Result struct {
+		Allowed    bool
+		Limit      int
+		Remaining  int
+		Reset      time.Duration
+		RetryAfter time.Duration
+	}
+)
+
+// NewLimiter creates a token bucket limiter where every key starts with
+// capacity tokens, spends one per request and earns refillPerMinute tokens
+// back each minute.
+func NewLimiter(capacity int, refillPerMinute float64) *Limiter {
+	return &Limiter{
+		capacity:  float64(capacity),
+		perSecond: refillPerMinute / 60,
+		buckets:   make(map[string]*bucket),
+		now:       time.Now,
+	}
+}
+
+func (l *Limiter) Allow(key string) Result {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.capacity, updatedAt: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = l.refill(b, now)
+	b.updatedAt = now
+
+	result := Result{Limit: int(l.capacity)}
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = l.timeToEarn(1 - b.tokens)
+	}
+
+	result.Remaining = int(math.Floor(b.tokens))
+	result.Reset = l.timeToEarn(l.capacity - b.tokens)
+
+	return result
+}
+
+func (l *Limiter) refill(b *bucket, now time.Time) float64 {
+	return math.Min(l.capacity, b.tokens+now.Sub(b.updatedAt).Seconds()*l.perSecond)
+}
+
+func (l *Limiter) timeToEarn(tokens float64) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+
+	if l.perSecond <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(math.Ceil(tokens / l.perSecond * float64(time.Second)))
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= l.capacity {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.lastSweep = now
+}
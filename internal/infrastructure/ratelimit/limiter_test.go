@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LimiterTestSuite struct {
+	suite.Suite
+
+	now     time.Time
+	limiter *Limiter
+}
+
+func TestLimiter(t *testing.T) {
+	suite.Run(t, new(LimiterTestSuite))
+}
+
+func (s *LimiterTestSuite) SetupTest() {
+	s.now = time.Date(2025, 1, 25, 15, 4, 5, 0, time.UTC)
+	s.limiter = NewLimiter(2, 6)
+	s.limiter.now = func() time.Time { return s.now }
+}
+
+func (s *LimiterTestSuite) TestAllow() {
+	s.Run("spend the burst capacity", func() {
+		first := s.limiter.Allow("key")
+		s.True(first.Allowed)
+		s.Equal(2, first.Limit)
+		s.Equal(1, first.Remaining)
+		s.Equal(10*time.Second, first.Reset)
+
+		second := s.limiter.Allow("key")
+		s.True(second.Allowed)
+		s.Equal(0, second.Remaining)
+		s.Equal(20*time.Second, second.Reset)
+	})
+
+	s.Run("reject empty bucket until a token is earned", func() {
+		result := s.limiter.Allow("key")
+		s.False(result.Allowed)
+		s.Equal(0, result.Remaining)
+		s.Equal(10*time.Second, result.RetryAfter)
+
+		s.now = s.now.Add(10 * time.Second)
+		s.True(s.limiter.Allow("key").Allowed)
+		s.False(s.limiter.Allow("key").Allowed)
+	})
+
+	s.Run("buckets are independent per key", func() {
+		s.True(s.limiter.Allow("other").Allowed)
+	})
+
+	s.Run("refill never exceeds capacity", func() {
+		s.now = s.now.Add(time.Hour)
+		s.Equal(1, s.limiter.Allow("key").Remaining)
+	})
+
+	s.Run("drop idle buckets", func() {
+		s.now = s.now.Add(time.Hour)
+		s.limiter.Allow("key")
+		s.Len(s.limiter.buckets, 1)
+	})
+}
@@ -0,0 +1,91 @@
+package render
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/document"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+type executor interface {
+	Execute(wr io.Writer, data any) error
+}
+
+type Renderer struct {
+	templatesDir string
+}
+
+func NewRenderer(templatesDir string) *Renderer {
+	return &Renderer{
+		templatesDir: templatesDir,
+	}
+}
+
+func (r *Renderer) Render(ctx context.Context, format document.Format, input document.SummaryDocument) ([]byte, error) {
+	if _, ok := document.Formats[format]; !ok {
+		return nil, fmt.Errorf("error on render summary document: error=unsupported format %s", format)
+	}
+
+	name := templateName(format)
+	content, err := r.readTemplate(name)
+	if err != nil {
+		return nil, fmt.Errorf("error on render summary document: error=%s", err.Error())
+	}
+
+	tmpl, err := parseTemplate(format, name, string(content))
+	if err != nil {
+		return nil, fmt.Errorf("error on render summary document: error=%s", err.Error())
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, input); err != nil {
+		return nil, fmt.Errorf("error on render summary document: error=%s", err.Error())
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *Renderer) readTemplate(name string) ([]byte, error) {
+	if r.templatesDir != "" {
+		content, err := os.ReadFile(filepath.Join(r.templatesDir, name))
+		if err == nil {
+			return content, nil
+		}
+
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	return defaultTemplates.ReadFile("templates/" + name)
+}
+
+func templateName(format document.Format) string {
+	return "summary" + document.Formats[format].Extension + ".tmpl"
+}
+
+func parseTemplate(format document.Format, name, content string) (executor, error) {
+	if format == document.HTML {
+		return htmltemplate.New(name).Funcs(htmltemplate.FuncMap(templateFuncs)).Parse(content)
+	}
+
+	return template.New(name).Funcs(templateFuncs).Parse(content)
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(value any) (string, error) {
+		encoded, err := json.Marshal(value)
+		return string(encoded), err
+	},
+}
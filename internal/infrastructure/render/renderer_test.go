@@ -0,0 +1,110 @@
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/diegofsousa/explicAI/internal/gateway/document"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	summaryExternalIDUUID = uuid.MustParse("9156fe73-c692-4834-bf58-7474b878a634")
+	createdAt             = time.Date(2025, 1, 25, 15, 4, 5, 0, time.UTC)
+)
+
+type (
+	RendererTestSuite struct {
+		suite.Suite
+		ctx   context.Context
+		input document.SummaryDocument
+	}
+)
+
+func TestRenderer(t *testing.T) {
+	suite.Run(t, new(RendererTestSuite))
+}
+
+func (s *RendererTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.input = document.SummaryDocument{
+		ExternalID:   summaryExternalIDUUID,
+		Status:       "SUMMARIZED",
+		CreatedAt:    createdAt,
+		UpdatedAt:    createdAt,
+		Title:        "Reunião <semanal>",
+		Description:  "description",
+		BriefResume:  "brief resume",
+		MediumResume: "medium resume",
+		FullText:     "linha 1\nlinha 2",
+	}
+}
+
+func (s *RendererTestSuite) TestRender() {
+	s.Run("successful render markdown", func() {
+		content, err := NewRenderer("").Render(s.ctx, document.Markdown, s.input)
+		s.Require().NoError(err)
+		s.Contains(string(content), "# Reunião <semanal>")
+		s.Contains(string(content), "## TL;DR\n\nbrief resume")
+		s.Contains(string(content), "linha 1\nlinha 2")
+	})
+
+	s.Run("successful render plain text", func() {
+		content, err := NewRenderer("").Render(s.ctx, document.PlainText, s.input)
+		s.Require().NoError(err)
+		s.Contains(string(content), "Reunião <semanal>")
+		s.Contains(string(content), "medium resume")
+	})
+
+	s.Run("successful render html escaping fields", func() {
+		content, err := NewRenderer("").Render(s.ctx, document.HTML, s.input)
+		s.Require().NoError(err)
+		s.Contains(string(content), "<h1>Reunião &lt;semanal&gt;</h1>")
+		s.NotContains(string(content), "<semanal>")
+	})
+
+	s.Run("successful render json", func() {
+		content, err := NewRenderer("").Render(s.ctx, document.JSON, s.input)
+		s.Require().NoError(err)
+
+		var output map[string]string
+		s.Require().NoError(json.Unmarshal(content, &output))
+		s.Equal(summaryExternalIDUUID.String(), output["externalId"])
+		s.Equal("Reunião <semanal>", output["title"])
+		s.Equal("linha 1\nlinha 2", output["fullText"])
+	})
+
+	s.Run("successful render with template override", func() {
+		dir := s.T().TempDir()
+		err := os.WriteFile(filepath.Join(dir, "summary.md.tmpl"), []byte("custom {{ .Title }}"), 0o600)
+		s.Require().NoError(err)
+
+		content, err := NewRenderer(dir).Render(s.ctx, document.Markdown, s.input)
+		s.Require().NoError(err)
+		s.Equal("custom Reunião <semanal>", string(content))
+
+		content, err = NewRenderer(dir).Render(s.ctx, document.PlainText, s.input)
+		s.Require().NoError(err)
+		s.Contains(string(content), "TL;DR")
+	})
+
+	s.Run("fail render with invalid template override", func() {
+		dir := s.T().TempDir()
+		err := os.WriteFile(filepath.Join(dir, "summary.txt.tmpl"), []byte("{{ .Title "), 0o600)
+		s.Require().NoError(err)
+
+		_, err = NewRenderer(dir).Render(s.ctx, document.PlainText, s.input)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "error on render summary document")
+	})
+
+	s.Run("fail render unsupported format", func() {
+		_, err := NewRenderer("").Render(s.ctx, document.Format("pdf"), s.input)
+		s.EqualError(err, "error on render summary document: error=unsupported format pdf")
+	})
+}